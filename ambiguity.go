@@ -0,0 +1,73 @@
+package gocd
+
+import "fmt"
+
+// DefaultAmbiguity holds rough ambiguity weights for dataset
+// abbreviations that often occur in names as normal words or other
+// abbreviations (e.g. "AS", "N.A.", "Co."), from 0 (never) to 1
+// (usually). Weights are applied to entries with the abbreviation when
+// loaded, and overlay entries can set their own weights with e.g.
+//
+//	ambiguity:
+//	  AS: 0.5
+var DefaultAmbiguity = map[string]float64{
+	"AD":   0.4,
+	"AS":   0.5,
+	"AT":   0.6,
+	"Co.":  0.3,
+	"GP":   0.3,
+	"IP":   0.4,
+	"Mts":  0.4,
+	"N.A.": 0.5,
+	"NL":   0.3,
+	"P.C.": 0.3,
+	"PT":   0.3,
+	"S.A.": 0.3,
+	"S.C.": 0.3,
+	"S.E.": 0.3,
+	"SC":   0.3,
+}
+
+// WithMaxAmbiguity suppresses matches of designators with an ambiguity
+// weight (see DefaultAmbiguity and Result.Ambiguity) greater than w,
+// for strict matching
+func WithMaxAmbiguity(w float64) Option {
+	return func(o *options) {
+		o.maxAmbiguity = w
+		o.checkAmbiguity = true
+	}
+}
+
+// initAmbiguity adds the DefaultAmbiguity weights for e's abbreviations
+// to any weights set by the dataset
+func (e *Entry) initAmbiguity() {
+	for _, a := range e.Abbr {
+		w, exists := DefaultAmbiguity[a]
+		if !exists {
+			continue
+		}
+		if _, set := e.Ambiguity[a]; set {
+			continue
+		}
+		if e.Ambiguity == nil {
+			e.Ambiguity = make(map[string]float64)
+		}
+		e.Ambiguity[a] = w
+	}
+}
+
+// validateAmbiguity checks e's ambiguity weights are for its
+// abbreviations, and between 0 and 1
+func (e *Entry) validateAmbiguity() error {
+	for a, w := range e.Ambiguity {
+		if !containsString(e.Abbr, a) {
+			return fmt.Errorf("%w: ambiguity for %q is not an abbreviation of %q",
+				ErrDatasetParse, a, e.LongName)
+		}
+		if w < 0 || w > 1 {
+			return fmt.Errorf("%w: ambiguity %v for %q of %q is not between 0 and 1",
+				ErrDatasetParse, w, a, e.LongName)
+		}
+	}
+	return nil
+}
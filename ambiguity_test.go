@@ -0,0 +1,82 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAmbiguity(t *testing.T) {
+	p, err := New(WithOverlay([]byte(`
+Widgetschaft:
+  abbr:
+    - WS
+    - Wsch
+  ambiguity:
+    WS: 0.8
+  lang: de
+`)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input     string
+		ambiguity float64
+	}{
+		{"Acme AS", 0.5},
+		{"Acme S.A.", 0.3},
+		{"Acme SA", 0.3},
+		{"Acme Ltd", 0},
+		{"Acme WS", 0.8},
+		{"Acme Wsch", 0},
+		{"Acme Widgetschaft", 0},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, res.Matched, "Matched for %q", tc.input)
+		assert.Equal(t, tc.ambiguity, res.Ambiguity, "Ambiguity matches for %q", tc.input)
+	}
+
+	// Strict matching
+	strict, err := p.Clone(WithMaxAmbiguity(0.4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range tests {
+		res, err := strict.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.ambiguity <= 0.4, res.Matched, "strict Matched for %q", tc.input)
+	}
+
+	// Ambiguous designators are discounted
+	_, score := p.IsLikelyCompany("Acme Ltd")
+	_, scoreAmbiguous := p.IsLikelyCompany("Acme AS")
+	assert.Less(t, scoreAmbiguous, score, "ambiguous designator scores lower")
+}
+
+func TestAmbiguityInvalid(t *testing.T) {
+	for _, overlay := range []string{`
+Widgetschaft:
+  abbr:
+    - WS
+  ambiguity:
+    WS: 1.5
+  lang: de
+`, `
+Widgetschaft:
+  abbr:
+    - WS
+  ambiguity:
+    Wsch: 0.5
+  lang: de
+`} {
+		_, err := New(WithOverlay([]byte(overlay)))
+		assert.ErrorIs(t, err, ErrDatasetParse, "invalid ambiguity rejected")
+	}
+}
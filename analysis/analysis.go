@@ -0,0 +1,115 @@
+/*
+analysis provides a search-indexing token filter for gocd, which removes
+or normalises company designator tokens so that e.g. a query for "Acme"
+matches documents indexed as "Acme LLC".
+
+Token mirrors the fields of Bleve's analysis.Token, so the filter can be
+used in a Bleve analysis chain with a small adapter, without this
+package depending on Bleve:
+
+	type designatorFilter struct{ f *analysis.TokenFilter }
+
+	func (d designatorFilter) Filter(input bleveanalysis.TokenStream) bleveanalysis.TokenStream {
+		tokens := make([]*analysis.Token, len(input))
+		for i, t := range input {
+			tokens[i] = &analysis.Token{Term: t.Term, Start: t.Start, End: t.End, Position: t.Position}
+		}
+		output := make(bleveanalysis.TokenStream, 0, len(tokens))
+		for _, t := range d.f.Filter(tokens) {
+			output = append(output, &bleveanalysis.Token{Term: t.Term, Start: t.Start,
+				End: t.End, Position: t.Position, Type: bleveanalysis.AlphaNumeric})
+		}
+		return output
+	}
+*/
+package analysis
+
+import (
+	"strings"
+
+	"github.com/ProfoundNetworks/gocd"
+)
+
+// MaxDesignatorTokens is the maximum number of tokens considered as a
+// single designator
+const MaxDesignatorTokens = 6
+
+// Token is a token in a token stream, as produced by a tokenizer
+type Token struct {
+	Term     []byte // The token text
+	Start    int    // Byte offset of the token start in the original text
+	End      int    // Byte offset of the token end in the original text
+	Position int    // One-based position of the token in the stream
+}
+
+// Mode determines how TokenFilter handles designator tokens
+type Mode int
+
+const (
+	Remove    Mode = iota // Remove designator tokens
+	Normalize             // Replace designator tokens with a single token for the long form
+)
+
+// TokenFilter removes or normalises the designator tokens at the end (or,
+// for leading designators like "OOO", the start) of a token stream
+type TokenFilter struct {
+	p    *gocd.Parser
+	mode Mode
+}
+
+// NewTokenFilter returns a TokenFilter using p with the given mode
+func NewTokenFilter(p *gocd.Parser, mode Mode) *TokenFilter {
+	return &TokenFilter{p: p, mode: mode}
+}
+
+// Filter returns input with any designator tokens removed or normalised.
+// Designators are identified by looking up runs of trailing (or leading)
+// token terms in the designator dataset, ignoring case and punctuation,
+// so tokenizers that split "L.L.C." into "l", "l", "c" are handled.
+// Designators are only filtered if at least one other token remains.
+func (f *TokenFilter) Filter(input []*Token) []*Token {
+	// Try the longest trailing designator first
+	for k := f.maxTokens(input); k > 0; k-- {
+		if e, ok := f.lookup(input[len(input)-k:]); ok {
+			return append(input[:len(input)-k:len(input)-k], f.replacement(input[len(input)-k:], e)...)
+		}
+	}
+	// Then leading designators
+	for k := f.maxTokens(input); k > 0; k-- {
+		if e, ok := f.lookup(input[:k]); ok && e.Lead {
+			return append(f.replacement(input[:k], e), input[k:]...)
+		}
+	}
+	return input
+}
+
+// maxTokens returns the maximum number of tokens to try as a designator
+func (f *TokenFilter) maxTokens(input []*Token) int {
+	k := len(input) - 1
+	if k > MaxDesignatorTokens {
+		k = MaxDesignatorTokens
+	}
+	return k
+}
+
+// lookup returns the dataset Entry for the designator formed by tokens
+func (f *TokenFilter) lookup(tokens []*Token) (*gocd.Entry, bool) {
+	terms := make([]string, len(tokens))
+	for i, t := range tokens {
+		terms[i] = string(t.Term)
+	}
+	return f.p.Lookup(strings.Join(terms, " "))
+}
+
+// replacement returns the tokens replacing the designator tokens for e
+func (f *TokenFilter) replacement(tokens []*Token, e *gocd.Entry) []*Token {
+	if f.mode != Normalize {
+		return nil
+	}
+	return []*Token{{
+		Term:     []byte(strings.ToLower(e.LongName)),
+		Start:    tokens[0].Start,
+		End:      tokens[len(tokens)-1].End,
+		Position: tokens[0].Position,
+	}}
+}
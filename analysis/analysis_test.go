@@ -0,0 +1,75 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ProfoundNetworks/gocd"
+)
+
+// tokenize splits text into lowercased letter/digit tokens
+func tokenize(text string) []*Token {
+	var tokens []*Token
+	start := -1
+	for i, r := range text + " " {
+		isToken := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if isToken && start < 0 {
+			start = i
+		} else if !isToken && start >= 0 {
+			tokens = append(tokens, &Token{
+				Term:     []byte(strings.ToLower(text[start:i])),
+				Start:    start,
+				End:      i,
+				Position: len(tokens) + 1,
+			})
+			start = -1
+		}
+	}
+	return tokens
+}
+
+func terms(tokens []*Token) string {
+	var s []string
+	for _, t := range tokens {
+		s = append(s, string(t.Term))
+	}
+	return strings.Join(s, " ")
+}
+
+func TestTokenFilter(t *testing.T) {
+	tests := []struct {
+		text       string
+		removed    string
+		normalized string
+	}{
+		{"Acme LLC", "acme", "acme limited liability company"},
+		{"Acme Widgets L.L.C.", "acme widgets", "acme widgets limited liability company"},
+		{"Acme Pty. Ltd.", "acme", "acme proprietary limited"},
+		{"OOO Romashka", "romashka", "общество с ограниченной ответственностью romashka"},
+		{"Acme", "acme", "acme"},
+		{"Limited", "limited", "limited"},
+		{"Acme Widgets", "acme widgets", "acme widgets"},
+	}
+
+	p, err := gocd.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	remove := NewTokenFilter(p, Remove)
+	normalize := NewTokenFilter(p, Normalize)
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.removed, terms(remove.Filter(tokenize(tc.text))),
+			"Remove terms match for %q", tc.text)
+		assert.Equal(t, tc.normalized, terms(normalize.Filter(tokenize(tc.text))),
+			"Normalize terms match for %q", tc.text)
+	}
+
+	tokens := normalize.Filter(tokenize("Acme Widgets L.L.C."))
+	last := tokens[len(tokens)-1]
+	assert.Equal(t, []int{13, 18, 3}, []int{last.Start, last.End, last.Position},
+		"normalized token offsets span designator")
+}
@@ -0,0 +1,39 @@
+package gocd
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Arabic text in registry data often uses typographic forms that don't
+// affect meaning, but stop designators matching: tatweel (kashida, U+0640)
+// to stretch words for justification, the Arabic comma (U+060C) and full
+// stop (U+06D4) as separators, and presentation forms (U+FB50-U+FDFF,
+// U+FE70-U+FEFC) from legacy encodings and PDF extraction. We fold these
+// before matching (and in index keys), while invisible bidi controls
+// (e.g. RLM) are stripped with other zero-width characters.
+
+// reArabicTypography matches runs of Arabic typographic forms
+var reArabicTypography = regexp.MustCompile(
+	"[ـ،۔ﭐ-﷿ﹰ-ﻼ]+")
+
+// arabicPunct strips tatweel and replaces Arabic punctuation with the
+// ASCII equivalents the patterns expect
+var arabicPunct = strings.NewReplacer("ـ", "", "،", ",", "۔", ".")
+
+// foldArabic returns word (a run of Arabic typographic forms) with
+// tatweel stripped, punctuation replaced, and presentation forms
+// decomposed to their (NFD) base letters
+func foldArabic(word []byte) []byte {
+	return norm.NFKD.Bytes([]byte(arabicPunct.Replace(string(word))))
+}
+
+// foldArabicString is like foldArabic for any string s
+func foldArabicString(s string) string {
+	if !reArabicTypography.MatchString(s) {
+		return s
+	}
+	return string(reArabicTypography.ReplaceAllFunc([]byte(s), foldArabic))
+}
@@ -0,0 +1,56 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArabic(t *testing.T) {
+	tests := []struct {
+		input     string
+		form      OutputForm
+		shortName string
+		des       string
+		std       string
+	}{
+		{"مؤسسة النور ذ.م.م", OutputNFC, "مؤسسة النور", "ذ.م.م", "ذ.م.م"},
+		{"النور ذ.م.م.", OutputNFC, "النور", "ذ.م.م.", "ذ.م.م"},
+		{"النور ذ م م", OutputNFC, "النور", "ذ م م", "ذ.م.م"},
+		{"النور ش.ذ.م.م", OutputNFC, "النور", "ش.ذ.م.م", "ذ.م.م"},
+		{"النور ذات مسؤولية محدودة", OutputNFC, "النور", "ذات مسؤولية محدودة", "ذ.م.م"},
+		{"شركة الأمل ش.م.م", OutputNFC, "شركة الأمل", "ش.م.م", "ش.م.م"},
+		// Arabic comma
+		{"النور، ذ.م.م", OutputNFC, "النور", "ذ.م.م", "ذ.م.م"},
+		// Tatweel
+		{"النور ذ.ـم.ـم", OutputNFC, "النور", "ذ.م.م", "ذ.م.م"},
+		{"النور ذ.ـم.ـم", OutputOriginal, "النور", "ذ.ـم.ـم", "ذ.م.م"},
+		// Presentation forms
+		{"النور ﺫ.ﻡ.ﻡ", OutputNFC, "النور", "ذ.م.م", "ذ.م.م"},
+		{"النور ﺫ.ﻡ.ﻡ", OutputOriginal, "النور", "ﺫ.ﻡ.ﻡ", "ذ.م.م"},
+		// Bidi controls
+		{"‏النور ذ.م.م‏", OutputNFC, "النور", "ذ.م.م", "ذ.م.م"},
+		{"⁧النور ذ.م.م⁩", OutputNFC, "النور", "ذ.م.م", "ذ.م.م"},
+	}
+	parsers := make(map[OutputForm]*Parser)
+	for _, tc := range tests {
+		p, exists := parsers[tc.form]
+		if !exists {
+			var err error
+			p, err = New(WithOutputForm(tc.form))
+			if err != nil {
+				t.Fatal(err)
+			}
+			parsers[tc.form] = p
+		}
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, res.Matched, "Matched for %+q", tc.input)
+		assert.Equal(t, tc.shortName, res.ShortName, "ShortName matches for %+q (form %d)", tc.input, tc.form)
+		assert.Equal(t, tc.des, res.Designator, "Designator matches for %+q (form %d)", tc.input, tc.form)
+		assert.Equal(t, tc.std, res.DesignatorStd, "DesignatorStd matches for %+q (form %d)", tc.input, tc.form)
+		assert.Equal(t, "ar", res.Lang, "Lang matches for %+q", tc.input)
+	}
+}
@@ -0,0 +1,55 @@
+package gocd
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultArticles are the leading articles stripped by WithArticles(nil),
+// keyed by language. Articles for the empty language key are used for
+// names without a designator match.
+var DefaultArticles = map[string][]string{
+	"":   {"The"},
+	"en": {"The"},
+	"de": {"Der", "Die", "Das"},
+	"fr": {"Le", "La", "Les"},
+	"es": {"El", "La", "Los", "Las"},
+	"it": {"Il", "Lo", "La", "Gli", "Le"},
+	"nl": {"De", "Het"},
+	"pt": {"O", "A", "Os", "As"},
+}
+
+// WithArticles strips a leading article (like "The", "Die", "El") from
+// ShortName, so "The Acme Company Ltd" and "Acme Company Ltd" produce
+// the same ShortName. articles maps languages to their articles; the
+// articles for the matched designator's language are used, or those for
+// the empty language key for unmatched names. If articles is nil,
+// DefaultArticles is used.
+func WithArticles(articles map[string][]string) Option {
+	if articles == nil {
+		articles = DefaultArticles
+	}
+	return func(o *options) {
+		o.articles = articles
+	}
+}
+
+// stripArticle returns short with any leading article for lang removed,
+// provided something remains
+func (p *Parser) stripArticle(short, lang string) string {
+	for _, article := range p.opts.articles[lang] {
+		if len(short) <= len(article) || !strings.EqualFold(short[:len(article)], article) {
+			continue
+		}
+		r, _ := utf8.DecodeRuneInString(short[len(article):])
+		if !unicode.IsSpace(r) {
+			continue
+		}
+		rest := strings.TrimLeftFunc(short[len(article):], unicode.IsSpace)
+		if hasLetter(rest) {
+			return rest
+		}
+	}
+	return short
+}
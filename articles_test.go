@@ -0,0 +1,46 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArticles(t *testing.T) {
+	tests := []struct {
+		input string
+		short string
+	}{
+		{"The Acme Widget Company Ltd", "Acme Widget"},
+		{"THE  Acme Widgets Ltd", "Acme Widgets"},
+		{"The Acme Widgets", "Acme Widgets"},
+		{"Die Acme GmbH", "Acme"},
+		{"Die Acme Ltd", "Die Acme"},
+		{"Theodore Widgets Ltd", "Theodore Widgets"},
+		{"The Ltd", "The"},
+		{"De Beers Ltd", "De Beers"},
+	}
+
+	p, err := New(WithArticles(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.short, res.ShortName, "ShortName matches for %q", tc.input)
+	}
+
+	p, err = New(WithArticles(map[string][]string{"en": {"Ye"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := p.Parse("Ye Olde Shoppe Ltd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "Olde Shoppe", res.ShortName, "custom article stripped")
+}
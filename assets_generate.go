@@ -6,16 +6,73 @@
 package main
 
 import (
+	"io/ioutil"
 	"log"
 	"net/http"
+	"os"
 
 	"github.com/shurcooL/vfsgen"
+	"gopkg.in/yaml.v2"
 )
 
+// minimalLangs are the languages included in the minimal dataset
+var minimalLangs = map[string]bool{
+	"en": true, "de": true, "fr": true, "es": true, "it": true, "nl": true, "pt": true,
+}
+
+// writeMinimal writes the entries in the full dataset for minimalLangs
+// to data_minimal
+func writeMinimal() error {
+	data, err := ioutil.ReadFile("data/company_designator.yml")
+	if err != nil {
+		return err
+	}
+	var full, minimal yaml.MapSlice
+	err = yaml.Unmarshal(data, &full)
+	if err != nil {
+		return err
+	}
+	for _, item := range full {
+		entry, ok := item.Value.(yaml.MapSlice)
+		if !ok {
+			continue
+		}
+		for _, field := range entry {
+			if field.Key == "lang" && minimalLangs[field.Value.(string)] {
+				minimal = append(minimal, item)
+			}
+		}
+	}
+	data, err = yaml.Marshal(minimal)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll("data_minimal", 0755)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile("data_minimal/company_designator.yml", data, 0644)
+}
+
 func main() {
-	var fs http.FileSystem = http.Dir("data")
-	err := vfsgen.Generate(fs, vfsgen.Options{
+	// The full dataset, embedded by default
+	err := vfsgen.Generate(http.Dir("data"), vfsgen.Options{
+		PackageName: "gocd",
+		BuildTags:   "!gocd_minimal",
+	})
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	// The minimal dataset, embedded with the gocd_minimal build tag
+	err = writeMinimal()
+	if err != nil {
+		log.Fatalln(err)
+	}
+	err = vfsgen.Generate(http.Dir("data_minimal"), vfsgen.Options{
+		Filename:    "assets_minimal_vfsdata.go",
 		PackageName: "gocd",
+		BuildTags:   "gocd_minimal",
 	})
 	if err != nil {
 		log.Fatalln(err)
@@ -0,0 +1,186 @@
+// Code generated by vfsgen; DO NOT EDIT.
+
+// +build gocd_minimal
+
+package gocd
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	pathpkg "path"
+	"time"
+)
+
+// assets statically implements the virtual filesystem provided to vfsgen.
+var assets = func() http.FileSystem {
+	fs := vfsgen۰FS{
+		"/": &vfsgen۰DirInfo{
+			name:    "/",
+			modTime: time.Date(2026, 10, 16, 16, 21, 38, 28326638, time.UTC),
+		},
+		"/company_designator.yml": &vfsgen۰CompressedFileInfo{
+			name:             "company_designator.yml",
+			modTime:          time.Date(2026, 10, 16, 16, 23, 49, 388326638, time.UTC),
+			uncompressedSize: 7252,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x59\x4f\x6f\xe3\xba\x11\xbf\xe7\x53\xcc\x69\xb7\x05\xba\xdc\xfb\xde\x14\x6f\x9e\x93\x8d\xd6\x11\x2c\xc4\x01\x7a\x29\x68\x69\x6c\xb3\xa1\x48\x81\xa4\xfd\x90\x9c\xfa\x51\xb6\x40\x81\x22\x87\x77\x28\x5e\x7b\x7b\x37\x7d\xb1\x42\xa2\xfe\x90\x94\x64\xe7\xb5\x27\x93\xa3\xf9\xfd\x38\x24\x87\x33\x1c\x3a\x7a\x36\x0c\xc5\x1e\x35\x72\xae\xb3\x03\xdd\x99\x2f\x57\x00\x74\xbb\x55\xf5\xef\x27\x88\x96\x57\x00\x9c\x8a\xfd\x17\xc8\xf1\x2a\xd2\x32\x63\x34\x63\xd5\xaf\x02\x16\xec\xc4\x78\xa0\x4c\x16\xa4\x57\x47\x7d\x45\x75\xa3\x6f\x98\x14\xa0\xa9\xd0\xb0\x3d\x1a\xe0\xc7\x4c\x51\xc3\x76\x01\x34\xbd\x8e\x7b\xe8\x4e\x5d\x5d\xa3\xe6\xd2\xa0\x80\x13\x0a\x21\xa5\xa9\x6d\x2b\x7d\xc8\x35\xd9\x0c\xa3\x09\x5e\x37\x91\xe6\x5f\xc0\xa8\x23\x4e\xe3\xa1\x40\x03\x5b\x2c\x51\x3d\x1b\x04\x4a\x85\x2e\x15\x7d\x46\xce\xfe\xfa\x7c\x40\x96\x8f\xe9\xaf\x49\xe4\x0d\xb1\x38\x50\x65\x50\x61\xa0\xba\x38\x98\xdc\x99\xb8\xb8\x5a\x70\xa9\x31\x87\x6f\x92\x09\x03\xa9\x91\xd9\x33\x2c\x64\x51\x52\xf1\x12\x00\xbf\xa5\x8b\xa6\x91\x28\xdb\xea\x19\xbc\xc9\x2c\x64\x51\x50\x91\x33\x43\x99\xc2\x33\x2b\xb2\x38\xb7\x22\x81\x01\x7f\xd1\x26\xff\xd2\x59\xe5\xb3\x48\xd2\xfc\x7e\xfc\x50\x37\x3f\x36\x6d\x2a\xf2\x5e\x6e\xdb\x1d\x6e\x98\xb3\x94\x25\xd6\x3b\x7b\xa2\x90\x23\xac\x51\x97\x52\x68\xba\x65\x9c\xe5\x34\x47\x88\x59\xc1\x0c\xcd\x69\x60\xf2\x7a\xd8\xf6\xd2\x38\x24\x18\xe8\x49\x59\x92\xb6\xf5\xc9\x36\x9d\x91\x55\x29\x55\xe3\x66\x21\x48\xf9\x9a\xc8\xc4\x1e\x8d\xa2\x7b\x14\x08\x4b\x14\x52\x6b\x14\x53\x6e\x8f\x64\x49\x5c\xc7\x77\x81\x0a\xee\xe9\x71\x57\x50\x21\x42\xcc\xfd\x2c\x46\xc3\x0d\x13\xaf\xc8\x8f\xc2\xa0\x12\x78\x28\x70\x04\x7e\x9c\x05\xc3\x06\x15\xb2\x11\x60\xe3\x01\x6e\x50\x94\xa8\xb4\x94\xf5\x31\xfb\xbf\x9d\xff\x86\x4c\xb9\xbf\xe7\x4f\x37\x45\xa9\x50\x53\x48\xeb\x23\xce\x21\x47\x0e\x37\xda\xd0\x5c\x86\x4c\x29\xb9\xf1\xa2\x82\xc7\xb2\xc7\x02\x99\x10\xd5\x6f\xe6\x95\xed\x11\x96\xc5\xf6\xd6\xc7\xef\x6b\x91\x3b\xd1\xa5\x13\xab\xa0\x60\xf5\x9c\x74\x76\x50\xd5\x3f\xc4\xb3\x41\x05\xb7\x74\x67\x8e\x62\xef\xbb\x79\xcb\x31\xb0\xb6\x02\xdb\x80\x0f\xbd\x6f\x37\xdd\xa3\xe3\xec\x05\xd9\x92\xdb\xf6\x1b\x6a\xe2\x77\x1d\x3b\x06\xb9\x3d\x36\x0d\x93\x3d\x3b\x2d\x1d\x9c\x9d\xc8\xb6\xfa\x4d\xed\x51\x71\x96\x1d\x50\xc0\x1a\xb3\x83\xd1\xfe\x42\x2c\xb7\x6b\x17\x7d\x27\xb2\xd6\xed\xc3\x68\x74\x27\xb2\xee\xa4\x10\xb7\xd3\x9c\xd8\x4e\xd0\x1f\x89\x8e\xa7\x7a\xc3\x49\x9a\x3e\x22\x5f\x0c\x66\xe7\x22\x58\x00\x2e\x50\x35\x4e\x73\x4d\xc5\xf3\x88\xe4\x7a\x96\xe5\x5e\x72\x8e\xcf\x86\x9d\xe6\xf3\xd5\xbd\xe4\x5e\xc6\xba\xef\x43\xe7\x85\x44\x77\x2f\x8b\x68\x1a\x39\x85\xb1\x6e\x75\xbf\xf4\x29\x96\xed\xcf\xa4\x57\x35\xdd\x4e\xc7\xf5\xb3\x4e\x36\x52\x9a\xd0\x89\x96\xbe\x4a\xb4\x0c\x35\x62\x93\x13\x57\xe7\xfc\x7c\x80\x1e\x77\x60\xef\x00\xc1\x72\x2c\x69\x34\xb6\xdd\x95\x0d\xe3\xd2\x28\xb4\x6d\x90\x04\x5a\xbd\x35\x4d\x36\x08\x3d\x37\x0e\xd2\x68\xab\x34\xed\x6e\xb1\xbd\x6c\x58\x54\xbf\xd4\x6d\xa7\xcb\x4e\xf6\x10\xb4\xbc\x4d\xe7\x4f\x4e\xa7\xd1\x82\xb1\xc0\x0e\x3b\x65\x49\x42\x95\x11\xa8\xf4\x81\x95\xa1\x35\x49\x40\x62\x05\x23\x86\x98\x35\x29\xd1\xbc\xc0\x64\x42\x8e\xe3\x85\xcf\x3b\x03\x63\xa8\xdb\x71\xfb\x75\x88\xd2\x85\xdb\x6d\x26\x3e\x74\x3f\x7e\x70\x24\x5e\x52\x1f\xb4\xa6\x4f\xdd\xd8\x84\x33\xab\x70\x79\xda\xef\x58\xca\x19\x9a\x51\x88\x8a\x6b\x91\x1b\xa3\xbe\x53\x3a\x75\x2f\xfa\x6e\xf4\x6c\x1a\x5b\x51\x5a\x70\xf9\x7a\xee\x5a\xb5\xb2\xa9\xd6\x36\x60\x75\x6a\xda\x29\x89\xc8\xe7\xfe\x4b\x1a\x7d\x5e\x6d\xce\x8c\x51\xdf\x4d\x28\x87\x68\xb8\x10\x87\x43\x44\xde\x7c\x57\x72\x58\xb1\x40\x33\x76\xf5\xe4\x6e\x67\xaf\x32\x73\x61\xed\xc1\x8b\x01\xad\xfa\x2d\x15\x39\x72\x3d\x1f\x0c\x1f\x6e\x97\xfe\xe1\xf7\x25\xf6\x50\xb7\xb2\x9e\xfc\xa1\x44\x71\xee\xca\x6b\x1d\xfc\xc1\xa6\x09\x67\x2c\x2b\xb0\x0e\xfb\x10\x24\x91\xce\x49\x1a\x1b\xcf\x18\x5c\xeb\x79\xc6\x24\x8a\x9d\xa8\x41\x98\x8c\x33\x89\x41\x27\x28\x24\x27\x43\x46\xa1\x27\xc0\x4f\x87\xa0\x77\xf1\xc8\x1d\x6a\x6d\xb7\x7f\xf6\xa2\x9a\x90\xc5\x3c\xea\x62\xe4\x68\x39\x6c\xe8\x70\x39\x4a\xc5\xd0\x50\xf5\x32\xb7\x0a\x2f\xae\xf5\x9f\x63\x47\x6a\xf5\xed\x97\x3f\xd4\xa2\x3f\x7a\x41\x34\x90\x0d\x63\x1e\xb7\x9c\x65\x97\x9d\x20\x09\x9d\x20\x21\xdf\x48\xda\x85\xaa\x64\x68\xd6\x63\x39\xdf\xc2\x81\xce\xee\x4e\xc9\x33\xfb\x4b\x38\xf1\xef\x3c\x29\x13\x7b\x8e\xf0\x1d\x8b\x2d\x2a\x78\xd7\x56\xa7\xdf\xbd\xdd\x1e\xa8\x64\xc6\x30\xa7\x39\x44\xa2\xfa\x55\xb0\x22\x28\x6f\x52\x7b\xae\x6d\x03\x72\xf4\x4b\x34\xd4\x63\x3c\x7c\xc5\x52\xaa\xba\x8a\x1a\x33\x7d\xbd\x00\x8d\xe9\x56\x2a\xca\xc7\xc0\xf8\x02\xf0\xfa\xa8\x74\xf5\x77\xc3\x78\x63\x22\x2d\x99\xa1\x1c\x36\x54\x31\xba\xe5\x38\xa6\xbb\x26\x4e\xf3\xec\xac\x72\x84\xe8\x20\x95\x92\xf0\x02\x89\x42\x6d\x68\x21\xc7\x7c\xc9\x05\xf3\x12\x25\x0b\x69\xa4\xa2\x35\xdf\x9d\x38\xa1\xaa\x8f\xc6\xfb\x6c\x4d\xc8\x1d\xf1\x3a\xef\xdb\x85\x47\xc1\x9a\x72\x4a\x4c\x2d\xe7\xe3\x34\x78\x21\x39\x66\x53\x3b\xd7\x3a\x73\x2a\x33\xb2\x90\x7c\xe8\x40\xdb\x9b\xa2\x6a\x6f\xad\x8a\x8d\xd9\x14\x9d\x03\xf5\x15\xf8\x08\x23\x65\x39\x0d\x72\x16\xb1\x5e\xdb\xfc\xa8\xcd\xd4\x98\x04\xc9\xdd\x1c\xc1\x74\xe1\x17\x96\x7d\xee\x88\x4b\xaa\xa8\x30\xd5\x2f\xb4\xae\x74\x58\xa9\x64\x36\x1a\x71\x49\xd6\xd3\x70\x14\xc3\xea\x40\xca\x8a\x72\xbc\xeb\x8d\x0e\xf1\x3a\x67\x77\xdd\xa3\x2c\xa5\x82\x28\xcb\x98\x14\xa8\xa7\x89\xad\x0a\x99\x92\x5d\x3a\x0c\xcd\xab\x48\xff\x28\x32\xf3\x26\x92\x92\xb5\x3d\xb1\x5d\xd3\x61\xad\x45\x0d\x11\x89\xc3\xee\xa5\x29\xae\x64\xb1\x55\xd8\x7b\x69\xb0\x5d\x2f\x90\xd5\x71\xaf\xfa\x57\xf5\x0b\x1d\x09\x3c\x03\x5e\x40\x1f\x33\xd4\x52\xa1\x0e\xfb\x67\x4d\x98\x9b\x6c\x7c\x5e\x7d\x2e\xaa\xc5\x17\x81\xab\x23\x9e\x28\xb4\xef\x14\x63\xf8\x8a\xdc\x5c\x20\x48\x66\x22\x40\x4c\x92\x0b\xc8\x33\xe1\x23\x9e\x0d\x1f\xf5\x83\xaa\xcd\x46\xf9\xc4\x01\x4c\xce\xa2\xa8\x32\x2c\x3b\x72\xaa\xde\x0b\x44\xa0\xa2\xfa\xf7\x6c\xc6\xea\x5f\xe4\x06\xfd\x9f\x30\x3b\x4c\x58\xf6\xd3\x8c\xfa\x12\x75\x17\xb2\xad\x75\xac\xa4\xd5\x3f\xab\xff\xa0\xb6\x4f\x46\x2c\x3c\x5d\xcb\x24\x9d\x66\xe2\x93\x8e\x13\x9b\x9c\x92\xa1\x46\xa2\x39\x1d\xa1\x4d\xf5\x03\x4a\x54\x10\xbd\x32\x29\x58\x68\x78\xd9\xe5\x66\xe7\x5a\x36\x88\x2d\x13\x73\x98\x28\x28\xe7\x4d\xb3\x96\x58\xc3\xcc\x68\x49\x14\xe1\xd3\x14\xd9\x10\xa1\x7f\x07\x5d\x36\x49\x58\xbd\x99\xea\x0d\xaa\x1f\x01\xcd\x1b\xf0\xc9\x5a\x29\x25\xb5\x2a\xe9\xb2\x0f\xa9\xfe\xe6\x46\x99\xc8\xe9\x54\x3f\xd6\xf6\x2a\x98\xae\xbd\x27\xf9\x61\x50\x2a\xa4\x78\x29\xd0\xbf\xd3\xa5\xd1\xbc\x1f\x79\xe8\xac\x7f\xff\x41\xc8\xa8\xa0\x39\x43\x21\x70\xec\xb7\x8b\x19\x3c\x8a\x86\xa2\x09\xd7\x21\x6c\xf1\x0e\x08\xe8\xa9\xa4\x71\xb3\x48\x67\xb1\x42\x16\x90\xd5\x6f\x4f\xd9\xe8\x7f\x8b\x74\x35\x33\x64\x49\x15\xd0\xac\xf6\xa9\x26\x2a\xaa\xea\x6d\xcf\x0a\x84\x5d\xf5\x96\x57\x6f\x93\x97\xb4\xb5\x7b\x94\x66\xb9\x1a\xe3\xd9\x8e\x8d\x37\x38\x9a\x99\x40\xa9\xd8\xa9\x7a\xc3\xdf\xe3\x2a\x49\xe7\x0e\xff\x1b\x1d\x1c\xbb\xf0\x27\x90\xf3\x19\xf6\x47\x8f\xff\x51\xf0\xee\x5d\x60\xa8\x5b\xc6\xf5\x8a\x7b\xf5\x1e\x20\x6e\x65\x34\x53\x61\x3d\xfa\xc5\x51\xfd\xa2\x2e\xd8\x9e\x89\x3d\xbc\x4a\x91\xa3\x82\x9f\x99\xd0\x46\xca\x7d\x81\x2a\x78\x92\xdc\xfc\xf9\x69\xb6\xb2\xb7\x2f\xf3\x6c\x7f\x14\x7b\x90\x87\xa6\x12\xff\x99\x09\x81\xea\x95\xd5\x8f\xfd\x7b\x4d\xb7\x9a\x65\x87\xa0\x5a\xdd\x48\xaf\x56\xdd\x04\x2f\x0f\x73\x43\x0d\x5a\x60\x6d\xde\x31\x15\x86\xef\x0d\x79\xb0\x6e\xf4\x09\xbe\xfa\x6f\x1a\x2e\x66\x76\x90\x27\x66\x0e\xe3\x82\xd3\x1f\xe2\xa9\x4b\xbd\xb6\x3c\x7b\x0a\x32\xb1\xb8\xfa\xef\x00\x54\x99\x34\x30\x54\x1c\x00\x00"),
+		},
+	}
+	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/company_designator.yml"].(os.FileInfo),
+	}
+
+	return fs
+}()
+
+type vfsgen۰FS map[string]interface{}
+
+func (fs vfsgen۰FS) Open(path string) (http.File, error) {
+	path = pathpkg.Clean("/" + path)
+	f, ok := fs[path]
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+	}
+
+	switch f := f.(type) {
+	case *vfsgen۰CompressedFileInfo:
+		gr, err := gzip.NewReader(bytes.NewReader(f.compressedContent))
+		if err != nil {
+			// This should never happen because we generate the gzip bytes such that they are always valid.
+			panic("unexpected error reading own gzip compressed bytes: " + err.Error())
+		}
+		return &vfsgen۰CompressedFile{
+			vfsgen۰CompressedFileInfo: f,
+			gr:                        gr,
+		}, nil
+	case *vfsgen۰DirInfo:
+		return &vfsgen۰Dir{
+			vfsgen۰DirInfo: f,
+		}, nil
+	default:
+		// This should never happen because we generate only the above types.
+		panic(fmt.Sprintf("unexpected type %T", f))
+	}
+}
+
+// vfsgen۰CompressedFileInfo is a static definition of a gzip compressed file.
+type vfsgen۰CompressedFileInfo struct {
+	name              string
+	modTime           time.Time
+	compressedContent []byte
+	uncompressedSize  int64
+}
+
+func (f *vfsgen۰CompressedFileInfo) Readdir(count int) ([]os.FileInfo, error) {
+	return nil, fmt.Errorf("cannot Readdir from file %s", f.name)
+}
+func (f *vfsgen۰CompressedFileInfo) Stat() (os.FileInfo, error) { return f, nil }
+
+func (f *vfsgen۰CompressedFileInfo) GzipBytes() []byte {
+	return f.compressedContent
+}
+
+func (f *vfsgen۰CompressedFileInfo) Name() string       { return f.name }
+func (f *vfsgen۰CompressedFileInfo) Size() int64        { return f.uncompressedSize }
+func (f *vfsgen۰CompressedFileInfo) Mode() os.FileMode  { return 0444 }
+func (f *vfsgen۰CompressedFileInfo) ModTime() time.Time { return f.modTime }
+func (f *vfsgen۰CompressedFileInfo) IsDir() bool        { return false }
+func (f *vfsgen۰CompressedFileInfo) Sys() interface{}   { return nil }
+
+// vfsgen۰CompressedFile is an opened compressedFile instance.
+type vfsgen۰CompressedFile struct {
+	*vfsgen۰CompressedFileInfo
+	gr      *gzip.Reader
+	grPos   int64 // Actual gr uncompressed position.
+	seekPos int64 // Seek uncompressed position.
+}
+
+func (f *vfsgen۰CompressedFile) Read(p []byte) (n int, err error) {
+	if f.grPos > f.seekPos {
+		// Rewind to beginning.
+		err = f.gr.Reset(bytes.NewReader(f.compressedContent))
+		if err != nil {
+			return 0, err
+		}
+		f.grPos = 0
+	}
+	if f.grPos < f.seekPos {
+		// Fast-forward.
+		_, err = io.CopyN(ioutil.Discard, f.gr, f.seekPos-f.grPos)
+		if err != nil {
+			return 0, err
+		}
+		f.grPos = f.seekPos
+	}
+	n, err = f.gr.Read(p)
+	f.grPos += int64(n)
+	f.seekPos = f.grPos
+	return n, err
+}
+func (f *vfsgen۰CompressedFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		f.seekPos = 0 + offset
+	case io.SeekCurrent:
+		f.seekPos += offset
+	case io.SeekEnd:
+		f.seekPos = f.uncompressedSize + offset
+	default:
+		panic(fmt.Errorf("invalid whence value: %v", whence))
+	}
+	return f.seekPos, nil
+}
+func (f *vfsgen۰CompressedFile) Close() error {
+	return f.gr.Close()
+}
+
+// vfsgen۰DirInfo is a static definition of a directory.
+type vfsgen۰DirInfo struct {
+	name    string
+	modTime time.Time
+	entries []os.FileInfo
+}
+
+func (d *vfsgen۰DirInfo) Read([]byte) (int, error) {
+	return 0, fmt.Errorf("cannot Read from directory %s", d.name)
+}
+func (d *vfsgen۰DirInfo) Close() error               { return nil }
+func (d *vfsgen۰DirInfo) Stat() (os.FileInfo, error) { return d, nil }
+
+func (d *vfsgen۰DirInfo) Name() string       { return d.name }
+func (d *vfsgen۰DirInfo) Size() int64        { return 0 }
+func (d *vfsgen۰DirInfo) Mode() os.FileMode  { return 0755 | os.ModeDir }
+func (d *vfsgen۰DirInfo) ModTime() time.Time { return d.modTime }
+func (d *vfsgen۰DirInfo) IsDir() bool        { return true }
+func (d *vfsgen۰DirInfo) Sys() interface{}   { return nil }
+
+// vfsgen۰Dir is an opened dir instance.
+type vfsgen۰Dir struct {
+	*vfsgen۰DirInfo
+	pos int // Position within entries for Seek and Readdir.
+}
+
+func (d *vfsgen۰Dir) Seek(offset int64, whence int) (int64, error) {
+	if offset == 0 && whence == io.SeekStart {
+		d.pos = 0
+		return 0, nil
+	}
+	return 0, fmt.Errorf("unsupported Seek in directory %s", d.name)
+}
+
+func (d *vfsgen۰Dir) Readdir(count int) ([]os.FileInfo, error) {
+	if d.pos >= len(d.entries) && count > 0 {
+		return nil, io.EOF
+	}
+	if count <= 0 || count > len(d.entries)-d.pos {
+		count = len(d.entries) - d.pos
+	}
+	e := d.entries[d.pos : d.pos+count]
+	d.pos += count
+	return e, nil
+}
@@ -1,5 +1,7 @@
 // Code generated by vfsgen; DO NOT EDIT.
 
+// +build !gocd_minimal
+
 package gocd
 
 import (
@@ -19,21 +21,21 @@ var assets = func() http.FileSystem {
 	fs := vfsgen۰FS{
 		"/": &vfsgen۰DirInfo{
 			name:    "/",
-			modTime: time.Date(2022, 2, 9, 6, 26, 59, 581800015, time.UTC),
+			modTime: time.Date(2022, 2, 9, 6, 28, 33, 0, time.UTC),
 		},
 		"/company_designator.yml": &vfsgen۰CompressedFileInfo{
 			name:             "company_designator.yml",
-			modTime:          time.Date(2022, 2, 9, 6, 25, 36, 607622254, time.UTC),
-			uncompressedSize: 14527,
+			modTime:          time.Date(2026, 10, 16, 17, 25, 10, 88326638, time.UTC),
+			uncompressedSize: 15007,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x5b\xcd\x73\x1b\xc7\x95\xbf\xe3\xaf\xe8\xe2\x21\x70\xaa\xe2\xf1\x5d\x97\x2d\x10\xa2\x41\x09\x14\x89\x22\x24\xba\xec\xcb\x56\x63\xe6\x01\x68\xce\x4c\xf7\xa4\xbb\x87\x2c\xf0\xb0\x25\x53\x76\xe2\xac\xa5\x98\xd9\x58\x9b\xd8\xeb\xa5\x45\x3b\x5b\x29\x64\xed\x2a\xc9\xfa\x28\xc5\x94\x94\xc3\x88\xf7\xc1\xcd\x7b\x17\x15\x6f\xad\xfc\x3f\x6c\xcd\x0c\xe6\xb3\x7b\x40\x3a\x9b\x2d\x1d\x38\xd3\xe8\xdf\xef\xbd\xd7\x1f\xef\xbd\x7e\x3d\x6a\xd9\x26\xd9\xf6\x91\xc0\x03\x02\x16\x7f\xfe\x9f\x03\x7c\xa1\x81\x10\x1e\x0c\x78\xf4\x17\xa1\xd7\x51\xab\xdf\x40\xc8\xc1\x74\x74\x01\x39\x3b\xd1\x23\x60\xeb\x02\x7a\xbb\xd1\xb2\xc5\x36\x08\x70\x84\x8d\xbd\x05\x98\x25\xca\x96\x1a\x2d\x5b\x12\x18\x30\x07\x8f\x94\x9e\xcb\x59\x4f\x01\x49\x3f\x3a\x8a\x68\x1d\x61\x8e\xf1\x50\x2a\xfd\x3b\x59\x7f\x6b\xde\x3f\xd1\x61\x50\xed\xd9\x6c\xbd\xd1\x6f\xaa\xfa\x58\xb8\xd1\x72\x1c\x17\x28\xc5\x0b\x0d\x68\x95\x2d\x68\xb6\x28\xa3\xc4\x45\x1b\x5c\x62\xdb\x79\x7e\xcf\x6e\x2a\x10\x63\xc3\xc8\x30\x92\x67\x88\x93\x43\xc2\x6d\x90\x4d\x55\x3f\xe3\xe4\xd0\x68\x16\x21\x2d\xea\x61\x2e\x45\x8d\x41\x2d\xaf\x64\x45\xb3\x25\x98\x49\xb0\x49\x82\xfb\x14\xb5\xc9\x0e\x71\x34\x2a\xb5\x73\x95\x40\x34\xb0\x88\x21\x92\x30\x8a\x04\xa6\x02\x0d\x7c\x89\x1c\xdf\xe4\x58\x92\xa1\x3a\x04\xcb\x6b\x19\x78\xc8\x1b\xcd\xf0\x20\xfc\x76\xf6\x8b\xf0\x71\xf8\x2c\x7c\x1a\x3e\x9c\x5d\x0f\x9f\x86\xcf\x50\xf8\x60\x76\x7d\x76\x23\x7c\x14\x3e\x9c\xbd\x3b\xdb\x0f\xbf\x09\x9f\x29\x5a\x2c\x85\x07\xe1\xc7\x4b\x29\xeb\xc5\x8c\x73\x30\x6a\x34\xc3\x8f\xab\x70\x34\x7b\x17\x85\x87\xe1\xfd\xd9\xf5\xf0\x6e\xf8\x34\x7c\x3c\xfb\x65\xf8\x30\x7c\x1a\xde\x45\xe1\xe1\x6c\x3f\xbc\x1f\x3e\x8b\x3a\x25\xc2\x23\x88\x46\xda\x61\x78\x98\xcb\xdb\xd8\xa8\x08\xbc\x1d\x3e\x88\xb0\xe1\x71\x4c\x1d\x9b\xa0\xb5\xeb\xe3\x73\xd8\x75\xbb\x68\xd9\x4a\xeb\x6c\x49\xff\x0f\xd6\xde\x2e\xdb\xbb\xa2\x18\x1c\x5b\x37\xfb\xd7\x82\x75\x0f\x51\xf8\x45\xcc\x7c\x77\x76\x3d\x7c\xbc\x70\xde\xbe\x58\xca\x5e\xbe\x08\x0f\xc3\x7f\xc9\xa6\xf1\xea\xfc\xe1\xea\xc6\x56\x26\xce\xb7\x0b\xee\x41\xbb\x5c\x22\xd1\xcf\xc2\x7b\xb3\x5f\x9d\xb1\x5c\x0e\x33\x39\x1b\x19\x3b\xf7\x0b\xec\xcb\xc0\xc7\xd8\xaa\x42\x97\xc7\x56\xbe\xde\x5d\xd1\x58\x06\xe1\x30\x09\x14\xed\x00\xa5\x8c\xc9\xc8\xa5\x28\x5b\x7d\xd9\xd8\xca\x41\xd4\x29\x9a\xa0\xc5\x23\x17\x24\x1a\x80\x07\xdc\x96\x80\x30\xa6\xc2\xe3\xd8\x06\x87\x6c\xdb\x63\x20\x56\x53\xc7\xbf\x6c\xb4\x4a\x32\x9a\xcb\x20\x83\xa9\x24\xe8\x6a\x70\xc4\x05\x16\xc1\x91\x0a\x93\x39\x62\xec\x37\xda\x63\xcc\x25\x70\x50\x6c\x6e\x8f\x65\xc1\x68\xa0\x8d\xb6\xc3\x04\x58\xe8\x32\x23\x54\xa2\xbe\x64\xa6\x8d\xda\xcc\xf5\x30\x9d\x28\xd0\xcb\xfd\xf6\xfc\xb1\xc7\x93\xe7\x8c\x25\x1f\x85\x36\x73\x5d\x4c\x2d\x22\x31\xe1\xb0\x70\x24\xdb\xb5\x23\x59\x51\xe0\x1f\x85\xb4\x2e\xa4\x5a\x55\x49\x98\x91\x3a\xc8\x9f\x44\x2f\xa9\x0b\xc7\xd4\x2a\xfc\x96\xbc\xa5\xf8\xdc\x76\xc6\x3c\x88\xbc\xd9\x0e\x46\x16\xa0\x4d\x10\x1e\xa3\x51\x6c\x73\x88\x85\x2d\x40\x6b\xc4\x25\x12\x5b\x4a\x8c\x6b\x6f\xe6\xae\xce\x93\x05\x1a\x50\x7a\x32\xe6\x19\xd9\xf3\xeb\xc9\x4b\x41\x3e\xf7\x18\x8f\x1d\xac\x0a\xe4\x95\xbe\xbb\x2e\x25\xa8\x3d\x19\x4e\xe8\x08\x2c\x32\x42\xed\xc9\x98\x81\x65\xf9\x42\x81\x9a\x66\x06\x34\x27\x8d\x1c\xa2\x74\x9c\x0c\x8b\x1d\x9b\x17\xc1\xa1\xe4\xe4\xc8\xc6\xc8\xe2\xfe\xc9\xb3\x01\x56\x56\x99\x65\x14\x16\x8f\x70\x1a\xcd\x8b\x49\x47\xb4\x87\x28\x30\x17\xb6\x81\x32\xc4\xac\x11\xdb\x61\x9c\x32\x21\xb7\x99\x86\x82\x1a\xac\x8e\xe4\xbc\x14\xac\x4a\xd1\x65\xc9\xa2\xa3\xf5\xba\xdb\x55\xdd\xfb\xd8\x65\x42\xb2\x6d\x4a\x90\xc7\xac\x6d\x90\x94\xa8\x61\x5a\x18\x5e\x19\xb5\x32\x9a\x04\xd3\x68\x26\x82\xe9\x48\xe9\x0d\x86\x59\xda\x86\x59\xef\xad\xe0\xc8\x71\xb0\x63\xb3\xbd\xe0\xbe\x06\xb5\x53\x42\x01\xa1\x23\x90\x1c\x8f\x80\x02\xea\x00\x65\x42\x00\xd5\xe7\x37\x60\x74\x8c\x62\x86\x53\x84\x72\xd4\xc5\xfe\xd0\xc5\x94\xaa\xa8\x6e\x2d\x4a\xa0\x15\x42\xf7\xc0\xf1\xa9\x04\x4e\x61\xec\x82\x06\x7e\xad\x16\x8e\xb6\x80\x03\xd1\x40\xb6\x4a\x90\x26\x10\x6a\xe3\xb1\xe3\x4b\x3c\x0c\xa6\x0e\xd6\x0c\xe5\x78\x98\x23\x88\x68\x34\x57\x80\x7a\xc0\x05\x63\x51\x22\xf2\xf7\xf0\xb4\x2b\x86\xce\xd7\xe6\x5e\x68\xc5\xf5\x38\x08\x8c\xfa\x51\x12\xe4\x20\x0b\x1c\xb4\x22\x24\xb6\x98\x4a\xd4\x37\x56\x4a\x99\x53\x21\x28\x8c\xc0\x05\x42\x69\xf0\x44\xee\x91\x11\xa0\x8e\x3b\x58\x55\x34\x19\x45\xad\xa5\xe1\xe9\x14\x72\x5a\xe4\x92\xc8\x2e\x61\x8e\x79\xf0\x07\x6a\x4b\xe0\x68\x15\x0f\xa5\x4f\xf3\x41\x4b\x3c\xe4\x9c\xa5\xc8\x3c\x6f\x4a\x1f\xd1\x4f\x0a\x4e\x31\x6e\xf0\x4b\x7e\xd2\x35\x06\xc6\x6a\xf6\x3b\x08\xa3\xda\x50\x50\xaa\xf8\x4b\xe2\x7b\x13\xe3\xe6\x4d\x73\x62\xb4\xd8\xb4\x41\xf0\x84\x8f\x80\x3b\xc4\x1c\x03\x45\x9b\x60\x8e\xa5\x50\x86\xa7\x33\xd8\x2c\x31\x84\xbf\x8d\xd3\x9b\x1b\xe1\x83\x28\x25\x99\x67\x06\x51\x1e\x94\xa4\x0c\xb3\x1b\x71\x6a\xb4\x1f\xe7\x2b\xf3\x2c\xe2\x2f\xb3\xeb\xe1\xc3\xf0\x41\xfc\xf7\xf1\xec\xa3\xd9\x7e\xf8\x38\x7c\xa8\x08\x0a\x7f\x1b\x7e\x9e\xca\xec\xe5\x6d\x5f\xe6\xad\xd7\x7a\xfa\x04\x63\x15\x53\x0b\x1c\xa1\x3d\xb3\xac\x96\xce\x2c\xcd\x45\x8b\xbe\xba\xe6\x57\xb1\x63\x63\xd4\x0a\xfe\xf8\xfc\x9e\x8d\xce\x3c\x52\xac\xb6\x0a\xf9\x8f\xe4\x8d\x4b\xd4\x9c\x07\x18\x35\x0f\xb8\x44\xcd\x3c\x2e\x19\xe5\xd7\x38\x4e\xa6\x4d\x59\x00\x5a\x4a\xe9\x82\x29\x2c\xd5\xd0\x15\xb2\xff\xdf\x87\x4f\xc3\x07\xe1\xe3\xf0\x9b\xf0\x71\xf8\x60\x76\x23\xbc\x1b\x1e\xcf\x6e\x86\x4f\x67\x1f\x86\x7f\xae\x4c\x47\x34\x5b\xe1\x93\xf0\xee\x6c\x3f\x7c\x18\x75\x52\xa7\xe5\xf7\xd9\x04\x5c\xaa\x19\xff\xe6\x65\xdf\xb1\x09\x05\x8a\x98\xc0\x36\x4c\xc6\x92\x04\x8f\x14\xa2\x8d\xc9\x76\xae\x24\x69\x9c\x23\xe7\xa9\x4d\x73\x2a\x58\x17\x78\xec\x24\x96\x31\xb5\x35\x1c\xcb\x7a\x92\x2e\x73\x1c\xb0\x25\xd9\x59\x74\x84\xed\x32\xa7\x74\x88\x6d\x76\x59\x0c\x1a\xd6\x1f\x15\x23\x5e\x03\x9d\x1c\x4a\x52\x3e\x30\x66\x61\x72\x11\xd4\x4d\x90\x25\x60\x37\x4d\xea\xb4\x0b\xbc\x5b\x5a\xe0\x59\x5f\x7c\xe6\xe9\xbc\xcb\xdc\xf2\x01\x3d\xc3\xea\x50\x89\x93\xeb\x76\xaa\x24\x9d\xec\x41\xf5\x71\xcd\xbc\x05\x75\x3b\xcd\x52\x6b\xea\xa1\x0a\xed\xda\xce\x35\x7d\x5b\x1d\xb5\x6b\xab\xa3\xeb\xb9\x26\x2d\xa3\xd4\x77\xb1\xbd\x08\xfb\x43\x94\x94\x36\x94\x01\xeb\xe0\x96\xd6\x32\xdc\xaa\xb3\xad\xf0\x4b\x41\xe3\x72\x6b\xb5\xb7\xaa\x5f\x5d\xd1\xa4\x9b\x14\x4d\xf2\x2a\x43\x37\xcd\x85\xeb\x97\x67\x97\x15\xd3\xe0\x64\x59\x72\x27\x38\x92\xcc\x91\xe8\x4d\x70\xc0\x39\xf9\x8d\x10\xc1\x74\x74\x72\x2f\x3f\xf0\xa8\xbe\xb2\x3b\x2c\x1f\x79\x9a\xdd\xe0\xd1\xde\x18\x8b\x3d\x1a\x7c\xbb\x10\x37\x96\xe9\xea\x58\x67\xd4\xe3\x6c\x48\xa4\x9e\xcc\x06\x0e\x02\x8a\xc7\x2e\x0d\x9b\xad\x01\x06\x8f\x44\x9a\xf1\x61\x8d\xfd\xe5\x6c\x2f\x3e\x64\xa8\x0e\x7a\xad\x72\x4e\x9b\x77\xab\x73\x54\x6b\x49\xed\x26\x45\x16\xb6\xc0\xfc\x35\x3d\xfc\xa4\x1e\x7f\xce\x3f\x7f\xfd\x59\xe9\x35\x09\x00\xba\xa6\x44\x89\xa2\x5e\xcd\x54\xb1\xfa\xb8\xb4\x56\x2d\x75\xa5\x90\x5a\x2f\x14\xeb\x9c\xf9\xaf\xa4\x49\x29\x7f\xa5\x2c\x3d\xcc\x25\x05\x2e\xc6\xc4\x53\x25\xf7\x14\x13\x92\x26\x65\x5c\xd7\x48\x7c\xee\x93\x13\xa4\x3d\x7d\xae\xad\xb5\xab\xdc\x35\x40\x12\xe7\x7f\x89\xf4\xc2\xac\xb4\xfa\xed\x72\x43\x3c\x09\xc5\x86\x79\x12\x95\xb4\x55\xce\xb1\x79\x4f\x4d\x18\x51\x35\x59\x38\x24\x67\x8f\xc0\xb9\x46\x56\x25\x4a\xe6\x35\x98\x82\x6e\x42\xa3\xe6\x62\x86\x70\x05\x63\x7d\x59\xe0\x8a\x14\xfa\x7c\x7c\x1d\x63\xd7\x61\x7b\x8b\x8b\x0a\xeb\xc9\x41\x23\x7d\x44\xeb\x3b\xf3\xb7\xbe\xd1\x32\xde\x28\xfc\xda\x6f\xbd\xb1\xbe\x55\x27\x28\x3a\x91\x63\x07\xb5\xf2\x02\xa8\x2a\xa7\x55\xb2\x7d\x9d\xe5\xe3\xa7\xf4\x5d\x2b\x8d\xd2\xfa\x84\x38\x3b\xc1\x11\x65\x02\x53\x74\xe5\xe4\x9e\x1d\x3c\xb2\x4e\x7e\x83\x36\x83\xa9\xd8\xdb\x09\xa6\x74\x22\xeb\xdd\xcd\xfa\x84\x57\xfc\x4d\x78\x58\x2e\x90\xc5\x25\xc2\x67\x4a\x89\x30\xca\x83\xff\x8c\xc2\x67\x49\xae\x3c\xdb\x2f\x67\xcd\xd1\xdb\xec\xe6\xec\xd7\x6a\xea\x75\x18\xfd\xcb\x8a\xa3\x35\xe5\x35\x36\x1c\x26\x47\xd5\xfa\x50\xbf\x51\x8a\xf3\x73\xc0\x3c\x6b\x5e\x94\x22\x6c\xac\x76\x94\x80\x17\x35\xea\xc2\x5d\xda\x9e\x89\xd9\xf0\x80\x2e\xaa\x6c\x25\x5b\x7b\x23\x49\xf2\x4a\x52\xf3\x52\x57\xb4\xf9\x36\x2a\x69\x60\x93\x79\x84\x0e\x80\x4b\xb4\x28\x99\x67\xd5\x6c\x7e\x63\x61\x6a\x5a\xcc\x4c\x9b\x71\x3d\xf7\xdb\xd9\xf5\xd9\x87\xb3\xfd\xe4\x04\x73\xf7\x6f\x2b\x8f\x86\x87\xe1\x41\x3e\x81\xf1\xf9\xa0\x60\xa0\x66\x2e\xd3\x3d\x1f\xcf\xc6\xc2\xa9\x89\x7a\x96\x66\xb5\x07\x5c\x00\x67\x98\xa2\xab\xc0\x07\x58\x62\xa5\x34\xd5\xbb\x9a\x0f\x88\xa5\xe9\x1f\x3f\xf8\x36\x56\x71\xe8\xea\xc0\x2e\x61\x39\xd9\xc1\x12\x50\x4d\xf4\xec\x49\x28\x85\xb8\xde\x8e\x34\x94\x90\x5a\xe1\xa8\x0b\xad\xe7\xe4\x62\x43\x10\x22\xf1\x1b\x0b\xea\x7a\xbd\x8a\x17\x2f\xe1\xce\x8c\x45\x19\x4b\x12\x8e\x8a\x2c\x1e\x27\x20\x31\x9f\xd4\x8f\xc8\xa4\x6c\xc5\x1b\x6b\xa5\x5f\x12\x54\xfa\xeb\x6b\x51\xe3\x4f\x2b\x49\x42\xa5\x35\xdf\x0e\x3d\xbe\x07\x96\x20\xcf\x3f\x19\x10\xc6\x85\xdc\x65\xa8\x87\x4f\xde\x8b\x1e\x76\xd5\x58\xd0\x2b\x46\x0d\xcf\x69\xf4\xfc\x81\x43\xcc\xb3\xb7\x69\x4f\xdd\xa6\x3d\xe3\xb2\xd1\xcf\x03\x68\xaf\xf8\x12\xe9\x5b\xf8\x3d\x1f\xaa\x44\xdc\x19\x73\xee\x39\x66\xfa\x64\x38\x46\xf9\x20\xdc\x0c\x3f\x9f\xdd\x08\xef\xe5\x17\x35\xff\x87\xed\xb9\x14\x7e\x5e\xbc\xbf\x58\x8a\x8c\x5c\xaa\x39\xe2\xea\xc4\x9e\x87\xff\xb0\x44\xd8\x3c\x57\x9c\xd9\xac\x44\x19\x81\x5d\x20\x23\x8a\x79\x8d\xaf\x13\x65\x57\xd7\x07\x6a\x11\x4e\x30\x45\xfa\x9b\x97\xbe\x45\x0d\xe5\xfa\xa5\x3f\x66\x3f\x07\x4e\x50\xcb\x8e\xb6\x03\x70\xa5\x98\xde\x1f\x17\x83\xae\xf8\x79\x86\x70\x01\x79\xc0\x47\xdb\x30\xda\x06\x41\x90\x04\x64\xfb\x43\xb2\xe7\x63\xae\xa1\xf0\x0c\xbb\x4c\x72\xa9\x75\x41\x7f\x65\xdd\x27\x74\xe4\x00\xba\x02\xee\x00\x38\x3a\xa7\xb7\xe8\x5f\x29\x39\x8c\x7c\xd5\x88\xed\xe0\xc8\x19\x8a\x64\x18\x85\x64\x43\xea\x53\x75\x1c\x41\x94\x63\x46\x9f\x99\x04\x2c\x6c\xa1\x16\x0d\xee\x53\xe2\xaa\x75\xed\x7e\x32\x28\xe9\x23\xb2\xa0\x7c\xb3\x02\x3a\x16\x74\x11\x3c\xc6\x25\xd9\xd1\xf3\x5d\x3c\x0b\xbe\x86\x07\x8c\x63\x47\x0b\x5e\x3b\x0b\xbc\xec\x73\x11\x1c\x49\xe2\xc4\xba\x62\x8f\x48\xec\xa0\x2d\xcc\x09\x1e\x38\xa0\xa5\x5c\x36\x4a\x2f\x8b\x6d\xb4\x00\xb5\xc6\x8c\x73\x86\x26\xa8\xc7\x41\x48\xec\x32\x2d\x6b\xef\x2c\x45\x7b\x9c\xb9\x4c\x32\x1e\xdf\x0e\x5d\xa2\x3b\xc0\xa3\x95\x79\x6e\xad\x7b\xc6\x25\xa3\xf2\x7a\xce\xd9\xb9\x46\x49\x5c\xeb\xa6\x35\x43\x7c\xad\x44\x90\xe1\xdb\xcc\x01\x33\x9a\x54\x15\x93\xf9\xc5\x3e\x33\x8d\x36\x73\x8a\xaf\x68\xfe\xae\x23\x9c\x97\x72\x38\xd1\x71\x72\x5c\x07\xcb\xee\xd5\x34\xa8\xd2\xf1\xbf\x08\x2b\x8c\x6b\x34\xdc\x96\x2f\xa4\x5e\xae\x01\xc9\xc8\xea\x28\xea\x8a\xf4\xd5\x12\x7d\x69\xbd\x74\x30\xc7\x54\x06\x5f\x63\xb4\x09\x26\xf1\x38\x33\x75\xfb\xa2\x63\x6c\xea\xa5\x02\xcd\x47\x0a\xf5\x89\xeb\x39\xaa\xef\x32\xe2\x5e\x46\xe5\x55\xb7\x1e\xf4\xb4\x1e\xe3\xa8\x65\x9a\x91\x67\x14\x75\xe4\x49\x27\x43\xdf\x7a\xe6\xa6\x89\xaf\x3f\xb3\xdb\xcf\xec\xf2\x53\x33\x10\x9b\xc9\x1e\xcf\x5f\x0a\xdc\x73\xc9\x11\x5f\xb1\x57\xda\x70\x96\xc1\xeb\xcc\x1d\x70\xc8\x56\xb2\x32\x8d\xcd\x09\x32\x23\xc7\x1b\xdc\x0b\xbe\xc6\x4d\x5d\x63\x2a\xa1\xf0\xa3\xf0\x4d\x10\x8c\x83\xd0\xb5\x15\xfb\xab\x2a\xd5\x5d\x01\xf7\x2b\x6e\x4e\x01\xa4\x2e\x52\x07\x3c\x0b\xba\xee\xc3\x0e\x46\xf3\xfb\x27\x1d\xc1\x7a\x65\x31\xab\x14\xbd\xb9\xfb\xd0\xa1\x7b\x67\x60\x0b\xde\x47\x07\xaf\x73\x3e\x64\x87\x38\x49\x94\xb4\xb4\x9b\xb6\xb7\x10\x87\xb9\x24\xa6\xef\x68\x42\xb6\x02\xcd\x56\x2d\x20\x4c\x83\x07\x8b\xa2\x62\x76\x47\x5f\xc0\xbc\x09\xe6\x58\xbf\xae\xdf\xac\x83\x74\x40\xa4\x61\x20\x51\x94\x78\x38\xf8\x63\xf0\x10\x44\x72\x3b\x48\xd4\x4b\xab\x7e\xa7\xd7\xaf\x61\x73\xea\x76\xd6\x9a\xb4\xb0\x51\x2c\x2f\x61\x0b\xab\x1c\x32\xb8\x13\xa5\x3b\xa8\xb5\x47\x18\x25\x1a\x33\xbc\x3c\x23\x28\x9c\x44\xf2\x1f\xe6\xd9\x45\x91\x0f\x23\x5e\xf8\xf8\x21\x6a\x49\x94\x94\xba\x61\xe2\x86\x53\x43\x63\x16\x3e\xa8\xf8\x51\x94\xa6\x9e\x34\x98\xca\x60\x8a\x82\x3b\x15\xaa\x69\x42\xa5\xab\x34\xf5\x8d\xa8\xb7\x91\xc7\x37\x23\xb8\x5e\xf6\x57\xad\xd2\x6b\x70\x67\x33\x3d\x07\xf5\x37\xcb\x1f\xb0\xe5\x0a\x60\xca\xe8\xc4\x85\xca\x75\x6b\xfc\xcd\x5f\xed\x9a\x2b\x33\x98\xd9\x15\x11\x20\x13\x53\x6c\x11\xa0\x54\xa7\x7d\xbb\x78\x5c\x29\x73\x00\x8d\x69\xe2\x78\xa0\x81\xb6\xcf\x03\x43\x22\x8e\x4e\x2a\x7a\xa5\xdd\xaf\xc7\x53\xe6\x22\x93\x39\xb1\x4b\x1e\xaa\xd8\xf5\x3a\xd1\x1e\xe6\x08\x9b\xd1\xe2\x8b\xbd\x2c\x0f\xa6\x23\xe2\x02\x1a\x06\x53\x2b\x98\xd6\xa5\x8f\x9b\xc5\x6d\x58\xcf\x17\x1b\x42\x86\x44\xbb\x08\x5a\x75\xc6\x78\x9c\xec\x04\x53\xf8\x91\x4b\xaa\x97\x2e\x99\xbf\x91\x12\xf9\xa9\x47\xa5\xe0\x38\xf5\x12\xae\x55\x64\x78\xcc\x81\xe7\xb7\x28\x13\x12\x09\xc4\xfd\xe7\xb7\x80\x06\x5f\xbb\x88\xb9\xb0\x07\x34\x78\xea\x6a\xbe\x56\xe1\xc5\x2f\x63\x4c\xd1\x68\x0a\x2f\xb8\x7f\xb2\x6f\x63\x84\x6d\x73\xb2\x4d\xcf\xf2\x94\x4e\x01\x61\x4e\x76\x89\xa3\x41\x88\xe2\x81\xb8\x84\xd8\xc6\xbb\xba\xfe\x9e\xb1\x5d\x03\xb0\xe3\xfc\x66\x22\xd9\xae\x46\xb1\xd2\x49\xad\x06\xc6\x5e\xaf\xb7\xab\x5b\x6b\x99\x37\x2f\x72\xd9\x5a\x65\xbd\x1a\xd4\x1e\x62\x23\x8e\x29\x31\xf7\x18\x7d\xfe\x3e\x62\x96\xc7\x76\x09\x58\x7b\x04\x3b\x94\x9d\xfc\x9b\x49\x9e\xbf\xaf\x33\x22\xc2\x19\xd9\x75\x4c\xde\x90\x19\x58\x6d\x4e\x15\xcf\xda\x8d\x2a\xde\xd0\xe3\x8d\x1a\x7c\x15\xae\x47\x2f\x00\x6b\xfb\x56\xbb\x56\xc4\xe8\x80\xf5\x38\xa4\xeb\xa9\x99\xc3\x6b\xd4\x49\x2f\x27\xf2\x9a\x97\xae\xd6\x55\x3c\x7b\xe7\xa0\x62\x65\xad\xb6\x46\x77\xad\x5c\x5c\xdb\x02\x0e\x94\x8c\x08\x1d\xa1\x3d\x46\x2d\xe0\x68\x97\x50\x21\x19\x1b\xb9\xc0\x95\xdb\xfe\xad\x77\xde\xd2\xdf\x2a\x24\x1f\x46\x91\x91\x4f\x47\x88\x8d\xe3\x72\xf9\x2e\xa1\x14\xf8\x1e\x01\xc7\xa7\x23\x81\x07\x82\x98\x63\xa5\xcc\xba\xc5\x4a\x45\xd6\xad\xca\xe5\x87\x56\x52\xe1\xa3\xa8\x44\xe3\x21\xe1\xae\x92\x94\x6d\x19\x1b\x89\xaf\x8d\x5e\x2e\x96\xef\x55\x8a\xb8\x9a\xcf\x5d\xdf\x22\x72\xac\x96\x2c\x95\x1d\xf0\x56\x9a\xf0\xa6\x55\xb9\xb7\x2a\x19\x30\x6d\x34\xc3\xdf\xc5\x05\xb4\xbf\x43\xb9\xfb\x77\x85\x72\xf7\x3b\x75\x9f\x03\x37\xdf\x09\x8e\xb8\xb4\x83\x47\xfc\xe4\x1e\xfc\xe8\x3b\x98\x77\xaa\x57\x30\x2f\x3f\xfb\xd5\x7f\x7f\x72\xf0\xfd\x83\x2f\x5f\x1c\x1f\x9f\xbe\xff\xd5\xe9\x47\x8f\xd5\x0b\xb0\xa4\xcf\xfc\xd7\x06\x42\x16\x33\xb3\x4f\x5a\xd1\x6e\x34\x92\xe9\x12\x75\xd2\x91\xcc\x64\xec\x8d\x1b\xcd\xef\xf7\x8f\x5e\x1c\x3f\x2d\xb1\x5c\xa8\xd2\xa4\x0c\x83\x09\x12\x63\xcc\x41\x94\x18\x4e\x0f\x3e\x78\xf1\xe4\x93\x17\x4f\xde\x7d\xf1\xf8\xd3\x39\x4f\xdc\xa2\xea\x5a\xec\x99\xe9\x5a\xb8\x0b\x44\x40\x25\x70\x8f\x13\x01\x25\x09\x45\xd6\x39\xba\x6e\x1c\x12\xc9\x29\x77\xba\x88\xbc\x5c\x46\x59\xf5\x5f\xef\xe7\xd6\xbe\x89\x4d\xc9\x78\x79\x74\x5e\x5e\x3f\x56\x07\x65\xfe\xe9\x53\x99\xe9\x83\x5f\x54\x3b\x2e\x73\x4c\xcd\x71\x99\xee\xce\x9f\x4e\x9f\x7c\xf4\xe2\xc9\xa7\x7f\xfd\x83\xba\xc4\xba\xc9\xc7\x93\xd5\xec\xba\xd0\xdc\xc5\x03\x5f\x8c\x89\x4d\x50\x17\x13\x31\xc6\xa9\x24\x11\x17\xb7\xcd\xca\x77\xc8\xdb\x38\x1d\xb9\x1a\x81\x6f\xe7\xcc\x6f\xfb\x23\xa0\x15\xd6\x74\xd6\x75\xbc\xa7\x07\x1f\x9c\x1e\xdc\xac\xe1\xed\xe4\xbc\x1d\x66\xb1\x0a\x2d\x76\xb1\x33\xc2\x2e\x5e\x40\xfd\xfd\xfd\x5f\xd6\x51\xf7\xbb\x19\xb3\x18\x93\x1a\x8d\x75\xd3\x9d\x69\x7d\xab\x8e\xfa\x4a\x4e\xed\x42\x95\x3a\xfe\xee\x15\x3b\xb5\xd4\x2f\x8e\x8f\x5f\xbe\xf7\xd1\x5f\x1f\xbe\x77\x7a\xf0\x81\x7a\xb9\x9a\x32\x2f\xad\x13\xda\x24\xa8\xeb\xbb\x04\x93\xa5\x05\xdc\xe8\xb5\xe4\xf4\xda\x66\x16\xfc\xb4\x6c\xc3\xcd\xbf\x9c\x1e\xdc\xaa\x11\x74\x35\x15\x74\x95\xd9\x7e\x6c\x44\x2c\x29\x1b\xfa\xf8\xb8\xc1\x7c\x51\x6b\xc6\xcb\x7f\xbe\x1d\x0d\xfe\xb7\x1f\xbe\xfc\x8f\xaf\xe7\xde\xe7\x9b\xaf\x5e\x1c\x1f\xd7\xc9\x8b\x27\xe1\x32\x19\x4d\xd8\x7c\x09\xf5\xc1\x26\x94\xd0\x8a\x60\xcd\xc4\xa0\x21\xe3\x88\xd0\x1d\x10\xd2\x05\x2a\x35\xab\x36\x91\x9c\xe8\x52\x23\xbf\x2c\x33\xd1\x43\x2f\x39\x73\x7e\xb5\xa6\xbf\xfa\xf2\xc9\xab\x0f\xff\xfd\x87\x4f\x6f\xbe\xda\xff\x4a\xe3\x5e\xe2\x9d\xfb\xf2\xb3\x1b\xd1\xd2\x49\xbf\xbf\xf3\x05\xb1\xd1\x2a\x03\x91\x2d\x93\x7f\xf2\x1c\x13\xbd\x76\xad\xfb\x53\xf4\xda\x90\xf9\xd4\x42\x94\xa1\x28\xa6\x63\x6a\x82\x40\x84\xc6\x91\x22\x0a\xf8\x1e\x13\x24\xda\xde\xff\x90\xcf\xae\xcd\x1a\xcd\x57\x9f\xdd\xf9\xe1\xf6\x67\xb5\x4a\xc4\xe3\x52\x56\xe2\x6d\x7f\x8c\x69\x45\x89\x35\x69\x95\x94\x60\x14\x32\x2d\x74\x4a\xfc\x2c\xfe\x0f\x58\x94\xd1\xd7\x4d\x46\x25\xa1\x3e\xf3\x45\x49\xaf\x42\x84\xfb\xe1\xf6\xf4\xd5\xe1\x41\x9d\x86\xc9\xe6\x2d\x6b\xb8\x8a\xbd\x6d\x9c\x68\xd8\x5c\xb4\x20\xcc\xdc\xe9\x95\xc6\xe4\x87\xdb\xd3\xff\xf9\xd3\xfb\x0b\x24\x9e\x1e\xdc\x52\x24\xba\x13\x16\xe5\x43\xa5\x71\xd1\xed\xb3\x3a\xa1\xdf\x3d\xf8\xee\xde\x7f\xdd\xf8\x6e\xaa\x04\xc3\xac\x17\xe6\x85\x61\xf9\xdf\x00\x00\x00\xff\xff\x9f\x04\xbf\x66\xbf\x38\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x5b\x4f\x73\xdb\x46\x96\xbf\xf3\x53\x74\xe9\x30\x48\xaa\x12\xe4\xee\xcb\x16\x45\x2b\x94\x43\x59\x62\x89\xb6\x52\xc9\x65\xab\x09\x34\xc9\x16\x80\x6e\x4c\x77\x53\x2a\xea\xb0\xe5\xc8\xf1\x8c\x67\x12\x4f\xe4\x99\x78\x67\x92\xf5\x2a\x56\x92\xad\x2c\xb3\x71\x8d\x1d\xcb\x2e\x4f\x64\x7b\x0e\xb0\xee\xe0\x49\xf2\xdd\xf6\x64\x6b\x9d\xef\xb0\x05\x80\xf8\xd3\xe8\x06\xa5\xcc\xce\x56\xb9\x4a\x40\xf3\xfd\x7e\xef\xbd\xfe\xf3\xfa\xf5\x6b\xb8\xee\x58\x78\x7d\x08\x38\xec\x62\x64\xb3\xa7\xff\xd5\x85\x67\x6a\x00\xc0\x6e\x97\x45\x7f\x01\x78\x13\xd4\x3b\x35\x00\x5c\x48\xfa\x67\x80\xbb\x11\x3d\x22\x68\x9f\x01\xef\xd5\xea\x0e\x5f\x47\x1c\xb9\xdc\x81\xfe\x0c\xcc\x1c\xa1\x73\xb5\xba\x23\x30\xea\x52\x17\xf6\x15\xc9\xf9\x4c\x92\xa3\x44\x8e\xf4\x23\x5a\x97\x5b\x03\xd8\x13\x8a\x7c\x33\x93\xb7\xa7\xf2\x89\x0d\xdd\xb2\xa4\x51\x7f\xab\x63\xa8\xf6\xd8\xb0\x56\x77\x5d\x0f\x11\x02\x67\x3a\x50\x97\x3d\x30\xea\x84\x12\xec\x81\x15\x26\xa0\xe3\x3e\xbd\xeb\x18\x0a\xc4\x5c\x31\x33\x8c\x60\x19\xe2\x70\x17\x33\x07\x09\x43\xb5\xcf\x3c\xdc\x35\x8d\x22\xa4\x4e\x7c\xc8\x04\xaf\x70\xa8\xee\x4b\x5e\x18\x75\x4e\x2d\x0c\x2d\x1c\xdc\x23\xa0\x81\x37\xb0\xab\x31\xa9\x91\x9b\x84\x78\x0d\xf2\x18\x22\x30\x25\x80\x43\xc2\x41\x77\x28\x80\x3b\xb4\x18\x14\xb8\xa7\x76\xc1\xfc\x52\x06\xee\xb1\x9a\x11\xee\x84\x3f\x4c\x7e\x15\x3e\x0c\x9f\x84\x8f\xc3\xfb\x93\x4b\xe1\xe3\xf0\x09\x08\xf7\x27\x97\x26\x97\xc3\x07\xe1\xfd\xc9\x07\x93\xed\xf0\xfb\xf0\x89\x62\xc5\x5c\xb8\x13\x7e\x3a\x97\xb2\x9e\xcd\x38\xbb\xfd\x9a\x11\x7e\x5a\x86\x83\xc9\x07\x20\xdc\x0d\xef\x4d\x2e\x85\x77\xc2\xc7\xe1\xc3\xc9\xaf\xc3\xfb\xe1\xe3\xf0\x0e\x08\x77\x27\xdb\xe1\xbd\xf0\x49\x24\x94\x28\x8f\x20\x1a\x6d\xbb\xe1\x6e\xae\x6f\x65\xa5\xa4\xf0\x46\xb8\x1f\x61\xc3\x83\x98\x3a\x76\x41\xeb\xd7\xa7\xa7\xf0\xeb\x46\xd1\xb3\x85\xfa\xc9\x9a\xfe\x1f\xbc\xbd\x21\xfb\xbb\xa0\x38\x1c\x7b\x37\xf9\xd7\x82\x77\xf7\x41\xf8\x65\xcc\x7c\x67\x72\x29\x7c\x38\x73\xdc\xbe\x9c\xcb\x5e\xbe\x0c\x77\xc3\xdf\x67\xc3\x78\x61\xfa\x70\x61\x65\x2d\x53\x37\x74\x0a\xe1\x41\x3b\x5d\x22\xd5\x4f\xc2\xbb\x93\xdf\x9c\x30\x5d\x76\x33\x3d\x2b\x19\x3b\x1b\x16\xd8\xe7\x11\x1b\x40\xbb\x0c\x9d\x1f\xd8\xf9\x7c\xf7\x78\x6d\x1e\x71\x97\x0a\x44\xc0\x06\x22\x84\x52\x11\x85\x14\x65\xa9\xcf\x9b\x6b\x39\x88\xb8\x45\x17\xb4\x78\xe0\x21\x01\xba\xc8\x47\xcc\x11\x08\x40\x48\xb8\xcf\xa0\x83\x5c\xbc\xee\x0c\x10\xb6\x0d\x1d\xff\xbc\x59\x97\x74\x18\xf3\x48\x04\x63\x81\xc1\x85\x60\x8f\x71\xc8\x83\x3d\x15\x26\x72\xc4\x60\x58\x6b\x0c\x20\x13\x88\x21\xc5\xe7\xc6\x40\x14\x9c\x46\xa4\xd6\x70\x29\x47\x36\x78\x87\x62\x22\x40\x47\x50\xcb\x01\x0d\xea\xf9\x90\x8c\x14\xe8\x3b\x9d\xc6\xf4\xb1\xcd\x92\xe7\x8c\x25\xef\x85\x06\xf5\x3c\x48\x6c\x2c\x20\x66\x68\x66\x4f\x36\x2a\x7b\xb2\x64\xc0\x3f\x73\x61\x9f\x49\xad\x2a\x93\x50\x73\xfa\x64\xfc\x22\x7a\x49\x43\x38\x24\x76\xe1\xb7\xe4\x2d\xc5\xe7\xbe\x53\xea\xa3\x28\x9a\x6d\x40\x60\x23\xb0\x8a\xb8\x4f\x49\xb4\xb7\xb9\xd8\x86\x36\x02\x4b\xd8\xc3\x02\xda\xca\x1e\xd7\x58\xcd\x43\x9d\x2f\x0a\x34\x48\x91\xa4\xd4\x37\xb3\xe7\x37\x93\x97\x82\x7e\xe6\x53\x16\x07\x58\x15\xc8\x4a\xb2\x9b\x1e\xc1\xa0\x31\xea\x8d\x48\x1f\xd9\xb8\x0f\x1a\xa3\x01\x45\xb6\x3d\xe4\x0a\xd4\xb2\x32\xa0\x35\xaa\xe5\x10\x45\x70\xd4\x2b\x0a\x1a\x67\x91\x4b\xf0\xe1\x9e\x03\x81\xcd\x86\x87\x4f\xba\x50\x99\x65\xb6\x59\x98\x3c\xdc\xad\x19\x67\x13\x41\xb0\x05\x08\xa2\x1e\x5a\x47\x84\x02\x6a\xf7\xe9\x06\x65\x84\x72\xb1\x4e\x35\x14\xc4\xa4\x55\x24\xa7\xa5\xa0\x65\x8a\x16\x4d\x26\x1d\xa9\xb6\xdd\x29\xdb\xde\x81\x1e\xe5\x82\xae\x13\x0c\x7c\x6a\xaf\x23\x41\xb0\xba\x4d\x73\xd3\x97\x51\x0b\xfd\x51\x30\x8e\x46\x22\x18\xf7\x15\x69\x64\x5a\xd2\x32\xcc\xa4\xd7\x82\x3d\xd7\x85\xae\x43\xb7\x82\x7b\x1a\xd4\x86\x84\x42\x98\xf4\x91\x60\xb0\x8f\x08\x02\x4d\x44\x28\xe7\x88\xe8\xf3\x1b\x64\x36\xcd\x62\x86\x53\x84\x32\xd0\x82\xc3\x9e\x07\x09\x51\x51\xad\x4a\x14\x07\x0b\x98\x6c\x21\x77\x48\x04\x62\x04\x0d\x3c\xa4\x81\x5f\xac\x84\x83\x35\xc4\x10\xd6\x40\xd6\x24\x88\x81\x30\x71\xe0\xc0\x1d\x0a\xd8\x0b\xc6\x2e\xd4\x74\xe5\xa0\x97\x23\x30\xaf\x19\x0b\x88\xf8\x88\x71\x4a\xa3\x44\xe4\x1f\x11\x69\x17\x4c\x5d\xac\xcd\xa3\xd0\x82\xe7\x33\xc4\x21\xe8\x44\x49\x90\x0b\x6c\xe4\x82\x05\x2e\xa0\x4d\x55\xa2\x8e\xb9\x20\x65\x4e\x85\x4d\xa1\x8f\x3c\x84\x09\x09\x1e\x89\x2d\xdc\x47\xa0\xe9\x75\x17\x15\x4b\xfa\x51\xab\xd4\x3d\xcd\x42\x4e\x0b\x3c\x1c\xf9\xc5\xad\x01\x0b\xbe\x26\x8e\x40\x0c\x2c\xc2\x9e\x18\x92\xbe\x21\x47\xc8\x29\x4b\x91\x79\xda\x94\x3e\x82\x5f\x14\x82\x62\xdc\x30\x94\xe2\xa4\x67\x76\xcd\xc5\xec\x77\xc4\xcd\x72\x43\xc1\xa8\xe2\x2f\x49\xec\x4d\x9c\x9b\x36\x4d\x89\xc1\x6c\xd7\xba\xc1\x23\xd6\x47\xcc\xc5\xd6\x00\x11\xb0\x8a\xac\x81\xe0\x4a\xf7\x34\xbb\xab\x12\x43\xf8\x87\x38\xbd\xb9\x1c\xee\x47\x29\xc9\x34\x33\x88\xf2\xa0\x24\x65\x98\x5c\x8e\x53\xa3\xed\xe8\xc7\x69\x53\xf8\xd7\xc9\xa5\xf0\x7e\xb8\x1f\xff\x7d\x38\xf9\x64\xb2\x1d\x3e\x0c\xef\x2b\x8a\xc2\x3f\x84\x5f\xa4\x3a\xdb\x79\xdb\x57\x79\xeb\xc5\xb6\x3e\xc1\x58\x84\xc4\x46\x2e\xd7\x9e\x59\x16\xa5\x33\x8b\x31\x6b\xd2\x97\xe7\xfc\x22\x74\x1d\x08\xea\xc1\x37\x4f\xef\x3a\xe0\xc4\x23\xc5\x62\xbd\x90\xff\x08\x56\x3b\x47\xac\xe9\x06\xa3\xe6\x01\xe7\x88\x95\xef\x4b\xa6\xfc\x1a\xef\x93\x69\x53\xb6\x01\xcd\xa5\x74\xc1\x18\xcd\x55\xd0\x15\xb2\xff\x3f\x85\x8f\xc3\xfd\xf0\x61\xf8\x7d\xf8\x30\xdc\x9f\x5c\x0e\xef\x84\x07\x93\x8f\xc3\xc7\x93\x8f\xc2\xbf\x94\x86\x23\x1a\xad\xf0\x51\x78\x67\xb2\x1d\xde\x8f\x84\xd4\x61\xf9\x53\x36\x00\xe7\x2a\xfa\xdf\x78\x67\xe8\x3a\x98\x20\x02\x28\x87\x0e\x1a\x0d\x04\x0e\x1e\x28\x44\x2b\xa3\xf5\xdc\x48\x5c\x3b\x45\xce\x53\x99\xe6\x94\xb0\x1e\x62\x71\x90\x98\x87\xc4\xd1\x70\xcc\xeb\x49\x5a\xd4\x75\x91\x23\xf0\xc6\xac\x23\x6c\x8b\xba\xd2\x21\xd6\x68\xd1\x18\xd4\xab\x3e\x2a\x46\xbc\x26\x38\xdc\x15\x58\x3e\x30\x66\xdb\xe4\x2c\xa8\x97\x20\x25\x60\x2b\x4d\xea\xb4\x13\xbc\x25\x4d\xf0\x4c\x16\x9e\x78\x3a\x6f\x51\x4f\x3e\xa0\x67\x58\x1d\x2a\x09\x72\xad\x66\x99\xa4\x99\x3d\xa8\x31\xce\xc8\x5b\x40\xab\x69\x48\xad\x69\x84\x2a\xb4\x6b\x85\x2b\x64\xeb\x4d\x55\xb4\xde\xd4\x49\x2e\x09\xdb\x94\x64\x67\xfb\x0b\xe0\xb0\x07\x92\xd2\x86\xd2\x61\x4d\x58\xd7\x7a\x06\xeb\x55\xbe\xc1\xba\xce\x62\xb9\xb5\x2c\xad\xda\x57\x55\x34\x69\xbd\xd5\x29\x00\x60\x34\xc3\xa6\xb9\x70\xf5\xf4\x6c\xd1\x62\x1a\x9c\x4c\x4b\xe6\x06\x7b\x82\xba\x02\xbc\x8d\x5c\xe4\x1e\x5e\xe7\x3c\x18\xf7\x0f\xef\xe6\x07\x1e\x35\x56\xb6\x7a\xf2\x91\xc7\x68\x05\x0f\xb6\x06\x90\x6f\x91\xe0\x87\x99\xb8\x81\x48\x67\xc7\x32\x25\x3e\xa3\x3d\x2c\xf4\x64\x0e\x62\x88\x23\x81\x67\xb2\x39\x1a\x60\xf0\x80\xa7\x19\x1f\xd4\xf8\x2f\x67\x7b\xf1\x21\x43\x0d\xd0\x4b\xa5\x73\xda\x54\xac\x2a\x50\x2d\x25\xb5\x9b\x14\x59\x58\x02\xd3\xd7\xf4\xf0\x93\x46\xfc\x29\xff\xf4\xf5\x0d\xe9\x35\x96\x05\xba\xa6\xc4\x88\xa2\x5d\xc6\xb4\x6d\xc6\xbe\xb4\x54\x2e\x75\xa5\x90\xca\x28\x14\xdb\x9c\xc5\xaf\xa4\x49\x29\x7f\xa5\x2c\x6d\xc8\x04\x41\x8c\x0f\xb0\xaf\x6a\x6e\x2b\x2e\x24\x4d\x4a\xbf\x2e\xe1\xf8\xdc\x27\x46\x40\x7b\xfa\x5c\x5a\x6a\x94\xb9\x2b\x80\x18\xf1\x54\xc2\x2c\x8c\x4a\xbd\xd3\x90\x1b\xe2\x41\x28\x36\x18\xbf\x28\xb4\x95\xce\xb1\xb9\xa4\x66\x1b\x51\x2d\x99\xd9\x25\x27\xf7\xc0\xa9\x7a\x56\x25\x4a\xc6\x35\x18\x23\xdd\x80\x46\xcd\xc5\x0c\xe1\x3c\x84\xfa\xb2\xc0\x79\xc1\xf5\xf9\xf8\x32\x84\x9e\x4b\xb7\x66\x17\x15\x96\x93\x83\x46\xfa\x08\x96\x37\xa6\x6f\x1d\xb3\x6e\xbe\x55\xf8\xb5\x53\x7f\x6b\x79\xad\x4a\x51\x74\x22\x87\x2e\xa8\xe7\x05\x50\x55\x4f\x5d\xf2\x7d\x99\xe6\xfd\xa7\xc8\x2e\x49\xbd\xb4\x3c\xc2\xee\x46\xb0\x47\x28\x87\x04\x9c\x3f\xbc\xeb\x04\x0f\xec\xc3\xeb\x60\x35\x18\xf3\xad\x8d\x60\x4c\x46\xa2\x3a\xdc\x2c\x8f\x58\x29\xde\x84\xbb\x72\x81\x2c\x2e\x11\x3e\x51\x4a\x84\x51\x1e\xfc\x17\x10\x3e\x49\x72\xe5\xc9\xb6\x9c\x35\x47\x6f\x93\x8f\x27\xbf\x53\x53\xaf\xa8\x5a\xb8\x9b\x15\x47\x2b\xca\x6b\xb4\xd7\x4b\x8e\xaa\xd5\x5b\xfd\x8a\xb4\xcf\x4f\x01\xd3\xac\x79\x56\x8a\xb0\xb2\xd8\x54\x36\xbc\xa8\x51\xb7\xdd\xa5\xed\x99\x9a\x15\x1f\x91\x59\x95\xad\x64\x69\xaf\x24\x49\x9e\xa4\x35\x2f\x75\x45\x8b\x6f\xa5\x94\x06\x1a\xd4\xc7\xa4\x8b\x98\x00\xb3\x92\x79\x5a\xce\xe6\x57\x66\xa6\xa6\xc5\xcc\xd4\x88\xeb\xb9\x3f\x4c\x2e\x4d\x3e\x9a\x6c\x27\x27\x98\x3b\x7f\x5f\x79\x34\xdc\x8d\xea\xa3\xa9\x92\xfa\x4a\xfa\x54\xf4\x48\x1a\xcb\x74\xcd\xc7\xa3\x31\x73\x68\x22\x49\x69\x54\xdb\x88\x71\xc4\x28\x24\xe0\x02\x62\x5d\x28\xa0\x52\x9a\x6a\x5f\xc8\x3b\xc4\xd6\xc8\xc7\x0f\x43\x07\xaa\x38\x70\xa1\xeb\x48\x58\x86\x37\xa0\x40\xa0\x62\xf7\x6c\x0b\x24\x6d\x71\xed\x0d\x61\x2a\x5b\x6a\x89\xa3\x6a\x6b\x3d\x25\x17\xed\x21\xce\x93\xb8\x31\xa3\xae\xd7\x36\x1b\xd5\xb8\x13\xf7\xa2\x8c\x25\xd9\x8e\x8a\x2c\x3e\xc3\x48\x40\x36\xaa\xee\x91\x91\xec\xc5\x5b\x4b\xd2\x2f\x09\x2a\xfd\xf5\xb5\xa8\xf1\xf5\x52\x92\x50\x6a\xcd\x97\x43\x9b\x6d\x21\x9b\xe3\xa7\x9f\x75\x31\x65\x5c\x6c\x52\xd0\x86\x87\x1f\x46\x0f\x9b\xea\x5e\xd0\x2e\xee\x1a\xbe\x5b\x6b\x0f\xbb\x2e\xb6\x4e\x5e\xa6\x6d\x75\x99\xb6\xcd\x77\xcc\x4e\xbe\x81\xb6\x8b\x2f\x91\xbd\x85\xdf\xf3\xae\x4a\xd4\x9d\x30\xe6\xbe\x6b\xa5\x4f\xa6\x6b\xca\x07\x61\x23\xfc\x62\x72\x39\xbc\x9b\x5f\xd4\xfc\x1f\x96\xe7\x5c\xf8\x45\xf1\xfe\x62\x2e\x72\x72\xae\xe2\x88\xab\x53\x7b\x1a\xfe\x5d\x89\xd0\x38\xd5\x3e\xb3\x5a\xda\x65\x38\xf4\x10\xee\x13\xc8\x2a\x62\x1d\x97\x43\x5d\x07\x11\x1b\x33\x0c\x09\xd0\xdf\xbc\x74\x6c\x62\x2a\xd7\x2f\x9d\x01\xfd\x25\x62\x18\xd4\x9d\x68\x39\x20\xa6\x14\xd3\x3b\x83\xe2\xa6\xcb\x7f\x99\x21\x3c\x04\x7c\xc4\xfa\xeb\xa8\xbf\x8e\x38\x06\x02\x01\x67\xd8\xc3\x5b\x43\xc8\x34\x14\xbe\xe9\xc8\x24\xe7\xea\x67\xf4\x57\xd6\x1d\x4c\xfa\x2e\x02\xe7\x91\xd7\x45\x0c\x9c\x32\x5a\x74\xce\x4b\x01\x23\x9f\x35\x7c\x3d\xd8\x73\x7b\x3c\xe9\x46\x2e\x68\x8f\x0c\x89\xda\x8f\x88\xcb\x7b\x46\x54\x7c\x44\x36\xb4\x41\x9d\x04\xf7\x08\xf6\xd4\xba\x76\x27\xe9\x94\xf4\x11\xd8\x48\xbe\x59\x41\x3a\x16\x70\x16\xf9\x94\x45\x17\x1f\x5a\xbe\xb3\x27\xc1\x97\x60\x97\x32\xe8\x6a\xc1\x4b\x27\x81\xe7\x87\x8c\x07\x7b\x02\xbb\xb1\xad\xd0\xc7\x02\xba\x60\x0d\x32\x0c\xbb\x2e\xd2\x52\xce\x9b\xd2\xcb\x6c\x1f\x6d\x04\xea\x03\xca\x18\x05\x23\xd0\x66\x88\x0b\xe8\x51\x2d\x6b\xfb\x24\x43\xdb\x8c\x7a\x54\x50\x16\xdf\x0e\x9d\x23\x1b\x88\x45\x33\xf3\xd4\x56\xb7\xcd\x73\x66\xe9\xf5\x94\xa3\x73\x91\xe0\xb8\xd6\x4d\x2a\xba\xf8\xa2\x44\x90\xe1\x1b\xd4\x45\x56\x34\xa8\x2a\x26\x8b\x8b\x1d\x6a\x99\x0d\xea\x16\x5f\xc1\xf4\x5d\x47\x38\x2d\xe5\x30\xac\xe3\x64\xb0\x0a\x96\xdd\xab\x69\x50\xd2\xf1\xbf\x08\x2b\xf4\x6b\xd4\xdd\xf6\x90\x0b\xbd\x5e\x13\x99\xe7\xaa\x28\xaa\x8a\xf4\xe5\x12\xbd\x34\x5f\x9a\x90\x41\x22\x82\xdb\x30\x2a\x41\x63\x9f\x51\x4b\xb7\x2e\x9a\xe6\xaa\x5e\x2b\x22\x79\x4f\x81\x0e\xf6\x7c\x57\x8d\x5d\x66\x2c\x65\x96\x5e\x75\xf3\x41\x4f\xeb\x53\x06\xea\x96\x15\x45\x46\x5e\x45\x9e\x08\x99\xfa\xd6\x13\x17\x4d\x7c\xfd\x99\xdd\x7e\x66\x97\x9f\x9a\x8e\x58\x4d\xd6\x78\xfe\x52\xe0\x4e\x1a\x63\x3e\x73\x49\x6d\x38\xc9\xe1\x65\xea\x75\x19\xca\x66\xb2\x32\x8c\xc6\x08\x58\x51\xe0\x0d\xee\x06\xb7\xa1\xa1\x6b\x4c\x35\x14\x7e\xe4\x43\x0b\x71\xca\x10\xd7\xb5\x15\xe5\x55\x93\xaa\xae\x80\x3b\xa5\x30\xa7\x00\xd2\x10\xa9\x03\x9e\x04\x5d\x1e\xa2\x0d\x08\xa6\xf7\x4f\x3a\x82\x65\x73\xe1\x04\x8a\xf6\x34\x7c\xe8\xd0\xed\x13\xb0\x85\xe8\xa3\x83\x57\x05\x9f\xe8\x9b\xa2\x64\x97\xb4\xb5\x8b\xb6\x3d\x13\x07\x99\xc0\xd6\xd0\x85\xec\x64\x68\x36\x6b\x11\x80\x24\xd8\x9f\xb5\x2b\x66\x77\xf4\x05\xcc\xdb\xc8\x1a\xe8\xe7\xf5\xdb\x55\x90\x26\xe2\xe9\x36\x90\x18\x8a\x7d\x18\x7c\x13\xdc\x47\x3c\xb9\x1d\xc4\xea\xa5\x55\xa7\xd9\xee\x54\xb0\xb9\x55\x2b\x6b\x49\xd8\xd0\x2c\x96\x97\xa0\x0d\x55\x0e\x11\xdc\x8a\xd2\x1d\x50\xdf\xc2\x94\x60\x8d\x1b\x7e\x9e\x11\x14\x4e\x22\xf9\x0f\x09\x1f\x2e\xf2\x41\xc0\x0a\x1f\x3f\x44\x2d\x89\x91\x42\xd7\x4d\xcc\x74\x2b\x68\xac\x3c\xf0\xff\x3c\x4a\x4b\x4f\x1a\x8c\x45\x30\x06\xc1\xad\x12\xd5\x38\xa1\xd2\x55\x9a\x3a\x66\x24\x6d\xe6\xfb\x9b\x19\x5c\x92\xe3\x55\x5d\x7a\x0d\x6e\xad\xa6\xe7\xa0\xce\xaa\xfc\x01\x5b\x6e\x00\x24\x94\x8c\x3c\x54\xba\x6e\xed\xd4\xa7\xaf\xda\x39\x27\x33\x58\xd9\x15\x11\x02\x16\x24\xd0\xc6\x88\x10\x9d\xf5\x0d\xb3\x51\xc5\x81\x48\x4c\x13\xef\x07\x1a\x68\xe3\x34\x30\xc0\xe3\xdd\x49\x45\x2f\x34\x3a\xd5\x78\x42\x3d\x60\x45\x77\x55\x96\xc0\x3d\x15\xbb\x5c\xa5\xda\x87\x0c\x40\x2b\x9a\x7c\x71\x94\x65\xc1\xb8\x8f\x3d\x04\x7a\xc1\xd8\x0e\xc6\x55\xe9\xe3\x6a\x71\x19\x56\xf3\xc5\x8e\xe0\x1e\xd6\x4e\x82\x7a\x95\x33\x3e\xc3\x1b\xc1\x18\xfd\xcc\x29\xd5\x4e\xa7\xcc\xdf\x49\x09\x86\x69\x44\x25\xc8\x75\xab\x35\x5c\x2c\xe9\xf0\xa9\x8b\x9e\x5e\x8b\xbe\x82\x01\x1c\xb0\xe1\xd3\x6b\x88\x04\xb7\x3d\x40\x3d\xb4\x85\x48\xf0\xd8\xd3\x7c\xad\xc2\x8a\x5f\xc6\x58\xbc\x66\x70\x3f\xb8\x77\xb8\xed\x40\x00\x1d\x6b\xb4\x4e\x4e\x8a\x94\x6e\x01\x61\x8d\x36\xb1\xab\x41\x70\xd3\xaa\x40\xac\xc3\x4d\x9d\xbc\x6f\xae\x57\x00\x9c\x38\xbf\x19\x09\xba\xa9\x31\x4c\x3a\xa9\x55\xc0\xe8\x9b\xd5\x7e\xb5\x2a\x3d\xf3\xa7\x45\x2e\x47\x6b\xac\x5f\x81\xda\x02\xb4\xcf\x20\xc1\xd6\x16\x25\x4f\xaf\x00\x6a\xfb\x74\x13\x23\x7b\x0b\x43\x97\xd0\xc3\x7f\xb3\xf0\xd3\x2b\x3a\x27\x22\x9c\x49\x4d\xa5\x21\x73\xb0\xdc\x9c\x1a\x9e\xb5\x9b\x65\xbc\xa9\xc7\x9b\x15\xf8\x32\x5c\x8f\x9e\x01\xd6\xca\x96\x45\x4b\x6a\x74\xc0\x6a\x1c\xd0\x49\x6a\xc6\xf0\x22\x71\xd3\xcb\x89\xbc\xe6\xa5\xab\x75\x15\xcf\xde\x39\xa8\x58\x59\xab\xac\xd1\x5d\x94\x8b\x6b\xd1\x17\x4d\x04\xf7\x31\xe9\x83\x2d\x4a\x6c\xc4\xc0\x26\x26\x5c\x50\xda\xf7\x10\x53\x6e\xfb\xd7\xde\x7f\x57\x7f\xab\x90\x7c\x18\x85\xfb\x43\xd2\x07\x74\x10\x97\xcb\x37\x31\x21\x88\x6d\xe1\xe8\x6b\xab\x3e\x87\x5d\x8e\xad\x81\x52\x66\x5d\xa3\x52\x91\x75\xad\x74\xf9\xa1\xd5\x94\x8b\x80\xc4\xe2\x1e\x66\x9e\x92\x94\xad\x99\x2b\x49\xac\x8d\x5e\xce\xca\xf7\x2a\x45\x9c\x5e\x8b\xf1\x2e\x16\x03\xb5\x64\xa9\xac\x80\x77\xd3\x84\x37\xad\xca\xbd\x5b\xca\x80\xa3\x8a\xda\x1f\xc3\x3b\xff\x98\x72\xf7\x1f\x0b\xe5\xee\xf7\xab\x3e\x07\x36\xde\x0f\xf6\x98\x70\x82\x07\xec\xf0\x2e\xfa\xd9\x77\x30\xef\x97\xaf\x60\x5e\xde\xfc\xcd\x7f\x7f\xb6\xf3\xe3\xfe\x57\xcf\x0f\x0e\x5e\x5c\xf9\xee\xc5\x27\x0f\x15\xcc\x54\x66\xfa\x6b\x0d\x00\x9b\x5a\xd9\x27\xad\x60\x33\xea\xc9\x74\x8a\xba\x69\x4f\x66\x3a\xb6\x06\x35\xe3\xc7\xed\xbd\xe7\x07\x8f\x25\x96\x33\x65\x9a\x94\xa1\x3b\x02\x7c\x00\x19\xe2\x12\xc3\x8b\x9d\xab\xcf\x1f\x7d\xf6\xfc\xd1\x07\xcf\x1f\x7e\x3e\xe5\x89\x5b\x54\x5b\x8b\x92\x99\xad\x85\xbb\x40\x80\xa2\x6f\x03\x7d\x86\x39\x92\x34\x14\x59\xa7\xe8\xaa\x7e\x48\x34\xa7\xdc\xe9\x24\xf2\x73\x1d\xb2\xe9\xbf\xdb\xce\xbd\x7d\x1b\x5a\x82\x32\xb9\x77\x5e\x5e\x3a\x50\x3b\x65\xfa\xe9\x93\xcc\x74\xf5\x57\x65\xc1\x79\x06\x89\x35\x90\xe9\x6e\x7d\xfb\xe2\xd1\x27\xcf\x1f\x7d\xfe\xb7\xaf\xd5\x29\xd6\x4a\x3e\x9e\x2c\x67\xd7\x85\xe6\x16\xec\x0e\xf9\x00\x3b\x18\xb4\x20\xe6\x03\x98\x6a\xe2\x71\x71\xdb\x2a\x7d\x87\xbc\x0e\xd3\x9e\xab\x50\xf8\x5e\xce\xfc\xde\xb0\x8f\x48\x89\x35\x1d\x75\x1d\xef\x8b\x9d\xab\x2f\x76\x3e\xae\xe0\x6d\xe6\xbc\x4d\x6a\xd3\x12\x2d\xf4\xa0\xdb\x87\x1e\x9c\x41\xfd\xe3\xbd\x5f\x57\x51\x77\x5a\x19\x33\x1f\xe0\x0a\x8b\x75\xc3\x9d\x59\x7d\xad\x8a\xfa\x7c\x4e\xed\xa1\x32\x75\xfc\xdd\x2b\x74\x2b\xa9\x9f\x1f\x1c\xbc\xfc\xf0\x93\xbf\xdd\xff\xf0\xc5\xce\x55\xf5\x72\x35\x65\x9e\x5b\xc6\xc4\xc0\xa0\x35\xf4\x30\xc4\x73\x33\xb8\xc1\x6b\xc9\xe9\xb5\x41\x6d\xf4\xba\xec\xc3\xc7\x7f\x7d\xb1\x73\xad\x42\xd1\x85\x54\xd1\x05\xea\x0c\x3d\x94\x6a\xca\xba\x3e\x3e\x6e\xd0\x21\xaf\x74\xe3\xe5\x6f\x6f\x44\x9d\xff\xc3\x47\x2f\xff\xe3\xf6\x34\xfa\x7c\xff\xdd\xf3\x83\x83\x2a\x7d\xf1\x20\xbc\x83\xfb\x23\x3a\x9d\x42\x1d\xe4\x60\x82\x49\x49\xb1\x66\x60\x40\x8f\x32\x80\xc9\x06\xe2\xc2\x43\x44\x68\x66\x6d\xa2\x39\xb1\xa5\x42\xbf\xac\x33\xb1\x43\xaf\x39\x0b\x7e\x95\xae\xbf\xfa\xea\xd1\xab\x8f\xfe\xfd\xa7\xcf\x3f\x7e\xb5\xfd\x9d\x26\xbc\xc4\x2b\xf7\xe5\xcd\xcb\xd1\xd4\x49\xbf\xbf\x1b\x72\xec\x80\x45\x8a\x78\x36\x4d\xfe\xc5\x77\x2d\xf0\xda\xc5\xd6\xeb\xe0\xb5\x1e\x8d\x2e\x8f\x09\x05\xd1\x9e\x0e\x89\x85\x38\xc0\x24\xde\x29\xa2\x0d\xdf\xa7\x1c\x47\xcb\xfb\x9f\xf2\xd1\x75\x68\xcd\x78\x75\xf3\xd6\x4f\x37\x6e\x56\x1a\x11\xf7\x8b\x6c\xc4\x7b\xc3\x01\x24\x25\x23\x96\x84\x2d\x19\x41\x09\xca\xac\xd0\x19\xf1\x46\xfc\x1f\xb0\x08\x25\x6f\x5a\x94\x08\x4c\x86\x74\xc8\x25\xbb\x0a\x3b\xdc\x4f\x37\xc6\xaf\x76\x77\xaa\x2c\x4c\x16\xaf\x6c\xe1\x22\xf4\xd7\x61\x62\xa1\x31\x6b\x42\x58\x79\xd0\x93\xfa\xe4\xa7\x1b\xe3\xff\xf9\xf6\xca\x0c\x8d\x2f\x76\xae\x29\x1a\xbd\x11\x8d\xf2\x21\xa9\x5f\x74\xeb\xac\x4a\xe9\xf1\xfe\xf1\xdd\x67\x97\x8f\xc7\xca\x66\x98\x49\x41\x56\xec\x96\x54\x1e\x1c\xdf\x39\xfe\xe6\xf8\x5b\xf0\xec\xca\xf1\xbd\xe3\xaf\x9f\x5d\x7d\xf6\xe1\xb3\xdf\x1e\x8f\xa3\xd7\xdb\xc7\x7f\x7e\x76\xf5\xf8\xcf\xc7\x63\xc5\x87\xe3\x7d\xf3\xf8\x8e\xf9\xec\x4a\xf4\x2f\x6d\x52\xde\x4f\x62\x95\x2a\x08\x29\xbc\xbc\x0f\xe6\xab\x60\x1a\x79\xc1\x6b\x17\xeb\x0b\x6f\x80\xe6\xd0\xed\x01\x2e\xa0\x40\x85\x71\x87\xac\xe0\x56\x51\x15\x38\xfe\xe6\xd9\x87\xb2\x29\x3a\x9f\x32\x07\x0a\x76\xed\x9f\xda\xae\x25\xd4\x8d\xc2\xd5\x1b\x60\xc5\x83\x44\x36\xea\xe8\xfa\xd1\x97\x47\xff\x79\x74\xfd\xe8\xc6\xd1\x17\xe0\x68\xf7\xe8\xc6\xd1\xef\x8f\xae\x1f\xdd\x54\xe7\x46\x24\x38\x77\xb4\x6b\x48\x36\x14\x5b\x25\x23\x32\xd5\xe7\x38\x83\xc8\xcd\x75\x0e\x50\xed\x7f\x07\x00\x7a\xbc\x63\xea\x9f\x3a\x00\x00"),
 		},
 		"/tests.yml": &vfsgen۰CompressedFileInfo{
 			name:             "tests.yml",
-			modTime:          time.Date(2022, 2, 9, 6, 27, 46, 564904347, time.UTC),
+			modTime:          time.Date(2022, 2, 9, 6, 28, 33, 0, time.UTC),
 			uncompressedSize: 24327,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x7c\xcd\x73\xdb\x46\x9a\xfe\x3d\x7f\xc5\x5b\x39\x44\x49\xfd\x4c\x78\xe6\x57\x7b\xca\x65\x0b\xa2\x28\x8a\x16\x24\x70\x09\x5a\xde\xf8\x32\xd5\x24\x5a\x24\x86\x60\x37\xab\x01\xc8\x2b\x1d\xa6\x1c\xcb\x4e\x1c\x8f\x3d\x51\x12\x3b\xb1\x1d\xc7\x23\x27\xd9\x75\x39\x1f\xe3\x64\xf3\xb1\x8e\xad\x24\x55\xc3\xf8\x8e\xb9\xc9\x67\x89\x72\x3c\x91\x6b\xfe\x85\x2d\x00\x04\xd0\x0d\x82\x14\x48\x2b\x53\x5b\x49\xb9\x84\xee\xa7\xdf\xe7\xe9\x97\x40\x7f\xbe\xdd\xb9\xe7\x00\x08\x6a\xe3\x97\x41\xed\x60\x02\xb3\x8e\x65\x50\x02\x65\x7b\x15\x14\x5b\x7f\x0e\xa0\x86\x97\x29\x13\x73\x9f\x03\xd0\xb1\xf5\x32\x07\xd2\xb1\xf5\x3b\xcb\xd6\xfd\x24\xc9\x4b\x93\x9e\x03\x30\x11\x69\xbc\x0c\xd8\x83\x77\xa8\x65\xd8\x06\x25\xde\xa3\xfe\x5c\x4c\xb9\x88\x4f\x59\x90\xa7\xac\x43\x19\xb2\x03\xcb\x21\x9f\x97\x15\x12\x89\x88\x88\x4c\x4c\xce\x40\x77\x02\x5b\xcb\x88\xb5\x31\xb3\x40\x31\xda\x86\x8d\xf9\x0a\xc6\x99\x21\x6d\x8c\x89\x28\xe3\xa4\x31\xe9\xec\x03\xa8\x44\x37\x66\xf7\x60\x11\x31\x9b\x60\x06\x25\x52\x97\x38\x86\x7e\x72\x68\xbe\x9f\x1b\xd9\xef\x3f\x8f\x67\x7f\xa4\xf9\x09\xad\x33\xa7\xd3\xa1\x30\x4b\x59\xdb\x31\x11\x68\x1d\x99\x27\x11\x32\x43\xae\x00\x13\x71\x69\x52\x47\x92\x63\x36\xc3\x1e\x83\xad\x9c\x81\xad\x3c\x31\xdb\xc2\xaa\xf6\x6f\x0a\xc8\xd3\x1c\x87\x9f\x14\x9a\xf6\x73\x22\xcb\xfe\x53\x60\xd5\xc2\x23\xac\xca\xa0\xd5\x9b\x8e\xd9\x46\x04\xca\x26\xb2\x6c\xa3\x6e\x81\xa6\x70\x1c\x29\x80\xa8\x32\x8a\x58\x17\x85\xfb\x95\xac\x11\x9c\x9a\xdd\xbd\x67\x62\x02\x0b\xc8\x06\x59\xe3\xb8\xb8\x8c\xa8\x56\x9a\x50\x2b\x2d\x62\xd0\xd1\x28\x5f\xe1\x3a\xcc\x60\xd2\x46\xac\x05\xf2\x51\x9e\x82\xcb\x89\x28\x8e\x8a\x1c\x47\x27\x21\x39\x88\x63\xa2\x6a\x68\x4d\x4c\x1a\xa0\x19\xd4\xfb\xd7\xe9\x60\xe6\x99\xc4\x36\x94\x6d\x9c\x68\x01\x86\x20\xe3\x96\x15\x0f\xb6\xac\x78\x9c\x96\x75\x4a\x2b\x2c\xaa\x65\x45\xd6\xaa\x30\x6f\xe2\x8e\x55\x6f\x82\x43\x74\xc8\x53\x28\xb6\x6b\x73\x53\xbc\x94\x24\x30\x14\x31\x95\x28\x10\x49\xf1\x12\x62\x87\xe0\xf1\x54\xbc\x30\x9e\x86\x17\x9e\x51\xc1\x3c\x66\xa4\xc5\xd0\xb2\x7d\x0a\xb3\x16\x4c\x33\xda\xd2\x29\x5b\xf6\x0d\x04\x52\xd4\xb9\x22\x27\x24\x1d\x1e\xaa\x49\x96\x12\xf4\xf0\x19\x19\x84\x9d\x74\x5a\x0e\x59\xb6\xad\x1a\x72\x1a\xd8\xc2\xa6\x69\xd5\x9b\x68\xd9\x86\x85\xe9\x39\x4e\xcf\x10\x54\x28\x28\x00\x4f\xe0\x16\xd9\xb1\x69\x1e\x59\x4d\x28\xe4\x14\x8a\x74\x83\x34\xa0\x58\xab\xf0\xcd\xc8\x00\x20\x72\x82\x8f\x8b\x39\xfd\xc7\x2c\x94\xf5\x3a\xb6\x2c\x6a\x30\x6c\x41\x99\x3a\x0c\x96\xb0\x49\x2d\x50\xa1\x08\x33\xa0\xd8\xdd\x3b\x98\xa7\x1f\x01\x8e\xfb\xcc\xa0\x0c\xd7\x6b\x06\x09\x81\x98\x65\x36\xb9\x18\x3c\xbe\x16\x3c\xa9\x94\x13\x94\x92\x5c\x81\xc0\x49\xca\x1a\x75\x4c\x6c\xe6\xb4\x41\x25\x6b\x38\xa7\x18\x78\x05\xe7\x96\x18\x75\x4e\xc1\x12\x22\x20\x13\xef\xad\xf4\x86\x61\x4b\x98\xb5\x28\xb3\x41\x35\x57\x10\x2c\x9d\x3c\xc1\x0f\x2d\x9e\xcd\x5a\x58\x9f\xc0\x68\x54\x9d\xe0\x31\xa8\x0c\x31\x47\x54\xa6\x54\xc3\x0c\x5b\x18\x34\x5a\x37\xb0\x8e\x74\x90\x49\xf7\x2b\x62\xb4\x11\x27\xb1\x8f\x89\x7a\xa5\x14\x68\xdc\x49\xa5\x64\x66\xe8\xb1\x52\x64\xd0\xcc\x2a\xe8\x21\x89\x58\xc2\x0c\x1b\xde\x98\x19\x33\x38\xe6\x34\x30\xd1\x97\x1d\xcc\x2c\xca\x1a\xd8\x6f\x8b\x83\xb4\xce\xb2\x89\x1b\x18\x0a\x12\x2c\xf1\x03\xb8\x71\x0a\x87\x75\x08\x6d\x44\xc2\xb1\xb4\x24\x65\xfb\x38\x4b\x55\xd0\xa8\xe9\x78\x59\xa0\x61\xb6\x62\xd4\xb1\x37\x30\x6f\x77\x10\x49\x4e\x04\xd2\xa0\xf1\x40\x9d\x2f\xc1\x0d\xd4\xa3\xe4\x8c\xdd\x97\xdc\xc6\xcc\x68\x62\x64\xda\x4d\x28\x11\xcb\x61\x88\xd4\x71\x64\x87\x2e\xc3\xe2\x31\x4e\x52\x3a\x3c\x21\x2a\x45\xd0\x73\x00\x68\xd9\xc6\xec\xe5\xc8\x62\xaa\xb4\xb6\xc1\x4b\xab\x32\x07\xc3\x09\xca\x4c\x1d\x66\x29\xd5\x2d\x6f\xb8\xeb\x95\x9f\x43\xa7\x90\x61\x70\xaa\x92\xc0\xd1\xa3\xe5\x58\x48\x64\x28\x93\x18\x4c\x5a\xa7\x90\xa9\x63\x06\x65\xef\xfd\x20\xc8\x04\xb9\xe8\x8d\x01\x4f\x61\x63\x4d\x50\x33\x88\x8c\x46\x3b\x42\x67\xe6\x3f\xf5\xe5\xc4\x76\x52\x5f\x22\x51\xcc\x02\xd6\x0d\x04\x0b\x88\xb5\x6c\xa8\xae\xe4\xe6\x8c\x65\x23\x57\x30\x71\xcb\x66\x41\x17\x0e\xf3\xdd\x6f\x4d\x92\x9b\x47\x66\x4b\x18\x7d\x0d\x2d\xc5\xf7\xbb\x29\xfd\x5c\x5f\xa3\x60\x35\x83\xcc\x59\x83\x60\x28\xb5\x51\xc3\x7b\xa1\xa4\x23\xe1\x5b\x19\xea\x89\xb3\xe3\x17\x28\x46\x71\xaf\xd0\x38\xc3\xb1\x42\xc7\xa8\x43\x89\xd8\xb8\xc1\x90\x8d\xf5\xe8\xc3\x39\x92\x98\x58\x0d\xc3\x3d\xf3\x4c\x6b\xa1\x34\xe7\xdb\x65\x04\xdb\x20\x2f\x33\xa3\x8e\xe0\xc5\xb2\xbd\xfa\x52\xe2\xeb\x4e\xc1\x85\xdc\x3c\x3c\x52\xe0\x25\x4a\x2f\x8d\xe1\x89\xaa\xd5\x3c\x85\x08\xf6\xbe\x0e\xcb\xf6\xbd\x98\x26\x43\x40\x71\xbf\x43\xba\x88\x30\x63\x2c\x21\x85\x8e\x61\x37\xb1\x69\xfc\x07\x68\xdd\xcd\x8a\x22\xfe\x08\x41\x4e\xd4\x39\xf4\x01\x71\x8f\xd0\x4f\xc8\xd0\xbd\xab\xf3\x45\xc0\xcd\x65\x7e\x25\x65\xbe\x18\x1a\x0e\x32\xe2\xf6\xba\xb9\xcc\x4d\x2e\xad\x03\xac\x16\xe6\x66\xd3\xad\x06\x19\x13\x58\x9d\x29\x15\x4b\x55\x59\x01\xed\xa4\xbc\x50\xaa\xca\x5a\xb5\x90\x9f\x5b\x2c\xcd\xcb\x25\x98\x2f\x54\x0a\xda\x7c\x61\xa6\xa0\x2c\x94\xa0\xa0\x81\x76\x52\x55\x8a\xb2\x52\x95\xab\x2a\xcc\xab\x15\x45\xae\xaa\x4a\x15\x66\x0b\x4a\x41\x51\x35\xad\x50\x3c\x0e\x55\xb9\xa2\xc9\x9a\xcc\x0f\xae\x27\x32\x1f\x56\xea\x40\x96\xa8\xbe\xf3\x94\x99\xdd\x5b\x36\x35\x6d\x98\xc5\x26\x36\x1f\xbe\x65\x59\xdd\x3b\x8d\x87\x5f\x42\xb5\x7b\x8b\x59\xc8\xea\xde\x6a\x44\xfe\x68\x3a\x23\xfc\x91\x6f\x22\xdb\x46\x84\xd2\x06\x82\x02\xd1\xa9\x55\xa7\x9d\x55\x90\xb5\x3c\x28\x4a\x9e\xab\x58\x2a\x2e\x9e\x4a\x86\xf0\x78\x74\xe8\x3f\x66\x78\x47\xa7\xcb\xd0\x91\x4c\x49\x58\xda\x99\x2e\x87\x96\xa3\xac\xc8\x70\x94\x92\xc5\x36\x22\x2d\xaf\xbb\xf1\xfb\xce\x3a\x3a\x02\x8b\xfe\x4a\x9a\xd7\x7f\x58\x16\xad\x1b\xc9\x05\xb9\x04\x3e\x14\x31\xa4\x54\x24\x69\x48\x7e\x96\x96\xa2\x89\xa1\x42\x57\x91\x19\x51\x6b\x75\x6a\x9b\x88\xe8\xb0\xb8\xc4\xb7\x15\x43\x71\x91\xc6\x25\x41\x11\x3f\x2a\x1a\x39\x9a\x9d\x72\x3f\x72\xaf\xbb\x9b\xee\x86\x7b\xd5\xfd\xc0\xbd\xe5\x5e\x77\xaf\xba\xd7\xc0\xfd\xb3\x7b\xd3\x7d\xd7\xdd\x70\x37\xdd\x0f\xdc\x4f\xbd\x84\xcb\xee\x4d\xf7\x06\xb8\x37\xbd\xff\xf8\x69\xed\x58\xe5\xa7\xa2\x39\x6f\x6c\x28\xd2\xcc\xa5\x05\xba\xd9\xa8\xf7\x56\x55\x55\x38\x5e\x6f\xe2\x1a\xa1\xb9\x16\x25\x96\x63\xda\xc8\xb6\x0c\x4a\xc8\xea\x2a\x54\x2d\x6f\x5a\x10\x92\xa9\xaa\xca\xf3\x04\x8f\xfd\x8e\xf5\x60\x13\xa9\x5a\x6a\xb8\x61\x10\x61\x1c\x4c\x08\xa5\xb6\x37\x73\xed\x80\x4a\xbc\x01\xc8\xac\xc1\xda\x08\x64\xbd\x6d\x10\xc3\xb2\x19\xb2\x0d\xdc\x42\xc4\xa6\x94\x41\x45\x82\x63\x88\x58\x38\x5a\x65\x1e\x56\x5c\x98\x9f\xf0\x18\xea\x63\x96\xfb\x98\x7e\x5d\x0e\xe4\x4a\x7d\x1d\x92\x55\xc9\xd3\x76\x1b\x11\xdd\xb0\x91\xc1\xb0\xc8\x9a\xa7\x26\x75\x58\xc7\x44\x7c\x7f\x35\x0c\x9d\x18\x8b\x72\xa8\x15\x11\xd5\x57\x2f\x1a\xcf\x24\x75\x06\x0f\x77\xfc\x71\xc3\x6e\xe0\x15\xcc\x8c\xdf\xc3\x6f\x7e\xfb\x9b\x50\xee\x88\x12\xbc\xde\x19\x51\x64\xba\xbb\x07\x18\x32\x69\x16\x1d\x8a\x98\x6e\x50\x93\x36\x30\x81\x39\x4c\x1a\xd8\xa4\x69\xaf\xc4\xb0\xd7\x80\x73\x5e\xaa\xa1\x4c\x82\xa6\xbc\x2f\xe9\xaf\x9f\xce\xd3\x76\xc7\x1b\x91\x7a\x5f\xc0\x2a\xfa\xeb\x83\xa9\x2c\x5f\xcf\x54\x7a\xb9\x4c\x9f\xcc\x54\x71\x85\xae\xe9\x46\x0b\x1d\x81\x06\xb5\x6c\x83\x18\xb6\x85\xfc\xcf\xfa\xf9\xe7\x0b\x2d\x9a\xab\x32\xbc\xaa\x3f\xff\xbc\xd0\xd6\xa4\x15\xc9\x26\x74\xc0\x66\xaa\x46\x71\x10\x3d\x8d\x58\x1d\x9b\x94\x78\x23\x77\x1b\x33\x03\x99\xd8\x02\x0d\x14\x38\x0a\x79\x59\x93\x73\xf9\x42\x45\x5e\x10\x7a\x8f\x41\x7c\x34\xbc\x82\xb4\x85\xe9\xbe\xba\x84\xbd\xd4\x79\xb7\x28\x4d\x66\xc4\x58\x03\xd9\xc2\x16\x65\x7d\x51\x65\x7e\x39\x47\xc8\xe6\x34\x40\x39\xa9\xa2\x9c\x71\x81\x7c\x8e\xda\xd8\xac\x61\xdd\x02\xad\x83\x0c\xe2\x97\x3d\xce\xf7\xd9\x09\x40\x44\x1a\xe2\x04\xd6\xe3\x93\xb2\x2a\xc7\x33\x50\xfa\xa0\x89\xf8\xf2\x94\xe8\x4e\xf7\x6e\x1b\x14\x90\x8d\x86\x83\x7c\x9f\xf1\x94\x49\x00\xef\xdb\x89\x59\x97\x8c\x3a\x26\x36\x86\x3c\xf2\xa6\xec\xd4\x37\xf6\x62\x5e\x5d\x28\x54\xf2\x25\x59\x81\xa5\x52\x5e\x5e\x78\x89\x5f\x38\x11\xf1\x99\xde\xb1\x34\x7b\x19\x5e\x34\x4f\xca\xa2\xd7\xbc\x74\xb0\x6d\xf1\xab\xd9\x89\xf4\xd4\xb1\x0e\xa1\x04\xf3\xcd\x35\xf3\x8b\x29\x58\x37\x48\xa3\x89\xda\x90\x83\x69\x8c\x9c\x36\x25\x36\x68\x36\xc3\xd8\x86\x32\x43\x75\xdb\xa8\xf3\x4b\x93\xe3\x95\xe2\x74\x58\x2d\xa3\xf3\x3b\x87\x98\xd8\xb2\x7e\x17\x24\xbf\x32\x4a\x9d\x86\x11\xb3\xa0\x42\x71\xcd\xa9\xb7\x00\xf9\xfb\x02\xe1\x0a\x4a\x54\x6b\x1e\x13\xba\x5d\x84\xa6\xac\xbf\x64\x18\x07\x8a\xe4\xb2\x6f\x51\x3a\x88\x38\x86\x4d\x46\x3a\x25\xb2\xbe\xe0\x19\x9b\x3a\x88\x74\x2a\x82\x1d\x0a\x69\x26\xca\x67\x23\x9c\x43\x2d\x67\x95\x5a\x4d\x04\x10\xad\xae\x81\x3f\x89\xe6\x99\x23\x54\x72\x9d\x0f\x52\x96\x45\xc6\x5d\xea\xe3\x34\xf4\x0b\x1f\xc9\x2c\xe0\xc8\x61\xf3\x4b\x47\x32\x50\x07\xa0\xc9\x17\x83\xfe\x7f\x9e\x12\x82\xeb\x36\x9c\x90\x94\xa0\x1d\x0a\xc9\xc2\x9c\x90\x2b\x02\x44\x4c\x51\xca\x58\x3c\x8a\x32\x8a\x44\x51\x26\x65\xc8\x2f\x69\x50\x6e\x22\xd6\x46\x75\xbe\x29\x48\x24\xfb\x2c\x7f\xe0\x39\xfe\x90\xb1\x55\xf4\x57\xa2\x2c\x1b\xd9\x38\xe8\xcf\x2c\x78\x01\x2a\x5e\xc7\x6d\x0f\xac\x9c\x8d\x80\x3e\xf3\xe2\xd9\x28\x19\xff\x3c\x15\x05\x49\x93\x0a\x12\xcc\x51\xab\x63\xd8\xc8\x84\xbc\xd3\xae\xf9\x6b\xb8\xfd\x5e\x6c\x30\xa3\xbf\x2b\xe0\x15\xe3\x29\xa3\x94\xd4\x3e\x2e\x39\x16\x8d\xcc\x6a\x88\x44\xbd\xab\x8e\x41\x66\xc8\xa9\xa3\xd8\x58\x3c\xf0\x18\x85\x9f\x54\x55\xa2\x4b\xa0\x75\xa3\x7b\xc7\xee\xde\x81\x62\xf7\x0e\xe9\xde\x61\xc8\xc4\xa0\xf1\xe1\x1c\x69\x88\x68\x2c\x20\xc6\x74\xc8\xd9\x16\xf2\xd2\x39\x83\x70\x90\x4c\xac\x01\x74\x6c\xde\x72\x79\x9a\x51\xbb\x89\x99\x05\x56\x47\x82\x35\xa0\x92\xd0\xfd\xc5\xf9\x21\x93\x00\x8b\xf9\xf8\xd4\x80\xb7\x63\x8e\xc1\x2b\x65\xe3\x95\x0e\x95\x37\x5b\x75\x0f\xbd\xb6\x6b\x59\xea\xba\x96\xc2\xb9\x96\x9d\xf1\x5f\xac\x55\xcb\xc6\x6d\x28\x53\xd3\x6a\xa1\x40\xb0\xe7\x3c\x8e\x56\x84\xc4\x11\x50\x31\x72\xe2\xfa\x1e\x43\x0d\xfd\x94\xc1\xec\x20\x9e\xc0\xca\x1d\x81\xd9\xee\xb7\x4c\xc7\xcc\x21\x0d\x2b\xe7\x6f\x31\x4e\x63\x9b\x19\xb8\x66\x35\xb0\x25\xb5\xa5\x9a\x34\xc7\x7b\x64\xbc\xf2\xa1\xf4\xc8\xcc\x24\x21\x0b\x8b\xf3\x6a\x21\xdc\x10\x81\x62\x9a\x28\x01\x12\x6d\x1e\xf1\xc8\x49\x78\xcd\x4e\x13\xf9\xbb\x54\x98\xe4\xfa\xb6\x73\x45\x3e\x64\x63\x50\x47\x4a\x11\x4e\x4e\x4a\xc9\x49\x74\xb5\x11\x81\x2a\xae\x37\x09\x35\x69\xc3\xf0\xf7\x6b\xa5\xc4\xc2\xf4\x00\x86\xdf\x4a\x99\x74\x51\x5a\x36\x73\x27\x90\x85\x6c\x04\x1a\xae\x3b\xcc\xb0\x03\xee\x24\x75\x0a\x2a\x66\x9f\x9c\x5c\x2a\x4a\x50\x46\x9d\xa6\x61\x1b\x56\x7f\x7a\x91\x64\xe6\x21\xe2\x44\xe4\x90\x68\x5f\xc8\x48\x3a\x15\x02\xa7\x26\x23\x9d\x59\x92\x17\x35\x75\xb6\x0a\x2f\x7a\x43\xb2\x3c\x3f\xcd\x8d\xf2\xa2\xdd\xb9\x08\xf2\x6c\x44\xca\x41\x34\xca\x21\x90\x48\xa0\x48\x90\x97\x60\x24\xd5\x14\x87\x7b\x56\x07\x46\x96\x46\x11\x86\xa0\xc9\xb8\x6a\xba\x63\x9a\x08\x5e\x80\x39\xd4\x46\x3a\xc8\x26\x14\x9b\x0e\x43\x06\x83\x12\x59\xc1\x96\xdd\xc6\xc4\x86\x17\x15\x50\x40\xf4\x70\x96\x72\xb1\xf7\xc3\xe2\xe3\x0b\x54\x94\x3c\x2c\x20\xb6\x0a\xf3\x88\x0b\x7c\xe0\x52\x02\x1f\x64\xfe\x40\x92\xe3\x44\xf9\xdf\x65\x28\x32\xea\x74\x40\xed\xe0\x20\x2a\xdb\x82\x69\x6c\x36\x0c\xa7\x0d\x9a\x7c\x54\xd8\xb3\x19\x05\x8e\x87\x69\x47\xc5\x8d\x1b\x6f\x04\x75\x34\xfb\xee\x8d\x1f\x7a\x61\x1a\x04\x0b\x05\xe3\x40\xa9\x7e\x2e\x3f\x3e\x0b\x41\x93\x52\xe6\x91\xd5\x31\xfa\x4d\x2e\xe4\x8f\x69\xc2\x26\x21\x97\x17\x35\x84\x01\x24\x9e\x47\x06\xcf\x59\x66\x5e\xc7\xb4\x3c\xc8\xd3\xa5\xc0\x8b\xdc\x3e\x07\x97\x34\x36\x45\xf2\x27\xf5\xf7\xd3\xa6\xb1\xb9\x54\x98\x06\x55\xac\x0d\x97\x15\xad\x31\x27\x98\xd4\xcc\x95\x99\x97\x2b\xf2\xac\x5c\x29\x2d\xc2\xb4\xbc\x38\x0f\x39\x28\x8b\x64\x62\x7e\x14\x24\x9b\xe0\x2b\x67\xe6\x93\x6b\x0e\xcc\x34\x51\xcd\x80\x92\x65\xa2\xb6\x51\x0f\xaa\x53\x96\x8e\x49\x9a\x24\xb4\xea\xa9\xc0\x48\x40\x84\x9f\x44\xc3\xb1\x05\xb9\x5f\xdf\xa3\xe5\x24\x6d\x94\x17\x53\x4d\x4e\x14\xd5\x61\xc6\xb0\x6c\x66\xd4\x82\xd0\xad\x70\xa1\xa4\xac\xa5\x57\x38\x0d\x1c\xa9\x99\xd8\xf1\x96\xcd\x10\x94\x88\xee\x78\xc6\x91\xd9\x6f\x03\xbc\xd1\x48\xe2\x27\x4f\x47\xf2\xc3\x97\xc9\x7f\x7e\x0f\x19\x78\x7e\x49\xd5\xaa\xea\x7c\xfc\xf5\x88\x89\x07\xbf\x65\x07\x6c\xe3\x68\xd3\xb2\x37\xfb\xc7\x88\xd5\x9b\xd0\xe8\x0f\xed\xa2\xb9\x22\x97\x19\x92\x35\x92\x91\x4e\x8d\x31\x22\x9d\x19\x72\x2c\x2b\xb7\x80\x96\x97\xb1\x01\x27\x70\xa3\x8d\x08\x09\xe2\xae\x82\x40\x6e\x09\xe6\x85\x50\xe7\x34\xbc\x10\xe9\x2c\x14\x8b\xc3\x2b\x32\x06\x38\x6b\x06\xb6\xac\x23\xb0\x22\x81\x42\xbb\x9f\x06\x31\x3a\x51\x9c\xb4\x67\x94\xef\x05\x07\xc0\x51\x8f\x37\x50\xe4\x90\x85\x8c\xaf\x63\x2c\x19\xc1\x52\xfb\xcb\xf0\x0a\x1f\x8b\xa5\x2d\xc1\x8c\x63\x58\x35\x87\x35\x40\xa8\x1f\xe2\x97\x30\x78\xd8\x40\x04\x7a\x84\x1e\x8c\x41\x8f\xb2\x32\xf8\x66\x88\x94\xb1\x94\x3c\x83\x90\x34\xef\x54\x0b\x4a\x61\x56\x5d\x2c\xe5\x65\x98\x29\x40\x41\x2b\xcb\x8b\xb2\xdf\x0f\x0b\x3b\x6a\x69\x28\xbe\x27\x3f\x3e\xd0\x8d\x67\xdd\x70\x4a\xe7\x97\x8f\x67\x25\x97\x8f\x4f\xca\xec\xbe\xe7\x6e\xb8\x37\xc1\xbd\xee\x7e\xe1\xfe\xe8\xde\xfb\xdb\x19\xf7\x0b\xf7\x81\xf7\xf8\x83\xbb\x05\xee\x5b\xee\x37\xee\x83\xb8\xa9\x3a\x00\xe5\x6b\x09\x0c\xf2\x72\xa2\x94\x4c\xed\xd7\x28\x8e\xd8\x54\xe8\x95\x5f\x43\x91\xe8\xa0\xdf\xfe\xe6\xb7\x62\x20\x87\x05\x98\x78\xa3\x10\x64\xd9\x06\x69\x20\x7d\xc5\xc0\x6b\x98\xc0\x92\xca\x47\xc6\x65\x2c\x15\x05\x17\xa8\x42\xf4\xdc\x92\xa4\x4a\xb3\x19\x87\x7f\xbb\xf7\x2e\xec\xde\xff\x78\xef\x8d\xd3\x7b\x17\x4e\xef\xdd\xb8\xbb\x77\xe3\x8d\x27\xd7\x36\x7e\xfe\xfa\xa3\xdd\x07\x0f\x7a\xe7\x3e\xeb\xbd\x79\x8f\x53\x95\xc0\x86\xec\xa9\x65\x22\x31\xa9\xb9\x81\xb0\xb5\xe6\x08\x61\xbd\xbf\xbc\xba\xf7\xd5\x87\x8f\xdf\x7e\xfd\xf1\x9f\xde\xea\x7d\x7c\x7b\xef\xca\x17\x7b\x97\xfe\x12\x18\x1b\x10\x96\x8a\x15\xe5\x0d\x13\x36\x8e\xa4\xdd\x7b\xaf\xf7\x2e\xfd\xa9\x77\xf1\xdd\xde\xe7\xff\xf5\xe4\xfd\xd7\x7a\xef\x7f\xf8\xf3\x99\x5b\xbb\x0f\xbe\x1f\xa2\x6a\x10\x1e\x4a\x4a\x2d\x16\x09\x4b\xcd\xcd\x24\xef\xc2\xde\x37\xff\xf3\xe4\xd3\xab\x7b\x77\xcf\xfd\xfc\xcd\xd9\xdd\xfb\xb7\x1f\xff\xe5\xd6\xe3\x8d\xd7\x7a\x1b\xe7\x77\xb7\xae\xed\x6e\xbd\xba\x7b\xef\x7a\xdf\xaa\x9f\x22\xfe\xb2\xa9\x45\x43\xc1\x23\x4c\x44\xb2\x47\x60\x0e\x49\xfc\x3f\xb6\xce\xf3\x86\xff\xb1\xf5\xc6\xff\xa9\x2a\xf4\xde\xff\x7e\xef\xda\xfd\xbd\x6b\xf7\x7b\xf7\xdf\xd9\x3b\xbf\xf1\x64\xfd\xfb\xbd\x77\x5f\xef\x5d\x7c\x77\x77\x6b\xb3\xf7\xc9\xdb\x8f\x37\x2f\x3f\xbe\xf3\xed\x93\x6b\x1f\x8e\xac\x45\x46\x1b\x89\x77\x7b\xa0\x22\x93\xfa\xff\xf1\xfa\x9f\x7f\xbe\xf4\x46\xf0\xb2\xf6\x2e\xbe\xb7\x7b\xff\xb3\xde\x25\x2f\xb1\xf7\xa7\x33\x03\xae\x1e\x86\x8d\x1c\xee\x97\x89\x7d\xeb\x3f\x66\x52\xf2\x79\xef\xfd\xef\x7b\x97\xbe\x78\xf2\xf6\xcd\x27\xa7\x37\x7b\xf7\x5e\xdd\x3b\x9d\xd6\x16\x25\x51\x91\x4f\x4e\xa7\xb5\x42\xa7\x1f\x8c\xf7\x29\xf9\xd6\x3f\xfe\xa4\xf7\xdd\x57\x7b\x5f\xff\x71\xf7\xfe\xfd\xde\xdd\x1f\x77\x7f\xfc\xe0\xc9\xb5\x3b\x83\x5f\x67\xe0\x8e\xde\xf9\xd7\x86\x89\x1c\xc7\x4c\xe4\x3d\xce\x5c\xec\x43\x2e\x31\x43\x2d\x8a\x0b\xea\xce\xfa\xf5\x9d\xf5\xb7\x76\xd6\x6f\xec\x6d\x7e\xd2\xdb\x7a\x73\x77\xeb\xfa\xe3\x8f\x7f\xe0\x8f\x10\x73\x90\xc8\x81\x22\x34\xf6\xa1\x98\x1e\x08\xf8\x3d\x1a\xb9\x62\xdf\x41\x04\xaa\xcc\x3f\x8c\x37\x44\x81\x80\x39\x7c\x09\x3b\xaf\x9e\xd9\x79\xf5\xc2\xce\x99\x33\x3b\x67\x2e\xf4\xde\xf8\xe6\xf1\xe5\x3f\xfb\x95\xdd\xda\x59\x3f\xbf\xb3\x7e\xc1\xfb\xe3\xcc\xfd\x21\xca\xb2\x14\xfd\x15\x04\xaf\xbf\xe3\x73\x3c\xd8\x59\xbf\x1c\xfe\x71\x69\x67\x7d\x7d\x67\xbd\xdf\x6c\x0c\xea\x1c\x5e\x42\x6c\x27\x52\xe4\x89\xe9\x99\xe4\xbd\x19\xba\xe0\xca\xce\xfa\xe7\x7d\xbe\x33\xf7\x76\xd6\x3f\xf2\xbd\xf3\x55\x6f\xe3\x7c\x6f\xe3\x62\x8a\xc8\x03\xca\x71\xed\x34\x5f\x9e\x6f\x9b\xf9\xf4\x0c\x52\x7f\x79\xed\xd2\xd3\xab\x6f\xfd\xfd\xbf\xbf\xdf\xdf\xbc\xb1\x7f\xfb\xf4\xfe\x47\x5b\xb0\xff\xd1\xd6\xfe\x1f\x3f\x78\x7a\xfd\xe2\xfe\x99\xcf\x38\x71\x83\xc8\x50\x4d\xa2\x40\xa4\x26\x91\x1e\xa8\x69\xd1\x11\x6a\xf6\xdf\xbb\xbc\xff\xde\xc6\xfe\x07\x5f\xef\x7f\x77\x35\xe0\xdb\xbf\xbf\xf1\xf4\xf2\xd6\xd3\xf5\xf3\x43\x54\x8d\x28\x71\x58\xf2\x00\x74\x5a\x7f\x19\x6c\x86\x0c\xd3\x20\x0d\x38\xe6\x58\x46\x0b\xe6\x28\xb6\xd0\x11\xa8\x53\x62\x1b\xc4\xa1\x8e\xc5\x55\xe3\xe9\x95\x1b\x7f\xff\xe6\xf3\x5f\x6e\xff\xe7\x2f\x6f\x9e\xfd\xe5\xcc\xe6\x2f\xef\xdc\xd8\xbf\xb1\xf9\xf4\xca\x8d\x01\xf5\x83\xc0\x48\xb4\x58\x20\x16\x2d\xa6\x67\x16\xfd\x8a\xd3\x44\x64\xa4\x68\xde\x72\x5f\xd8\xd3\xcb\x5b\xfb\x57\x3e\xde\xbf\x7d\xfa\xe9\xc5\x8d\x5f\x6e\x7f\x1c\x4f\x52\x86\x65\x3f\xb3\xf4\x60\xa6\xd2\x17\x6f\xe2\xe0\x04\xb3\xa0\x9d\x50\x92\x8b\xf5\xc3\x8b\x3a\x85\x53\x18\x08\xc6\x7a\x84\xe7\xb2\x2d\xa7\xd3\xa1\xcc\xfe\xd7\x97\xb8\x8a\x6e\x7f\xbd\xfd\xe5\xa3\xf5\xed\x3b\xb0\xfd\xd5\xa3\x73\x8f\x2e\x6c\x7f\x09\x8f\xce\x6d\x7f\xfe\xe8\xdc\xf6\x5d\xd8\xbe\xed\xa5\x6d\xdf\xde\xfe\xee\xd1\x85\x47\x67\x61\xfb\xbb\x47\xe7\xb7\xef\x3e\x7a\x1d\x1e\x9d\x7d\x74\x76\xfb\x93\xed\xcf\xb6\x6f\x6f\x7f\xf9\xe8\xf5\xd8\x0f\xcf\x62\xc2\xf7\x55\xa8\x85\xf7\x13\x97\x16\xf8\x08\xb1\x83\x7d\x54\x43\x96\x51\x8f\x16\xff\x74\x6c\x19\x0d\x82\x6c\xca\xf8\xa5\xe5\xd5\xba\x89\xfd\x0d\x33\x0d\x23\x90\xf5\x15\x4c\x6c\x87\x61\x0b\xf4\x64\x08\xc0\x30\x64\xa8\x5a\x1f\x08\x06\x88\x52\xfa\x57\x5d\x8c\x5c\x4c\x1f\xae\x03\xa8\xf7\xff\x58\x4a\xa2\x22\x87\xac\x85\xd2\x71\x64\x50\x3a\xa9\x02\xff\x08\xc9\x87\xee\x15\xff\x38\xc9\x3b\xee\xa6\x7b\xd5\x9b\x5a\x7f\xe2\xbe\xef\xbe\xe3\x1f\x25\xb9\xea\xde\x05\x77\xc3\xbd\xcc\x4f\xc8\x0f\x2e\x12\xcd\xcb\x83\x92\xf1\xac\x3c\x78\x0e\x74\xd5\x1a\xa3\x8e\x11\xa9\x95\x52\x5e\x06\x79\x86\xdf\x1c\xf0\xd3\xa2\x50\xcc\x19\xe1\x74\xe9\x4c\x36\xbb\x86\x56\x2d\x29\x05\x28\xa8\x2a\x6f\x39\x48\x8d\x82\x88\x82\xcc\x38\x84\x28\x78\xce\x60\xdd\xdd\x70\x7f\x74\x7f\x70\x1f\xb8\x3f\xfc\x6d\xdd\xfd\xc2\xfd\xce\xfd\x1e\xdc\x2b\xfe\x19\x1b\xc1\x81\x03\xa8\xc8\x5f\x31\x38\xf6\x59\x9c\x96\x45\xc1\x4d\xf7\x23\xf7\x8a\x7b\xcd\xbd\xe5\x7e\xe2\x6e\xba\x6f\xbb\x57\xdd\xf7\x60\x50\x41\x0a\x2a\xd2\x30\xa8\x60\x0c\xfe\x57\x50\xbb\x46\x4d\x98\xa7\xfe\xfd\x44\xa2\x97\x85\xbc\xf8\x08\xc1\x8c\x78\x84\x20\xa3\xab\xfd\x5b\x24\xf2\x0c\xeb\x86\x0d\x05\xe1\x35\xe1\x72\xa2\x5f\x54\x7c\x59\x0a\x59\xdf\x96\x59\xc4\x4c\xdc\xc9\x05\x3b\xa6\x50\x66\xe2\x66\x82\x90\x1b\x2d\xec\xb3\xe4\xca\x3e\xcb\xbe\xfb\x56\x51\xe5\x6a\x49\x06\x55\x2b\x15\x8f\x57\xe4\xc5\x63\x05\xd0\x25\xe1\x14\xf1\x20\x22\x6e\x8a\xf4\x44\x33\xa4\x67\x6d\x84\x52\x58\xf5\x6c\x9c\xfa\x64\x8c\x33\x06\xf1\xde\x8e\xbc\xe9\xd4\x60\x7a\x6e\xb0\x03\x10\xf3\x0f\xa7\xd9\x4f\x72\x0a\x0d\xec\x10\xc2\xc9\x9b\xd5\x9f\x2e\x62\xab\xd5\xbd\x05\x1d\xfa\xf0\x96\x8d\x8e\x80\x25\x75\xf8\x0a\x8a\xd9\x51\xf0\x57\x00\x8a\x08\xfb\xcf\x63\xd3\x81\xd5\x39\x98\xab\x33\x19\x93\xbf\xc7\xd5\x3f\x0f\x59\xc4\x94\x35\x0c\xee\x78\xd6\x60\x86\xcf\x95\xf8\x20\xc6\xd8\x29\x3e\x46\x0d\xff\xc8\x01\xf5\xc3\xd6\x83\xc1\x85\x5c\x9d\x87\x7c\x70\x74\xd0\xbf\x5f\x26\xb1\x51\x9d\x9e\x1b\xe8\x18\xb4\x26\xe8\x4a\xcd\x9e\x44\x67\x1b\xb3\xba\x11\x1e\x1c\xf5\x63\x77\x3b\x8c\xb6\x6b\xc1\x1e\x6f\x5f\x68\x32\x39\x4d\x21\x6f\x67\x84\xd2\x04\x2c\x9b\x62\x2d\x3f\x0d\xd5\xc2\xe2\x4c\xa1\x92\xeb\xef\x08\xf7\x85\x89\x89\xe1\x0f\x38\x9d\xf8\x05\xa7\xb3\x12\xf5\xaf\xb6\x03\xc5\x40\x35\xc3\x34\xec\xd5\xbe\x6f\x0d\x6c\x81\x5c\xad\x94\x8e\x2f\xf0\x3f\x5e\xff\x99\xbf\x27\x2f\xad\xe4\x84\xb1\x24\x79\x55\x2d\x17\x2a\x72\xb5\xb4\x24\xc3\xa2\x7c\x52\xae\x14\xaa\x73\xa0\x54\x67\xf8\x4d\xa9\x34\x4c\xa4\x28\x80\xf2\xf7\xe9\x21\x2e\x30\xd2\x1e\x79\xca\x89\x06\x11\x29\x2b\x08\xf2\xb4\x83\x96\x0d\x90\x19\x6e\x38\x28\xa8\x26\xd2\x91\x70\xe2\x69\x08\x58\x70\x4d\x50\x46\xbc\x43\x30\x48\xcb\xa0\xc7\xfd\xd0\xbd\xe9\xbe\x0d\xcf\xbb\x57\xdc\xeb\xee\x4d\xf7\x86\x7b\xc5\xfd\xf0\x79\xfe\xf0\x9e\x90\x11\x1d\xf8\x0b\x8a\x09\x83\x83\x30\x25\x20\x75\x5a\x23\x7f\x81\xaa\xba\x04\xf2\xc9\xd2\x2b\x32\x14\x2b\xc7\xcb\xdc\x2f\xcf\xa7\xf9\x4c\x55\x55\x08\xd2\x09\x1e\x33\x71\xcc\xab\x15\x59\x81\x6a\xa5\xb0\x54\x50\xfa\xe5\xa2\xcd\x69\x2e\x6b\x7c\xa2\x44\xd0\x41\x15\xe6\x9b\xfe\x8d\x27\x6a\xcd\x2c\x10\xcc\x1a\x94\xbb\x3c\x64\x30\x27\x18\xb4\x56\x85\x41\x6b\x35\x6b\x9d\xf2\x34\x37\x6b\x98\xfe\x54\x9a\xbb\xbb\x23\x7e\x5f\xd2\x72\x83\x68\x37\xf1\xbc\xf7\x54\xd6\x13\x12\xd5\x05\x79\x11\xf2\x6b\xb8\xde\x04\x4b\x62\x92\xd8\x3f\x73\xb9\x71\xdf\x15\x82\xb8\x4e\x85\xf1\xfd\x65\x7d\xd4\x1e\xa5\x5c\x91\xa7\xab\x85\x3c\xcc\xa9\xca\x4c\x69\xb1\x08\x39\x28\x83\x74\x0c\x24\xd0\x40\x02\x21\x80\x43\x04\xc6\x81\x2c\x70\x4c\x02\x2d\xc0\x4e\x10\xbb\x51\x6d\x62\xca\x70\x1b\x05\x97\x32\xd9\xdd\x4d\x40\xc0\xb0\xd5\xa1\xc4\x0a\x1a\x9f\xee\x26\x98\xfe\x07\x66\x23\xf1\x8e\x00\xbf\x98\x70\xdd\xd3\x81\xa5\xc5\x1b\xa0\x02\x78\x25\x01\x57\x62\xf8\xd0\x4b\x21\x53\xb7\xb9\xfb\x8a\x72\xd9\x6c\x67\xa8\xc8\x88\xd2\xbf\x66\x45\x16\x4e\x80\x42\x1b\x86\x65\x1b\x75\xb0\x3a\xdd\xaf\x1e\x9e\x69\x21\x58\x03\xda\x60\x88\x18\xf5\x35\x4a\x7e\x3a\x07\x54\xef\xd0\x53\x06\xd6\xd7\x0c\x64\x12\xfa\xf0\xfd\xba\xf1\xd3\x39\x3e\xbe\x20\x36\x11\x0f\x7b\xc6\xb2\x14\xbf\xcb\xe3\x95\x1b\x1a\x29\x3f\xac\xa6\x6a\xb1\xa4\x55\x4b\x79\xd0\xca\xdd\x77\x1e\xbe\x3a\x2f\xc3\x49\x50\x8b\x15\x79\xb1\x94\x3f\xa9\x2e\xfe\x74\x16\xd4\x99\xb2\x7a\xa2\x54\x98\x39\x59\x92\x95\x45\xf5\xe1\xf5\x7c\xe9\xa7\xb3\x89\x9a\xf6\x4d\xc4\x57\x8c\x8e\x65\xe9\x9f\x52\xd3\x7c\x69\xa9\xa4\xc0\xb4\xb1\x66\x53\x62\x75\x6f\x35\x0c\xd0\xd6\xa8\xd9\xe8\xde\x32\x91\x0d\x27\xbb\xb7\x98\xdd\xea\x7e\xcb\x1e\x7e\x89\x09\x2c\x3c\xfc\xb2\xd5\xfd\x56\x7f\xf8\x16\x54\xba\x77\xac\xb5\x95\xee\x1d\xb2\x6a\xf3\xf7\xac\x44\x6d\xdf\x08\x9b\xa1\x2f\xc6\x34\x1d\xf9\x62\xcc\x72\x43\xaf\x7e\x49\xf3\xc5\x94\x66\x23\xdd\xc4\x0c\x0a\x3a\x36\x2d\x1b\x35\x4d\x2e\xc2\x45\x38\x9f\x99\xc4\x45\x4d\xbb\x88\x9f\x20\xd6\x3f\x5d\xc2\xff\x4b\x9c\x83\x1d\xc6\xcf\x61\x27\xe0\x56\x6d\x1b\xb3\x1a\xaa\x37\xfb\x07\x1a\x60\xbe\x18\x3b\x80\xbf\x80\x28\x81\x8b\x2e\xee\x11\xe1\x63\x87\x6f\x15\xb4\x13\xa0\xd1\x65\xfb\x14\x62\x18\x4e\x20\xa6\x23\xcf\x24\x17\x8f\xc5\xc7\x6f\x0d\x82\x39\x19\x89\x32\xe3\x2b\x71\x18\x05\xc5\x58\xc1\xe2\xb1\x0b\xad\xc4\x0f\x4b\xd3\x41\xd1\xc7\x5e\x12\xcf\x9e\x95\xe2\xf0\x28\x73\x65\x7c\x6a\xe1\x1a\xdb\xd1\xcc\x43\x6f\xb4\x1d\xc9\xab\x95\xe4\xe1\x76\x0f\xbb\xca\x03\x11\xe5\xda\xb3\x72\x67\xad\xf4\x00\xb3\x22\x57\x2b\xea\x22\x28\x05\x6f\x04\x5a\x52\x40\x93\x64\xa8\x88\x67\x75\x93\x18\x2e\xfa\x2c\x84\x72\x51\x60\xdd\x4d\x60\x92\x29\x65\xbc\x9d\x34\x36\xdd\x7d\xa3\xcf\xdf\x3d\x3d\x42\x40\x80\x8a\x15\xc4\xe0\x43\x95\xb0\x29\xf5\x0d\x64\x91\x10\x81\x0f\x51\x82\x70\x4d\xdb\x70\xf2\xc4\x65\x6d\x59\x68\xff\x37\x00\x00\xff\xff\x8e\xe0\x1e\x0c\x07\x5f\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x7c\xcf\x73\xdb\x46\x96\xff\xdd\x7f\xc5\xab\x1c\xa2\xa4\xbe\x16\x3c\xf3\xad\x3d\xe5\xb2\x05\x51\x14\x45\x11\x12\xb8\x04\x25\x6f\x7c\x99\x6a\x12\x2d\x12\x43\x10\xcd\x6a\x80\xf2\x4a\x87\x29\x47\xb2\x13\xc7\x63\x27\x4a\x62\x27\xb6\xe3\x78\xe4\x24\xbb\x2e\xe7\x97\x93\xcd\x8f\x75\x6c\x25\xa9\x1a\xc6\x77\xcc\x4d\x3e\x4b\x94\xe3\x89\x5c\xf3\x2f\x6c\x01\x20\x1a\xdd\x20\x48\x81\xb4\x32\xb5\xe5\x2a\x97\xd8\xfd\x79\xef\xf3\xe9\x07\xa0\xbb\xd1\xfd\x1a\x93\xc7\x00\x2c\xd4\xc4\x2f\x81\xda\xc2\x16\xcc\xb4\x6d\x83\x58\x50\x74\x56\x41\x71\xf4\x63\x00\x15\xbc\x4c\xa8\x58\x7b\x0c\x40\xc7\xf6\x4b\x1c\x48\xc7\xf6\x1f\x6c\x47\xf7\x8b\x24\xaf\x4c\x3a\x06\x60\x22\xab\xf6\x12\x60\x0f\xde\x22\xb6\xe1\x18\xc4\xf2\x7e\xea\xc7\x22\xca\x05\x7c\xda\x86\x0c\xa1\x2d\x42\x91\x13\x78\x0e\xf9\xbc\xaa\x90\x48\x44\x30\x32\xb1\x38\x05\xdd\x49\x6c\x2f\x23\xda\xc4\xd4\x06\xc5\x68\x1a\x0e\xe6\x1b\x18\x55\x86\xb4\x11\x86\x51\x46\x45\x23\xd2\x39\x87\x50\x89\x61\x4c\x1f\xc1\x1c\xa2\x8e\x85\x29\xe4\xad\xaa\xc4\x31\xf4\x8a\x43\xf7\xbd\x5a\xe6\xbf\xf7\x7b\x34\xff\x43\xdd\x8f\xe9\x9d\xb6\x5b\x2d\x02\x33\x84\x36\xdb\x26\x02\xad\x25\xf3\x24\x42\x65\xc8\x15\x60\x18\x97\x26\xb5\x24\x39\x62\x33\x9c\x11\xd8\x8a\x29\xd8\x8a\x63\xb3\xcd\xaf\x6a\xff\xa6\x80\x3c\xc5\x71\xf8\x45\xa1\x6b\x79\x8a\xf7\x2c\x4f\x31\xaf\x36\x1e\xe2\x55\x06\xad\x5a\x6f\x9b\x4d\x64\x41\xd1\x44\xb6\x63\x54\x6d\xd0\x14\x8e\x23\x01\xc0\x1a\xa3\x88\x6d\x51\xb8\xab\x64\x0f\xe1\xd4\x9c\xce\x3d\x13\x5b\x30\x8f\x1c\x90\x35\x8e\x8b\xab\x60\xad\xd2\x84\x56\x69\x8c\x41\x47\xc3\x62\x85\xab\x30\x8d\xad\x26\xa2\x0d\x90\x4f\xf0\x14\x5c\x0d\xa3\x38\x21\x72\x9c\x18\x87\xe4\x30\x8e\xb1\x9a\xa1\xd5\xb1\x55\x03\xcd\x20\xde\xff\xed\x16\xa6\x9e\x4b\xec\x40\xd1\xc1\xb1\x1e\x60\x00\x32\xea\x59\x71\x7f\xcf\x8a\x47\xe9\x59\x27\xb4\xec\x82\x5a\x54\x64\xad\x0c\x05\x13\xb7\xec\x6a\x1d\xda\x96\x0e\x19\x02\xb9\x66\x65\x76\x82\x97\x12\x07\x86\x22\x26\x62\x06\x4c\x8a\x57\x10\x05\x04\x8f\xa6\xe2\xf9\xd1\x34\x3c\xff\x8c\x0a\x0a\x98\x5a\x0d\x8a\x96\x9d\xd3\x98\x36\x60\x8a\x92\x86\x4e\xe8\xb2\xef\x20\x90\xa2\xce\xe6\x38\x21\xc9\xf0\x50\x4d\xdc\x4a\xd0\xc3\x57\xa4\x10\x76\xaa\xdd\x68\x5b\xcb\x8e\x5d\x41\xed\x1a\xb6\xb1\x69\xda\xd5\x3a\x5a\x76\x60\x7e\x6a\x96\xd3\x33\x00\x15\x0a\x0a\xc0\x63\x84\x45\x6e\x3b\x24\x83\xec\x3a\x64\x27\x15\x82\x74\xc3\xaa\x41\xae\x52\xe2\xbb\x91\x3e\x00\x0b\x42\xa5\x24\x70\x56\x4a\x29\x29\xab\x55\x6c\xdb\xc4\xa0\xd8\x86\x22\x69\x53\x58\xc2\x26\xb1\x41\x85\x1c\x4c\x83\xe2\x74\xee\x60\x9e\x7e\x08\x38\x1a\x33\x03\x1b\x6e\xd4\x0c\x0a\x02\x31\xcb\x74\x7c\x31\x78\x74\x2d\x78\x5c\x29\x27\x09\xb1\x26\xb3\x16\x9c\x22\xb4\x56\xc5\x96\x43\xdb\x4d\x50\xad\x35\x3c\xa9\x18\x78\x05\x4f\x2e\x51\xd2\x3e\x0d\x4b\xc8\x02\xd9\xf2\xee\xca\x16\xb6\x60\x09\xd3\x06\xa1\x0e\xa8\xe6\x0a\x82\xa5\x53\x27\xf9\xa9\xc5\xb3\x79\x0b\xdb\x13\x38\x65\xcd\x09\x7e\x06\x8d\xb1\xcc\x21\x8d\xc9\x57\x30\xc5\x36\x06\x8d\x54\x0d\xac\x23\x1d\x64\xab\xf3\xb5\x65\x34\x11\x27\xb1\x87\x61\xa3\x52\x02\x34\x1a\xa4\x12\x2a\x53\x8c\x58\x09\x32\x48\x6a\x15\xe4\x88\x44\x2c\x61\x8a\x0d\x6f\xce\x8c\x29\xcc\xb5\x6b\xd8\xd2\x97\xdb\x98\xda\x84\xd6\xb0\xdf\x17\x07\x65\xad\x65\x13\xd7\x30\x64\x25\x58\xe2\x27\x70\xa3\x18\x87\x6d\x08\x7d\x30\xe1\x58\x5a\x92\xd2\x3d\x9c\xf9\x32\x68\xc4\x6c\x7b\x55\xa0\x61\xba\x62\x54\xb1\x37\x31\x6f\xb6\x90\x15\x7f\x11\x48\x82\x46\x13\x75\xde\x82\x9b\xa8\xb3\xe2\x94\xc3\x97\xdc\xc4\xd4\xa8\x63\x64\x3a\x75\xc8\x5b\x76\x9b\x22\xab\x8a\x99\x1f\xb2\x0c\x0b\x73\x9c\xa4\x64\x78\x4c\x54\x82\xa0\x63\x00\x68\xd9\xc1\xf4\x25\xe6\x31\x51\x5a\xd3\xe0\xa5\x95\x69\x1b\xc3\x49\x42\x4d\x1d\x66\x08\xd1\x6d\x6f\xba\xeb\xd9\xcf\xa2\xd3\xc8\x30\x38\x55\x71\xe0\xf0\xd9\x72\x24\x84\x39\x4a\x25\x06\x5b\x8d\xd3\xc8\xd4\x31\x85\xa2\x77\x7f\x58\xc8\x04\x39\xe7\xcd\x01\x4f\x63\x63\x4d\x50\xd3\x8f\x64\xb3\x1d\x61\x30\x93\x73\x91\x9c\xc8\x4f\xe2\x4d\x24\x8a\x99\xc7\xba\x81\x60\x1e\xd1\x86\x03\xe5\x95\xc9\x59\x63\xd9\x98\xcc\x9a\xb8\xe1\xd0\x60\x08\x87\x42\xe7\x3b\xd3\x9a\x2c\x20\xb3\x21\xcc\xbe\x06\x5a\xf1\xe3\x6e\xc2\x38\xd7\xd3\x28\x78\x4d\x21\x73\xc6\xb0\x30\xe4\x9b\xa8\xe6\xdd\x50\xd2\xf1\xf0\xae\x0c\xf5\x44\xd5\xd1\x0d\x14\xa1\xb8\x5b\x68\x94\xe9\x58\xb6\x65\x54\x21\x6f\x39\xb8\x46\x91\x83\x75\xf6\xe0\x1c\x8f\xbd\x58\x0d\xc2\x3d\xf3\x9b\xd6\x7c\x7e\xd6\xf7\x4b\x2d\xec\x80\xbc\x4c\x8d\x2a\x82\x17\x8a\xce\xea\x8b\xb1\xa7\x3b\x01\x17\x72\xf3\x70\xa6\xc0\x2b\x94\x5e\x1c\x21\x12\x65\xbb\x7e\x1a\x59\xd8\x7b\x3a\x6c\xc7\x8f\x62\x92\x0c\x01\xc5\x5d\x87\x64\x11\x61\xc5\x48\x42\xb2\x2d\xc3\xa9\x63\xd3\xf8\x0f\xd0\x3a\x5b\x25\x45\xbc\x08\x41\x0d\x1b\x1c\x7a\x80\x68\x44\xe8\x15\xa4\x18\xde\xd5\x42\x0e\x70\x7d\x99\x5f\x49\x29\xe4\x42\xc7\x41\x05\x73\x8b\xeb\xcb\x91\x78\xc3\x3e\xc4\x6b\x76\x76\x26\xd9\x6b\x50\x31\x86\xd7\xe9\x7c\x2e\x5f\x96\x15\xd0\x4e\xc9\xf3\xf9\xb2\xac\x95\xb3\x99\xd9\x85\x7c\x41\xce\x43\x21\x5b\xca\x6a\x85\xec\x74\x56\x99\xcf\x43\x56\x03\xed\x94\xaa\xe4\x64\xa5\x2c\x97\x55\x28\xa8\x25\x45\x2e\xab\x4a\x19\x66\xb2\x4a\x56\x51\x35\x2d\x9b\x5b\x84\xb2\x5c\xd2\x64\x4d\xe6\x27\xd7\x63\xb9\x0f\x1b\x75\x28\x0b\x6b\x6f\x81\x50\xb3\x73\xcb\x21\xa6\x03\x33\xd8\xc4\xe6\xc3\xb7\x6c\xbb\x73\xa7\xf6\xf0\x2b\x28\x77\x6e\x51\x1b\xd9\x9d\x5b\x35\x16\x8f\x7a\x7b\x48\x3c\x32\x75\xe4\x38\xc8\x22\xa4\x86\x20\x6b\xe9\xc4\xae\x92\xd6\x2a\xc8\x5a\x06\x14\x25\xc3\x35\x2c\x11\x17\xbd\x4a\x86\xf0\x68\x76\xa8\x64\x98\x80\xa1\xf7\xe8\x54\x11\x5a\x92\x29\x09\x4b\x3b\x53\xc5\xd0\x33\xab\x62\x8e\x59\x49\x1a\xdf\xc8\x6a\x78\xc3\x8d\x3f\x76\x56\xd1\x71\x58\xf0\x57\xd2\xbc\xf1\xc3\xb6\x49\xd5\x88\x2f\xc8\xc5\xf0\xa1\x88\x01\x56\x4c\xd2\x80\xfa\x34\x3d\x45\x1d\x43\x89\xac\x22\x93\x51\x6b\x55\xe2\x98\xc8\xd2\x61\x61\x89\xef\x2b\x06\xe2\x98\xc6\x25\x41\x11\x3f\x2b\x1a\x3a\x9b\x9d\x70\x3f\x72\xaf\xbb\x5b\xee\xa6\x7b\xd5\xfd\xc0\xbd\xe5\x5e\x77\xaf\xba\xd7\xc0\xfd\x8b\x7b\xd3\x7d\xd7\xdd\x74\xb7\xdc\x0f\xdc\x4f\xbd\x82\xcb\xee\x4d\xf7\x06\xb8\x37\xbd\x7f\xfc\x6b\xed\x48\xf6\x13\xec\x9d\x37\x72\xc4\x34\x73\x65\x81\x6e\x3a\xec\xbe\x55\x55\x15\x16\xab\x75\x5c\xb1\xc8\x64\x83\x58\x76\xdb\x74\x90\x63\x1b\xc4\xb2\x56\x57\xa1\x6c\x7b\xaf\x05\x21\x99\xaa\xaa\x3c\x4f\xf0\xb3\x37\xb0\x1e\xee\x22\x51\x4b\x05\xd7\x0c\x4b\x98\x07\x5b\x16\x21\x8e\xf7\xe6\xda\x02\xd5\xd2\x31\x85\x19\x83\x36\x11\xc8\x7a\xd3\xb0\x0c\xdb\xf1\x96\x70\x71\x03\x59\x0e\x21\x14\x4a\x12\xcc\x21\xcb\xc6\x6c\x95\x79\x90\xb9\xf0\x7e\xc2\x63\x88\x8f\x59\xee\x61\x7a\x6d\x39\x94\x2b\xf1\x76\x88\x37\x25\x43\x9a\x4d\x64\xe9\x86\x83\x0c\x8a\x45\xd6\x0c\x31\x49\x9b\xb6\xbc\x25\x37\x6e\xe2\x39\x00\x1d\x9b\x8b\x72\xa8\x15\x11\xd5\x53\x2f\x3a\x4f\x25\x75\x1a\x0f\x0e\xfc\xa2\xe1\xd4\xf0\x0a\xa6\xc6\x1f\xe1\x77\xbf\xff\x5d\x28\x77\x88\x05\xaf\x77\x5a\x14\x99\x1c\xee\x3e\x86\x54\x9a\xc5\x80\x22\xaa\x1b\xc4\x24\x35\x6c\xc1\x2c\xb6\x6a\xd8\x24\x49\xb7\xc4\xa0\xdb\x80\x0b\x5e\xa2\xa3\x54\x82\x26\xbc\x27\xe9\xaf\x9f\x16\x48\xb3\xe5\xcd\x48\xbd\x27\x60\x15\xfd\xf5\xc1\x44\x9a\xa7\x67\x22\xd9\x2e\xd5\x23\x33\x91\x5b\x21\x6b\xba\xd1\x40\xc7\xa1\x46\x6c\xc7\xb0\x0c\xc7\x46\x9e\x7b\x78\xee\xb9\x6c\x83\x4c\x96\x29\x5e\xd5\x9f\x7b\x4e\xe8\x6b\x92\x4c\xd2\x09\xed\xf3\x99\xa8\x51\x9c\x44\x4f\x21\x5a\xc5\x26\xb1\xbc\x99\xbb\x83\xa9\x81\x4c\x6c\x83\x06\x0a\x9c\x80\x8c\xac\xc9\x93\x99\x6c\x49\x9e\x17\x46\x8f\x7e\x3c\x9b\x5e\x41\xd2\xc2\x74\x4f\x5d\xcc\x5f\xe2\x7b\xb7\x28\x4d\xa6\x96\xb1\x06\xb2\x8d\x6d\x42\x7b\xa2\x8a\xfc\x72\x8e\x50\xcd\x69\x80\x62\x5c\x45\x31\xe5\x02\xf9\x2c\x71\xb0\x59\xc1\xba\x0d\x5a\x0b\x19\x96\x6f\xbb\xc8\x8f\xd9\x31\x00\x23\x0d\x71\x02\xeb\xe2\xb8\xac\xca\x62\x0a\x4a\x65\x71\x5c\xbe\x0c\xb1\xf4\x76\xe7\x6e\x13\x14\x90\x8d\x5a\x1b\xf9\x31\xe3\x29\xe3\x00\x3e\xb6\x63\xb3\x2e\x19\xde\x32\x16\x86\x0c\xf2\x5e\xd9\x89\xef\xec\x85\x8c\x3a\x9f\x2d\x65\xf2\xb2\x02\x4b\xf9\x8c\x3c\xff\x22\xbf\x70\x22\xe2\x53\xdd\x63\x49\xfe\x52\xdc\x68\x9e\x94\x05\xaf\x7b\x69\x61\xc7\xe6\x57\xb3\x63\xe5\x89\x73\x1d\x8b\x58\x98\xef\xae\xa9\x6f\xa6\x60\x6f\xa9\xb5\x8e\x9a\x30\x09\x53\x18\xb5\x9b\xc4\x72\x40\x73\x28\xf6\x76\x11\x28\xaa\x3a\x46\x95\x5f\x9a\x1c\xcd\x8a\xd3\x61\x37\x8c\xd6\x1f\xda\x96\x89\x6d\xfb\x0f\x41\xf1\xcb\xc3\xd4\x69\x18\x51\x1b\x4a\x04\x57\xda\xd5\x06\x20\x7f\x5f\x20\x5c\x41\x61\xad\xe6\x31\x61\xd8\x45\x68\xc2\xfa\x4b\x8a\x79\xa0\x48\x2e\xfb\x1e\xa5\xc3\x88\x23\xd8\x78\xa4\x13\x22\xab\xb7\xb6\x2f\x4d\x1c\x46\x3a\xc1\x60\x47\x42\x9a\x8a\xf2\xd9\x08\x67\x51\xa3\xbd\x4a\xec\x3a\x02\x08\x2d\x01\xfc\x97\x68\x9e\x99\xa1\xe2\xeb\x7c\x90\xb0\x2c\x32\xea\x52\x1f\xa7\xa1\x67\x7c\x3c\xb5\x80\xe3\x47\xcd\x2f\x1d\x4f\x41\x1d\x80\xc6\x5f\x0c\xfa\xff\x19\x62\x59\xb8\xea\xc0\x49\x49\x09\xfa\xa1\x90\x2c\xac\x09\xb9\x18\x80\x31\xb1\x92\x91\x78\x14\x65\x18\x89\xa2\x8c\xcb\x90\x59\xd2\xa0\x58\x47\xb4\x89\xaa\x7c\x57\x10\x2b\xf6\x59\xfe\xc4\x73\xfc\x29\x65\xaf\xe8\xaf\x44\xd9\x0e\x72\x70\x30\x9e\xd9\xf0\x3c\x94\xbc\x81\xdb\xe9\x5b\x39\x1b\x02\x7d\xe6\xc5\xb3\x61\x32\xfe\x79\x2a\xb2\x92\x26\x65\x25\x98\x25\x76\xcb\x70\x90\x09\x99\x76\xb3\x82\xcc\x68\x14\xeb\xaf\xe8\xed\x0a\x78\x66\x3c\x25\x2b\x49\x1c\xe3\xe2\x73\x51\xe6\x56\x43\x16\x1b\x5d\x75\x0c\x32\x45\xed\x2a\x8a\x9c\x45\x13\x8f\x61\xf8\x71\x55\xc5\x86\x04\x52\x35\x3a\x77\x9c\xce\x1d\xc8\x75\xee\x58\x9d\x3b\x14\x99\x18\x34\x3e\x9d\x23\x09\xc1\xe6\x02\x62\x4e\x87\x9c\x6e\x21\x2f\x99\x33\x48\x07\x49\xc5\x1a\x40\x47\xe6\x2d\x16\xa7\x28\x71\xea\x98\xda\x60\xb7\x24\x58\x03\x22\x09\xc3\x5f\x54\x1f\x32\x09\xb0\x88\x8f\x2f\x0d\x78\x5b\xe6\x08\xbc\x52\x3a\x5e\xe9\x48\x79\xd3\x35\xf7\xc8\x5b\xbb\x96\xa6\xad\x6b\x09\x9c\x6b\xe9\x19\xff\xc5\x5e\xb5\x1d\xdc\x84\x22\x31\xed\x06\x02\x2d\x0c\x1e\x47\x2b\x42\xa2\x0c\xa8\x08\x39\x76\x7b\xe7\x50\x4d\x3f\x6d\x50\x27\xc8\x27\xb0\x27\x8f\xc3\x4c\xe7\x3b\xaa\x63\xda\xb6\x6a\xf6\xa4\xbf\xc5\x38\x85\x1d\x6a\xe0\x8a\x5d\xc3\xb6\xd4\x94\x2a\xd2\x2c\x1f\x91\xd1\xec\x43\xe9\xcc\xcd\x38\x29\x0b\x0b\x05\x35\x1b\x6e\x88\x40\x2e\x49\x94\x00\x61\x9b\x47\x3c\x72\x1c\x5e\xb3\x55\x47\xfe\x2e\x15\xb6\x26\x7b\xbe\x27\x73\x7c\xca\x46\xbf\x8e\x04\x13\x4e\x4e\x82\xe5\x38\xba\x9a\xc8\x82\x32\xae\xd6\x2d\x6f\x6d\xc3\xf0\xf7\x6b\xa5\xd8\xc2\x74\x1f\x86\xdf\x4a\x19\x77\x51\x5a\x36\x27\x4f\x22\x1b\x39\x08\x34\x5c\x6d\x53\xc3\x09\xb8\xe3\xd4\x09\xa8\x88\x7d\x7c\x72\x29\x27\x41\x11\xb5\xea\x86\x63\xd8\xbd\xd7\x8b\x38\x33\x0f\x11\x5f\x44\x8e\x88\xf6\xf9\x94\xa4\x13\x21\x70\x62\x3c\xd2\xe9\x25\x79\x41\x53\x67\xca\xf0\x82\x37\x25\xcb\xf0\xaf\xb9\xac\x8e\xed\xce\x31\xc8\xb3\x11\x29\x87\xd1\x28\x47\x40\x22\x81\x22\x41\x46\x82\xa1\x54\x13\x1c\xee\x59\x03\xc8\x3c\x0d\x23\x0c\x41\xe3\x71\x55\xf4\xb6\x69\x22\x78\x1e\x66\x51\x13\xe9\x20\x9b\x90\xab\xb7\x29\x32\xbc\xdc\xd9\x15\x6c\x3b\x4d\x6c\x39\xf0\x82\x02\x0a\x88\x11\x4e\x63\x17\x45\x3f\x34\x1f\x5d\xa0\xa2\x64\xbc\x5d\xf6\x55\x28\x20\x2e\xf1\x81\x2b\x09\x62\x90\xfa\x01\x89\xcf\x13\xe5\x7f\x97\x21\x47\x49\xbb\xe5\xa5\x8a\x07\x59\xd9\x36\x4c\x61\xb3\x66\xb4\x9b\xa0\xc9\x27\x84\x3d\x9b\x61\xe0\x68\x9a\x76\x42\xdc\xb8\xf1\x66\x50\x27\xd2\xef\xde\xf8\xa9\x17\xa6\x61\x61\xc1\x30\x4a\x94\xea\xd5\xf2\xf3\xb3\x10\x34\x2e\x65\x06\xd9\x2d\xa3\xd7\xe5\x42\x66\x4e\x13\x36\x09\xb9\x3a\xd6\x11\x06\x90\xe8\x3d\x32\xf8\x9d\xe6\xcd\x6b\x4e\xcb\x80\x3c\x95\x0f\xa2\xc8\xed\x73\x70\x45\x23\x53\xc4\x2f\xa9\xbf\x9f\x36\x85\xcd\xa5\xec\x14\xa8\x62\x6b\xb8\x2a\xb6\xc6\x1c\x63\x52\x53\x37\xa6\x20\x97\xe4\x19\xb9\x94\x5f\x80\x29\x79\xa1\x00\x93\x50\x14\xc9\xc4\x7a\x96\x24\x1b\xe3\x2b\xa6\xe6\x93\x2b\x6d\x98\xae\xa3\x8a\x01\x79\xdb\x44\x4d\xa3\x1a\x34\xa7\x28\xcd\x49\x9a\x24\xf4\xea\x89\x40\x26\x80\xe1\xc7\xd1\x30\x37\x2f\xf7\xda\x7b\xa2\x18\xa7\x65\x75\x11\xd5\xf8\x44\xac\x0d\xd3\xde\xfe\x97\x51\x09\x52\xb7\xc2\x85\x92\xa2\x96\xdc\xe0\x24\x30\x53\x33\x76\xe0\xbd\x0d\x38\xc8\x5b\x7a\xdb\x73\x8e\xcc\x5e\x1f\xe0\xcd\x46\x62\x97\x3c\x19\xc9\x4f\x5f\xc6\xbf\xfc\x1e\x32\x88\xfc\x92\xaa\x95\xd5\x42\xf4\xf4\x88\x85\x87\xdf\x65\x87\x6c\xe3\x68\x53\xb2\xf7\xf6\x8f\x11\xad\xd6\xa1\xd6\x9b\xda\xb1\x77\x45\xae\x32\x24\xab\xc5\x33\x9d\x6a\x23\x64\x3a\x53\xd4\xb6\xed\xc9\x79\xb4\xbc\x8c\x0d\x38\x89\x6b\x4d\x64\x59\x41\xde\x55\x90\xc8\x2d\x41\x41\x48\x75\x4e\xc2\x0b\x99\xce\x82\x59\x94\x5e\x91\x32\xc1\x59\x33\xb0\x6d\x1f\x87\x15\x09\x14\xd2\xf9\x34\xc8\xd1\x61\x79\xd2\x9e\x53\x7e\x14\xec\x03\xb3\x11\xaf\xcf\xe4\x88\x85\x8c\xae\x63\x24\x19\xc1\x52\xbb\xb7\xbc\xce\xe5\x62\x69\x4b\x30\xdd\x36\xec\x4a\x9b\xd6\x40\x68\x1f\xe2\x97\x30\x78\x58\x5f\x06\x3a\x43\xf7\xe7\xa0\xb3\xaa\x14\xb1\x19\x20\x65\x24\x25\xcf\x20\x24\x29\x3a\xe5\xac\x92\x9d\x51\x17\xf2\x19\x19\xa6\xb3\x90\xd5\x8a\xf2\x82\xec\x8f\xc3\xc2\x8e\x5a\x12\x8a\x1f\xc9\x17\xfb\x86\xf1\xb4\x1b\x4e\xc9\xfc\xf2\x62\x5a\x72\x79\x71\x5c\x66\xf7\x3d\x77\xd3\xbd\x09\xee\x75\xf7\x4b\xf7\x27\xf7\xde\xdf\xd6\xdd\x2f\xdd\x07\xde\xcf\x1f\xdd\x6d\x70\xdf\x72\xbf\x75\x1f\x44\x5d\xd5\x21\x28\x5f\x4b\xe0\x90\x97\xc3\x4a\x52\xf5\x5f\xc3\x38\x22\x57\x61\x54\x7e\x0b\x45\x62\x80\x7e\xff\xbb\xdf\x8b\x89\x1c\x36\x60\xcb\x9b\x85\x20\xdb\x31\xac\x1a\xd2\x57\x0c\xbc\x86\x2d\x58\x52\xf9\xcc\xb8\x94\x56\x2c\xb9\x40\x15\xb2\xe7\x96\x24\x55\x9a\x49\x39\xfd\xdb\xbb\x77\x61\xef\xfe\xc7\xfb\xaf\x9f\xd9\xbf\x70\x66\xff\xc6\xdd\xfd\x1b\xaf\x3f\xb9\xb6\xf9\xcb\x37\x1f\xed\x3d\x78\xd0\x3d\xf7\x59\xf7\xcd\x7b\x9c\xaa\x18\x36\x64\x4f\xb4\x61\x62\x12\x6b\x03\x61\x6b\xf5\x21\xc2\xba\x5f\xbc\xb2\xff\xf5\x87\x8f\xdf\x7e\xed\xf1\x1b\x6f\x75\x3f\xbe\xbd\x7f\xe5\xcb\xfd\x4b\x5f\x04\xce\xfa\x84\x25\x62\x45\x79\x83\x84\x8d\x22\x69\xef\xde\x6b\xdd\x4b\x6f\x74\x2f\xbe\xdb\xfd\xfc\xbf\x9e\xbc\xff\x6a\xf7\xfd\x0f\x7f\x59\xbf\xb5\xf7\xe0\x87\x01\xaa\xfa\xe1\xa1\xa4\x44\x33\x26\x2c\xb1\x36\x95\xbc\x0b\xfb\xdf\xfe\xcf\x93\x4f\xaf\xee\xdf\x3d\xf7\xcb\xb7\x67\xf7\xee\xdf\x7e\xfc\xc5\xad\xc7\x9b\xaf\x76\x37\xcf\xef\x6d\x5f\xdb\xdb\x7e\x65\xef\xde\xf5\x9e\x57\xbf\x44\xbc\xb2\x89\xa6\xa1\xe0\x21\x2e\x98\xec\x21\x98\x23\x12\xff\x8f\xed\xf3\xbc\xe3\x7f\x6c\xbf\xfe\x7f\xaa\x09\xdd\xf7\x7f\xd8\xbf\x76\x7f\xff\xda\xfd\xee\xfd\x77\xf6\xcf\x6f\x3e\xd9\xf8\x61\xff\xdd\xd7\xba\x17\xdf\xdd\xdb\xde\xea\x7e\xf2\xf6\xe3\xad\xcb\x8f\xef\x7c\xf7\xe4\xda\x87\x43\x5b\x91\xd2\x47\xec\xde\xee\x6b\xc8\xb8\xf1\x7f\xbc\xf1\x97\x5f\x2e\xbd\x1e\xdc\xac\xdd\x8b\xef\xed\xdd\xff\xac\x7b\xc9\x2b\xec\xbe\xb1\xde\x17\xea\x41\x58\x16\xf0\x37\xd6\x79\x49\xc1\xcf\x54\x4a\x3e\xef\xbe\xff\x43\xf7\xd2\x97\x4f\xde\xbe\xf9\xe4\xcc\x56\xf7\xde\x2b\xfb\x67\x92\xfa\xa2\x38\x8a\xc5\xe4\x4c\x52\x2f\x74\xe6\xc1\x68\x8f\x92\xef\xfd\xe3\x4f\xba\xdf\x7f\xbd\xff\xcd\x9f\xf7\xee\xdf\xef\xde\xfd\x69\xef\xa7\x0f\x9e\x5c\xbb\xd3\xff\x74\x06\xe1\xe8\x9e\x7f\x75\x90\xc8\x51\xdc\xb0\xe8\x9d\x7f\xb5\xbf\x15\x7c\x61\x8a\x56\xe4\xe6\xd5\xdd\x8d\xeb\xbb\x1b\x6f\xed\x6e\xdc\xd8\xdf\xfa\xa4\xbb\xfd\xe6\xde\xf6\xf5\xc7\x1f\xff\xc8\x1f\x21\xe6\x20\x2c\x80\x22\x34\x8a\xa1\x58\x1e\x08\xf8\x23\x1a\xba\x62\xdf\xf2\x56\x0f\xa8\x7f\x18\x6f\x80\x02\x01\x73\xf4\x12\x76\x5f\x59\xdf\x7d\xe5\xc2\xee\xfa\xfa\xee\xfa\x85\xee\xeb\xdf\x3e\xbe\xfc\x17\xbf\xb1\xdb\xbb\x1b\xe7\x77\x37\x2e\x78\x7f\xac\xdf\x1f\xa0\x2c\x8d\xe9\x6f\x20\x78\xe3\x1d\x9f\xe3\xc1\xee\xc6\xe5\xf0\x8f\x4b\xbb\x1b\x1b\xbb\x1b\xbd\x6e\xa3\x5f\xe7\x60\x0b\xb1\x9f\x48\x90\x27\x96\xa7\x92\xf7\x66\x18\x82\x2b\xbb\x1b\x9f\xf7\xf8\xd6\xef\xed\x6e\x7c\xe4\x47\xe7\xeb\xee\xe6\xf9\xee\xe6\xc5\x04\x91\x87\xd8\x71\xfd\x34\x6f\xcf\xf7\xcd\x7c\x79\x0a\xa9\xbf\xbe\x7a\xe9\xe9\xd5\xb7\xfe\xfe\xdf\x3f\x1c\x6c\xdd\x38\xb8\x7d\xe6\xe0\xa3\x6d\x38\xf8\x68\xfb\xe0\xcf\x1f\x3c\xbd\x7e\xf1\x60\xfd\x33\x4e\x5c\x3f\x32\x54\x13\x33\x60\x6a\x62\xe5\x81\x9a\x06\x19\xa2\xe6\xe0\xbd\xcb\x07\xef\x6d\x1e\x7c\xf0\xcd\xc1\xf7\x57\x03\xbe\x83\xfb\x9b\x4f\x2f\x6f\x3f\xdd\x38\x3f\x40\xd5\x10\x8b\xa3\x92\x07\xa0\x93\xea\x4b\xe0\x50\x64\x98\x86\x55\x83\xb9\xb6\x6d\x34\x60\x96\x60\x1b\x1d\x87\x2a\xb1\x1c\xc3\x6a\x93\xb6\xcd\x35\xe3\xe9\x95\x1b\x7f\xff\xf6\xf3\x5f\x6f\xff\xe7\xaf\x6f\x9e\xfd\x75\x7d\xeb\xd7\x77\x6e\x1c\xdc\xd8\x7a\x7a\xe5\x46\x9f\xfa\x7e\x20\x13\x2d\x1a\x44\xa2\xc5\xf2\xd4\xa2\x5f\x6e\xd7\x91\x35\x54\x34\xef\xb9\x27\xec\xe9\xe5\xed\x83\x2b\x1f\x1f\xdc\x3e\xf3\xf4\xe2\xe6\xaf\xb7\x3f\x8e\x5e\x52\x06\x55\x3f\xb3\xf4\xe0\x4d\xa5\x27\xde\xc4\x48\xef\xd3\x6e\x11\x6b\x32\xd2\x0f\x2f\xe8\x04\x4e\x63\xb0\x30\xd6\x19\x9e\xab\xb6\xbd\x8f\x3f\x50\xe7\x5f\x5f\xe4\x1a\xba\xf3\xcd\xce\x57\x8f\x36\x76\xee\xc0\xce\xd7\x8f\xce\x3d\xba\xb0\xf3\x15\x3c\x3a\xb7\xf3\xf9\xa3\x73\x3b\x77\x61\xe7\xb6\x57\xb6\x73\x7b\xe7\xfb\x47\x17\x1e\x9d\x85\x9d\xef\x1f\x9d\xdf\xb9\xfb\xe8\x35\x78\x74\xf6\xd1\xd9\x9d\x4f\x76\x3e\xdb\xb9\xbd\xf3\xd5\xa3\xd7\xa2\x38\x3c\x8b\x0b\x3f\x56\xa1\x16\x3e\x4e\x5c\x59\x10\x23\x44\x0f\x8f\x51\x05\xd9\x46\x95\x2d\xfe\xe9\xd8\x36\x6a\x16\x72\x08\xe5\x97\x96\x57\xab\x26\xf6\x37\xcc\x34\x8c\x40\xd6\x57\xb0\xe5\xb4\x29\xb6\x41\x8f\xa7\x00\x0c\x42\x86\xaa\xf5\xbe\x64\x00\x56\x12\x28\xb6\x87\x2e\xa6\x0f\xd6\x01\x44\x82\x11\x95\x30\x93\x23\xd6\x42\xc8\x28\x32\x08\x19\x57\x81\x7f\x84\xe4\x43\xf7\x8a\x7f\x9c\xe4\x1d\x77\xcb\xbd\xea\xbd\x5a\x7f\xe2\xbe\xef\xbe\xe3\x1f\x25\xb9\xea\xde\x05\x77\xd3\xbd\xcc\xbf\x90\x1f\x6e\xc2\xde\xcb\x03\xcb\xe8\xad\x3c\xf8\x1d\xe8\xaa\xd4\x86\x1d\x23\x52\x4b\xde\x3a\x88\x3c\xcd\x6f\x0e\xf8\x65\xa1\x6f\x79\x9a\xf7\x2c\x4f\xa7\xf3\x6b\x68\xe5\xbc\x92\x85\xac\xaa\xf2\x9e\x83\xd2\xd0\x73\xaf\x92\xf9\xee\xfd\x4e\xe1\xdd\xdd\x74\x7f\x72\x7f\x74\x1f\xb8\x3f\xfe\x6d\xc3\xfd\xd2\xfd\xde\xfd\x01\xdc\x2b\xfe\x19\x1b\x21\x80\x7d\x28\x16\xaf\x08\x1c\xc5\x2c\x2a\x4b\xa3\xe0\xa6\xfb\x91\x7b\xc5\xbd\xe6\xde\x72\x3f\x71\xb7\xdc\xb7\xdd\xab\xee\x7b\xd0\xaf\x20\x01\xc5\x34\xf4\x2b\x18\x81\xff\x65\xd4\xac\x10\x13\x0a\xc4\xff\x3e\x91\x18\x65\xa1\x2e\x3a\x42\x30\x2d\x1e\x21\x48\x19\x6a\xff\x2b\x12\x19\x8a\x75\xc3\x81\xac\x70\x9b\x70\x35\xec\x8a\x8a\x37\x4b\x36\xed\xdd\x32\x83\xa8\x89\x5b\x93\xc1\x8e\x29\x14\xa9\xb8\x99\x20\xd4\xb2\x85\x7d\x1a\x5f\xd9\xa7\xe9\x77\xdf\x4a\xaa\x5c\xce\xcb\xa0\x6a\xf9\xdc\x62\x49\x5e\x98\xcb\x82\x2e\x09\xa7\x88\xfb\x11\x51\x57\xa4\xc7\xba\x21\x3d\x6d\x27\x94\xc0\xaa\xa7\xe3\xd4\xc7\x63\x9c\x36\x2c\xef\xee\xc8\x98\xed\x0a\x4c\xcd\xf6\x0f\x00\x62\xfd\xd1\x74\xfb\x71\x4e\xa1\x83\x1d\x40\x38\x7e\xb7\xfa\xf3\x45\x6c\x37\x3a\xb7\xa0\x45\x1e\xde\x72\xd0\x71\xb0\xa5\x16\xdf\x40\xb1\x3a\xe4\xeb\x81\x18\x61\xef\xf7\xc8\x74\x60\xb7\x0e\xe7\x6a\x8d\xc7\xe4\xef\x71\xf5\xce\x43\xe6\x30\xa1\x35\x83\x3b\x9e\xd5\x5f\xe1\x73\xc5\x1e\x88\x11\x76\x8a\xe7\x88\xe1\x1f\x39\x20\xd5\x06\x9b\x5c\xc8\xe5\x02\x64\x82\xa3\x83\xfe\xf7\x65\x62\x1b\xd5\xc9\xb5\x81\x8e\x7e\x6f\x82\xae\xc4\xea\x71\x74\x36\x31\xad\x1a\xe1\xc1\x51\x3f\x77\xb7\x45\x49\xb3\x12\xec\xf1\xf6\x84\xc6\x8b\x93\x14\xf2\x7e\x86\x28\x8d\xc1\xd2\x29\xd6\x32\x53\x50\xce\x2e\x4c\x67\x4b\x93\xbd\x1d\xe1\x9e\x30\xb1\x30\xbc\x80\x53\xb1\x2b\x38\x95\x96\xa8\xf7\x69\x3b\x50\x0c\x54\x31\x4c\xc3\x59\xed\xc5\xd6\xc0\x36\xc8\xe5\x52\x7e\x71\x9e\xbf\x78\xbd\xdf\xfc\x77\xf2\x92\x2c\xc7\xcc\x25\xc9\xa8\x6a\x31\x5b\x92\xcb\xf9\x25\x19\x16\xe4\x53\x72\x29\x5b\x9e\x05\xa5\x3c\xcd\x6f\x4a\x25\x61\x98\xa2\x00\xca\x7f\x4f\x0f\x71\x89\x91\xce\xd0\x53\x4e\x24\xc8\x48\x59\xf1\xce\x03\xb4\xd0\xb2\x01\x32\xc5\xde\x71\x27\xbf\x99\x48\xe7\x3f\x22\x33\x10\x2c\x84\x26\xb0\x11\xbf\x21\x18\x94\xa5\xd0\xe3\x7e\xe8\xde\x74\xdf\x86\xe7\xdc\x2b\xee\x75\xef\x98\xb0\x7b\xc5\xfd\xf0\x39\xfe\xf0\x9e\x50\xc1\x0e\xfc\x05\x66\xc2\xe4\x20\x2c\x09\x48\xdb\x8d\xa1\x57\xa0\xac\x2e\x81\x7c\x2a\xff\xb2\x0c\xb9\xd2\x62\x91\xbb\xf2\x7c\x99\xcf\x54\x56\x85\x24\x9d\xe0\x67\x2a\x8e\x82\x5a\x92\x15\x28\x97\xb2\x4b\x59\xa5\x67\xc7\x36\xa7\xb9\xaa\xd1\x89\x62\x49\x07\x65\x28\xd4\xfd\x2f\x9e\xa8\x15\x33\x6b\x61\x5a\x23\x51\x7b\x12\x6a\x82\x49\x6b\x59\x98\xb4\x96\xd3\xb6\x29\x43\x26\x67\x0c\xd3\x7f\x95\xe6\xbe\xdd\x11\xdd\x2f\x49\xb5\x41\xb6\x9b\x78\xde\x7b\x22\xed\x09\x89\xb2\x97\x2a\x92\x59\xf3\x12\x8e\x6c\x89\x4a\xe2\xf8\xcc\xd5\x46\x63\x57\x08\xe2\x06\x15\xca\x8f\x97\xd5\x61\x7b\x94\x72\x49\x9e\x2a\x67\x33\x30\xab\x2a\xd3\xf9\x85\x9c\x97\xab\x03\xd2\x1c\x48\xa0\x81\x04\x42\x02\x87\x08\x8c\x12\x59\x60\x4e\x02\x2d\xc0\x8e\x91\xbb\x51\xae\x63\x42\x71\x13\x05\x1f\x65\x72\x3a\x5b\x80\x80\x62\xbb\x45\x2c\x3b\xe8\x7c\x3a\x5b\x60\xfa\x0f\x98\x83\xc4\x6f\x04\xf8\x66\xc2\xe7\x9e\x0e\xb5\x16\xbf\x00\x15\xc0\x4b\x31\xb8\x12\xc1\x07\x7e\x14\x32\x71\x9b\xbb\xa7\x68\x32\x9d\xef\x14\x0d\x19\x62\xfd\x5b\x36\x64\xfe\x24\x28\xa4\x66\x78\x5f\x9a\x04\xbb\xd5\xf9\xfa\xe1\x7a\x03\x79\xa9\xe8\x35\x8a\x2c\xa3\xba\x46\xac\x9f\xcf\x01\xd1\x5b\xe4\xb4\x81\xf5\x35\x03\x99\x16\x79\xf8\x7e\xd5\xf8\xf9\x1c\x9f\x5f\x10\xb9\x88\xa6\x3d\x23\x79\x8a\xee\xe5\xd1\xec\x06\x66\xca\x0f\x6a\xa9\x9a\xcb\x6b\xe5\x7c\x06\xb4\x62\xe7\x9d\x87\xaf\x14\x64\x38\x05\x6a\xae\x24\x2f\xe4\x33\xa7\xd4\x85\x9f\xcf\x82\x3a\x5d\x54\x4f\xe6\xb3\xd3\xa7\xf2\xb2\xb2\xa0\x3e\xbc\x9e\xc9\xff\x7c\x36\xd6\xd2\x9e\x8b\xe8\x13\xa3\x23\x79\xfa\xa7\xb4\x34\x93\x5f\xca\x2b\x30\x65\xac\x39\xc4\xf2\x3e\x97\x62\x80\xb6\x46\xcc\x5a\xe7\x96\x89\x1c\x38\xd5\xb9\x45\x9d\x46\xe7\x3b\xfa\xf0\x2b\x6c\xc1\xfc\xc3\xaf\x1a\x9d\xef\xf4\x87\x6f\x41\xa9\x73\xc7\x5e\x5b\xe9\xdc\xb1\x56\x1d\xfe\x3b\x2b\xac\xef\x1b\xe2\x33\x8c\xc5\x88\xae\x59\x2c\x46\xb4\x1b\xf8\xe9\x97\xa4\x58\x4c\x68\x0e\xd2\x4d\x4c\x21\xab\x63\xd3\x76\x50\xdd\xe4\x32\x5c\x84\xf3\x99\x71\x1c\xeb\xda\x45\xfc\x18\xb9\xfe\xc9\x12\xfe\x5f\xec\x1c\xec\x20\x7e\x0e\x3b\x06\xb7\xea\x38\x98\x56\x50\xb5\xde\x3b\xd0\x00\x85\x5c\x14\x00\x8e\x3e\x8e\x0b\xd9\x63\xf0\x91\xd3\xb7\xb2\xda\x49\xd0\xc8\xb2\x73\x1a\x51\x0c\x27\x11\xd5\x91\xe7\x92\xcb\xc7\xe2\xf3\xb7\xfa\xc1\x9c\x8c\x98\xcd\xe8\x4a\xda\x94\x80\x62\xac\x60\xf1\xd8\x85\x96\xe7\xa7\xa5\xc9\x20\xf6\xb0\xe7\xc5\xb3\x67\xf9\x28\x3d\xca\x5c\x19\x9d\x5a\xf8\x8c\xed\x70\xe6\x81\x5f\xb4\x1d\xca\xab\xe5\xe5\xc1\x7e\x8f\xba\xc9\x7d\x19\xe5\xda\xb3\x72\xa7\x6d\x74\x1f\xb3\x22\x97\x4b\xea\x02\x28\x59\x6f\x06\x9a\x57\xbc\x34\x2e\x28\x89\x67\x75\xe3\x18\x2e\xfb\x2c\x84\x46\x8d\x96\x3a\x5b\x40\x25\x53\x4a\xf9\x75\xd2\xc8\x75\xe7\xf5\x1e\x7f\xe7\xcc\x10\x01\x01\x2a\x52\xd0\x39\xf3\x9b\x48\xd8\x92\x7a\x0e\xd2\x48\x60\xe0\x23\x94\x20\x7c\xa6\x6d\x30\x79\x49\x19\x95\xf6\xd8\xff\x0e\x00\x8e\xe0\x1e\x0c\x07\x5f\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
@@ -0,0 +1,43 @@
+package gocd
+
+import (
+	"encoding/gob"
+	"fmt"
+)
+
+func init() {
+	// Allow Results to be gob-encoded as interface values, e.g. by
+	// generic caches
+	gob.Register(Result{})
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler (and so gob
+// encoding), using the compact Protocol Buffers encoding of
+// MarshalProto. Entry is not encoded.
+func (r Result) MarshalBinary() ([]byte, error) {
+	return r.MarshalProto()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. Entry is nil
+// after decoding; use Parser.Lookup with the Designator to recover it.
+func (r *Result) UnmarshalBinary(data []byte) error {
+	return r.UnmarshalProto(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding p as a
+// single byte
+func (p PositionType) MarshalBinary() ([]byte, error) {
+	if p < None || p > EndGlued {
+		return nil, fmt.Errorf("invalid PositionType %d", int(p))
+	}
+	return []byte{byte(p)}, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (p *PositionType) UnmarshalBinary(data []byte) error {
+	if len(data) != 1 || PositionType(data[0]) > EndGlued {
+		return fmt.Errorf("invalid PositionType encoding %v", data)
+	}
+	*p = PositionType(data[0])
+	return nil
+}
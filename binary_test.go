@@ -0,0 +1,67 @@
+package gocd
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResultGob(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := p.Parse("Acme (UK) Ltd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := *res
+	expected.Entry = nil
+
+	var buf bytes.Buffer
+	err = gob.NewEncoder(&buf).Encode(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Result
+	err = gob.NewDecoder(&buf).Decode(&decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, expected, decoded, "gob round trip matches")
+
+	// As an interface value, via gob.Register
+	buf.Reset()
+	var in interface{} = *res
+	err = gob.NewEncoder(&buf).Encode(&in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out interface{}
+	err = gob.NewDecoder(&buf).Decode(&out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, expected, out, "gob interface round trip matches")
+}
+
+func TestPositionTypeBinary(t *testing.T) {
+	for pos := None; pos <= Whole; pos++ {
+		b, err := pos.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded PositionType
+		err = decoded.UnmarshalBinary(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, pos, decoded, "PositionType round trip matches")
+	}
+	_, err := PositionType(99).MarshalBinary()
+	assert.Error(t, err, "invalid PositionType errors")
+	var pos PositionType
+	assert.Error(t, pos.UnmarshalBinary([]byte{99}), "invalid encoding errors")
+}
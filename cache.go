@@ -0,0 +1,93 @@
+package gocd
+
+import (
+	"container/list"
+	"sync"
+)
+
+// WithCache enables memoization of Parse results for up to size
+// distinct inputs, evicting the least recently used result when full.
+// Each call returns a copy of the cached Result, so callers may modify
+// it freely.
+func WithCache(size int) Option {
+	return func(o *options) {
+		o.cacheSize = size
+	}
+}
+
+// cacheEntry is a cached Parse result
+type cacheEntry struct {
+	input string
+	res   Result
+	pass  PositionType
+}
+
+// resultCache is a size-bounded, concurrency-safe LRU cache of Parse
+// results keyed on input
+type resultCache struct {
+	sync.Mutex
+	size  int
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+func newResultCache(size int) *resultCache {
+	return &resultCache{
+		size:  size,
+		ll:    list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+// get returns a copy of the cached Result for input and the pass that
+// matched it, if present
+func (c *resultCache) get(input []byte) (*Result, PositionType, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	// The compiler optimises away the string conversion for map lookups
+	el, exists := c.items[string(input)]
+	if !exists {
+		return nil, None, false
+	}
+	c.ll.MoveToFront(el)
+	ce := el.Value.(*cacheEntry)
+	res := ce.res
+	return &res, ce.pass, true
+}
+
+// put adds a copy of res to the cache for input
+func (c *resultCache) put(input []byte, res *Result, pass PositionType) {
+	c.Lock()
+	defer c.Unlock()
+
+	if el, exists := c.items[string(input)]; exists {
+		c.ll.MoveToFront(el)
+		el.Value.(*cacheEntry).res = *res
+		el.Value.(*cacheEntry).pass = pass
+		return
+	}
+
+	ce := &cacheEntry{input: string(input), res: *res, pass: pass}
+	c.items[ce.input] = c.ll.PushFront(ce)
+	if c.ll.Len() > c.size {
+		el := c.ll.Back()
+		c.ll.Remove(el)
+		delete(c.items, el.Value.(*cacheEntry).input)
+	}
+}
+
+// len returns the number of cached results
+func (c *resultCache) len() int {
+	c.Lock()
+	defer c.Unlock()
+	return c.ll.Len()
+}
+
+// clear removes all cached results
+func (c *resultCache) clear() {
+	c.Lock()
+	defer c.Unlock()
+	c.ll.Init()
+	c.items = make(map[string]*list.Element)
+}
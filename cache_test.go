@@ -0,0 +1,61 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache(t *testing.T) {
+	p, err := New(WithCache(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, input := range []string{"Acme GmbH", "Acme GmbH", "Widgets Ltd", "Foo Bar"} {
+		res, err := p.Parse(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Modifying a returned Result must not affect the cached copy
+		res.ShortName = "modified"
+	}
+	assert.Equal(t, 2, p.cache.len(), "cache is bounded")
+
+	_, _, exists := p.cache.get([]byte("Acme GmbH"))
+	assert.False(t, exists, "least recently used input evicted")
+
+	res, err := p.Parse("Widgets Ltd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "Widgets", res.ShortName, "ShortName matches")
+	assert.Equal(t, "Ltd", res.Designator, "Designator matches")
+}
+
+func TestCacheMetrics(t *testing.T) {
+	m := NewMetrics()
+	p, err := New(WithCache(10), WithMetrics(m))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		_, err := p.Parse("Acme GmbH")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	assert.Equal(t, int64(3), m.Parses.Value(), "cached parses counted")
+	assert.Equal(t, int64(3), m.Matches.Value(), "cached matches counted")
+}
+
+func BenchmarkCache(b *testing.B) {
+	p, err := New(WithCache(1000))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.Parse("Acme Widgets Pty Ltd")
+	}
+}
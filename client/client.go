@@ -0,0 +1,115 @@
+/*
+client provides a Go client for the gocd HTTP API (see package server),
+implementing gocd.NameParser so applications can switch between
+embedded and remote parsing:
+
+	var parser gocd.NameParser = client.New("http://gocd.internal:8080", nil)
+	res, err := parser.Parse("Profound Networks LLC")
+
+Remote Results have no Entry, as dataset entries are not serialised.
+For the gRPC API, see gocdgrpc.Client in package
+github.com/ProfoundNetworks/gocd/grpc.
+*/
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ProfoundNetworks/gocd"
+)
+
+// Error is returned for non-200 responses from the server
+type Error struct {
+	StatusCode int    // The HTTP response status code
+	Message    string // The server error message
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("gocd server: %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// Client is a client for a gocd HTTP server, safe for concurrent use
+type Client struct {
+	baseURL string
+	hc      *http.Client
+}
+
+var _ gocd.NameParser = (*Client)(nil)
+
+// New returns a Client for the server at baseURL (e.g.
+// "http://localhost:8080"), using hc for requests, or
+// http.DefaultClient if hc is nil
+func New(baseURL string, hc *http.Client) *Client {
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	return &Client{baseURL: strings.TrimRight(baseURL, "/"), hc: hc}
+}
+
+// Parse parses input remotely, like gocd.Parser.Parse
+func (c *Client) Parse(input string) (*gocd.Result, error) {
+	return c.ParseLang(input, "")
+}
+
+// ParseLang parses input remotely with the language hint lang, like
+// gocd.Parser.ParseLang. An empty input is not sent, and gets an
+// unmatched Result, as locally.
+func (c *Client) ParseLang(input, lang string) (*gocd.Result, error) {
+	if input == "" {
+		return &gocd.Result{}, nil
+	}
+	q := url.Values{"name": {input}}
+	if lang != "" {
+		q.Set("lang", lang)
+	}
+	resp, err := c.hc.Get(c.baseURL + "/v1/parse?" + q.Encode())
+	if err != nil {
+		return nil, err
+	}
+	var res gocd.Result
+	if err := decode(resp, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ParseBatch parses names remotely in a single request, returning a
+// Result for each, in order. Batches are limited in size by the server
+// (see server.Options).
+func (c *Client) ParseBatch(names []string) ([]*gocd.Result, error) {
+	body, err := json.Marshal(names)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.hc.Post(c.baseURL+"/v1/parse", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	var results []*gocd.Result
+	if err := decode(resp, &results); err != nil {
+		return nil, err
+	}
+	if len(results) != len(names) {
+		return nil, fmt.Errorf("gocd server: %d results for %d names", len(results), len(names))
+	}
+	return results, nil
+}
+
+// decode decodes the JSON body of resp into v, returning an *Error for
+// non-200 responses, and closes the body
+func decode(resp *http.Response, v interface{}) error {
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		var e struct {
+			Error string `json:"error"`
+		}
+		json.NewDecoder(resp.Body).Decode(&e)
+		return &Error{StatusCode: resp.StatusCode, Message: e.Error}
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ProfoundNetworks/gocd"
+	"github.com/ProfoundNetworks/gocd/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient(t *testing.T) {
+	p, err := gocd.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := httptest.NewServer(server.New(func() *gocd.Parser { return p },
+		server.Options{MaxBatchSize: 3}))
+	defer ts.Close()
+	c := New(ts.URL+"/", nil)
+
+	names := []string{"Profound Networks LLC", "Acme & Sons", "OOO Romashka", "Acme GmbH & Co. KG"}
+	for _, parser := range []gocd.NameParser{p, c} {
+		for _, name := range names {
+			res, err := parser.Parse(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := p.Parse(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want.Entry = nil
+			res.Entry = nil
+			assert.Equal(t, want, res, "%T Result matches for %q", parser, name)
+		}
+	}
+
+	res, err := c.ParseLang("Romashka OOO", "ru")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "OOO", res.Designator, "ParseLang Designator matches")
+
+	results, err := c.ParseBatch(names[:3])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, results, 3, "ParseBatch results") {
+		assert.Equal(t, "Profound Networks", results[0].ShortName, "ParseBatch ShortName matches")
+		assert.Equal(t, "Romashka", results[2].ShortName, "ParseBatch ShortName matches")
+	}
+
+	_, err = c.ParseBatch(names)
+	var e *Error
+	if assert.True(t, errors.As(err, &e), "oversized batch returns *Error") {
+		assert.Equal(t, http.StatusRequestEntityTooLarge, e.StatusCode, "oversized batch status")
+		assert.Contains(t, e.Message, "batch exceeds", "oversized batch message")
+	}
+
+	// Empty names are unmatched, as locally
+	res, err = c.Parse("")
+	if assert.NoError(t, err, "empty name succeeds") {
+		assert.False(t, res.Matched, "empty name unmatched")
+	}
+}
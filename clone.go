@@ -0,0 +1,67 @@
+package gocd
+
+// Clone returns a new Parser derived from p with opts applied on top of
+// p's options. The loaded dataset is reused, and compiled patterns are
+// shared unless opts change them (via WithLayer, WithOverlay,
+// WithDatasetLayer, WithoutBeginPass, WithoutContinuousPass,
+// WithScriptShards, WithSuffixIndex, WithDesignatorTransforms,
+// WithTransliteration, or WithOnlyDesignators), so cloning is much
+// cheaper than New for e.g. strict and lenient variants of a Parser.
+// Layers and overlays added by opts are merged into p's dataset
+// according to their priorities. Script shards (see WithScriptShards)
+// use the clone's options. Caches (see WithCache and ParseLang) are not
+// shared.
+func (p *Parser) Clone(opts ...Option) (*Parser, error) {
+	c := *p
+	c.opts.overlays = append([][]byte(nil), p.opts.overlays...)
+	c.opts.layers = append([]Layer(nil), p.opts.layers...)
+	c.opts.datasetLayers = append([]datasetLayer(nil), p.opts.datasetLayers...)
+	c.opts.preprocessors = append([]Preprocessor(nil), p.opts.preprocessors...)
+	c.opts.postprocessors = append([]Postprocessor(nil), p.opts.postprocessors...)
+	for _, opt := range opts {
+		opt(&c.opts)
+	}
+
+	newLayers := c.opts.layers[len(p.opts.layers):]
+	newOverlays := c.opts.overlays[len(p.opts.overlays):]
+	newDatasetLayers := c.opts.datasetLayers[len(p.opts.datasetLayers):]
+	if len(newLayers) > 0 || len(newOverlays) > 0 || len(newDatasetLayers) > 0 ||
+		c.opts.noBegin != p.opts.noBegin || c.opts.noCont != p.opts.noCont ||
+		c.opts.suffixIndex != p.opts.suffixIndex ||
+		c.opts.scriptShards != p.opts.scriptShards ||
+		c.opts.translit != p.opts.translit ||
+		!sameTransforms(c.opts.desTransforms, p.opts.desTransforms) ||
+		!sameStrings(c.opts.onlyDes, p.opts.onlyDes) {
+		ds := make(dataset, len(*p.ds))
+		for long, e := range *p.ds {
+			ds[long] = e
+		}
+		err := ds.mergeLayers(newLayers)
+		if err != nil {
+			return nil, err
+		}
+		for _, overlay := range newOverlays {
+			err := ds.merge(overlay, SourceOverlay, OverlayPriority)
+			if err != nil {
+				return nil, err
+			}
+		}
+		err = ds.mergeDatasetLayers(newDatasetLayers)
+		if err != nil {
+			return nil, err
+		}
+		err = ds.validate()
+		if err != nil {
+			return nil, err
+		}
+		err = c.compile(&ds, ds.filter(func(e *Entry) bool { return !e.LangOnly }), nil)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		c.reshard()
+	}
+	c.init()
+
+	return &c, nil
+}
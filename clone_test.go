@@ -0,0 +1,107 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClone(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	strict, err := p.Clone(WithMinShortNameTokens(2, SuppressMatch))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Same(t, p.reEnd, strict.reEnd, "compiled patterns shared")
+
+	res, err := strict.Parse("Acme GmbH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, res.Matched, "clone options applied")
+	res, err = p.Parse("Acme GmbH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, res.Matched, "original options unchanged")
+
+	noBegin, err := p.Clone(WithoutBeginPass())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, noBegin.reBegin, "Begin pass not compiled")
+	assert.NotNil(t, p.reBegin, "original Begin pass intact")
+
+	overlay := []byte(`
+Widgetschaft:
+  abbr:
+    - WSch
+  lang: de
+`)
+	extended, err := p.Clone(WithOverlay(overlay))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err = extended.Parse("Acme WSch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "WSch", res.Designator, "clone overlay applied")
+	res, err = p.Parse("Acme WSch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, res.Matched, "original dataset unchanged")
+
+	_, err = p.Clone(WithOverlay([]byte("X: [")))
+	assert.ErrorIs(t, err, ErrDatasetParse, "invalid clone overlay errors")
+}
+
+func TestCloneScriptShards(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps, err := New(WithScriptShards())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Sharded clones apply option changes like unsharded clones
+	opts := []Option{WithoutInvisibleCleanup(), WithGluedDesignators()}
+	c, err := p.Clone(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs, err := ps.Clone(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, input := range []string{"AcmeLtd", "Acme Ltd", "Acme\u200b Ltd", "Acme Ltd\u200b",
+		"ООО Ромашка"} {
+		res, err := c.Parse(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resSharded, err := cs.Parse(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, res, resSharded, "sharded clone Parse matches clone Parse for %q", input)
+	}
+
+	res, err := cs.Parse("AcmeLtd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "Ltd", res.Designator, "sharded clone glued option applied")
+	res, err = ps.Parse("AcmeLtd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, res.Matched, "original sharded options unchanged")
+}
@@ -0,0 +1,78 @@
+package gocd
+
+// ClusterOptions configure Cluster
+type ClusterOptions struct {
+	// Threshold, if non-zero, also clusters names whose Similarity is at
+	// least Threshold. Fuzzy clustering compares every pair of distinct
+	// normalised names, so is quadratic in their number.
+	Threshold float64
+}
+
+// Cluster groups names whose normalised, designator-stripped forms are
+// identical (ignoring word order, as for Similarity), or with
+// opts.Threshold set, similar. It returns clusters of indexes into
+// names, each in ascending order, with clusters ordered by their first
+// index. Names without a normalised form (see MatchKey) are never
+// clustered with others.
+func (p *Parser) Cluster(names []string, opts ClusterOptions) [][]int {
+	// Group names by form
+	var forms [][]rune
+	formIndex := make(map[string]int)
+	nameForm := make([]int, len(names))
+	for i, name := range names {
+		form, ok := p.similarityForm(name)
+		if !ok {
+			nameForm[i] = -1
+			continue
+		}
+		f, exists := formIndex[string(form)]
+		if !exists {
+			f = len(forms)
+			formIndex[string(form)] = f
+			forms = append(forms, form)
+		}
+		nameForm[i] = f
+	}
+
+	// Merge similar forms using union-find
+	parent := make([]int, len(forms))
+	for f := range parent {
+		parent[f] = f
+	}
+	var find func(f int) int
+	find = func(f int) int {
+		if parent[f] != f {
+			parent[f] = find(parent[f])
+		}
+		return parent[f]
+	}
+	if opts.Threshold > 0 {
+		for f1 := range forms {
+			for f2 := f1 + 1; f2 < len(forms); f2++ {
+				if jaroWinkler(forms[f1], forms[f2]) >= opts.Threshold {
+					parent[find(f2)] = find(f1)
+				}
+			}
+		}
+	}
+
+	// Collect clusters in order of first index
+	var clusters [][]int
+	clusterIndex := make(map[int]int)
+	for i, f := range nameForm {
+		if f < 0 {
+			clusters = append(clusters, []int{i})
+			continue
+		}
+		root := find(f)
+		c, exists := clusterIndex[root]
+		if !exists {
+			c = len(clusters)
+			clusterIndex[root] = c
+			clusters = append(clusters, nil)
+		}
+		clusters[c] = append(clusters[c], i)
+	}
+
+	return clusters
+}
@@ -0,0 +1,30 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCluster(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := []string{
+		"Acme Widgets Ltd",
+		"Zenith Holdings GmbH",
+		"ACME WIDGETS LIMITED",
+		"!!!",
+		"Widgets Acme Pty Ltd",
+		"Acme Widget Inc",
+		"Zenith Holdings AG",
+	}
+
+	assert.Equal(t, [][]int{{0, 2, 4}, {1, 6}, {3}, {5}},
+		p.Cluster(names, ClusterOptions{}), "exact clusters match")
+	assert.Equal(t, [][]int{{0, 2, 4, 5}, {1, 6}, {3}},
+		p.Cluster(names, ClusterOptions{Threshold: 0.95}), "fuzzy clusters match")
+	assert.Nil(t, p.Cluster(nil, ClusterOptions{}), "no names, no clusters")
+}
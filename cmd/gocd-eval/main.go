@@ -0,0 +1,184 @@
+/*
+gocd-eval evaluates gocd against a corpus of company names, reporting
+the overall match rate and a per-language breakdown. If the corpus is
+labelled, precision and recall are reported as well.
+
+Usage:
+
+	gocd-eval [-labelled] [-json] [file]
+
+Input is read from file (or stdin), one name per line. With -labelled,
+each line is a tab-separated name and gold designator, with an empty
+(or missing) designator meaning the name has none.
+*/
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ProfoundNetworks/gocd"
+)
+
+// LangStats holds the counts for a single designator language
+type LangStats struct {
+	Matched int `json:"matched"`
+	Correct int `json:"correct"`
+}
+
+// Report holds evaluation results
+type Report struct {
+	Total     int                   `json:"total"`
+	Matched   int                   `json:"matched"`
+	MatchRate float64               `json:"match_rate"`
+	Langs     map[string]*LangStats `json:"langs"`
+	Labelled  bool                  `json:"labelled"`
+	TP        int                   `json:"tp"`
+	FP        int                   `json:"fp"`
+	FN        int                   `json:"fn"`
+	Precision float64               `json:"precision"`
+	Recall    float64               `json:"recall"`
+}
+
+func ratio(n, d int) float64 {
+	if d == 0 {
+		return 0
+	}
+	return float64(n) / float64(d)
+}
+
+// evaluate parses each name in r with p and returns a Report
+func evaluate(p *gocd.Parser, r io.Reader, labelled bool) (*Report, error) {
+	report := Report{Langs: make(map[string]*LangStats), Labelled: labelled}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), gocd.MaxLineLength)
+	for scanner.Scan() {
+		name := scanner.Text()
+		gold := ""
+		if labelled {
+			if i := strings.IndexByte(name, '\t'); i >= 0 {
+				name, gold = name[:i], strings.TrimSpace(name[i+1:])
+			}
+		}
+		if name == "" {
+			continue
+		}
+
+		res, err := p.Parse(name)
+		if err != nil {
+			return nil, err
+		}
+		report.Total++
+		correct := res.Designator == gold
+		if res.Matched {
+			report.Matched++
+			ls := report.Langs[res.Lang]
+			if ls == nil {
+				ls = &LangStats{}
+				report.Langs[res.Lang] = ls
+			}
+			ls.Matched++
+			if labelled && correct {
+				ls.Correct++
+			}
+		}
+
+		if labelled {
+			switch {
+			case res.Matched && correct:
+				report.TP++
+			case res.Matched:
+				report.FP++
+				if gold != "" {
+					report.FN++
+				}
+			case gold != "":
+				report.FN++
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	report.MatchRate = ratio(report.Matched, report.Total)
+	if labelled {
+		report.Precision = ratio(report.TP, report.TP+report.FP)
+		report.Recall = ratio(report.TP, report.TP+report.FN)
+	}
+
+	return &report, nil
+}
+
+// writeText writes a human-readable version of report to w
+func (report *Report) writeText(w io.Writer) {
+	fmt.Fprintf(w, "names:      %d\n", report.Total)
+	fmt.Fprintf(w, "matched:    %d\n", report.Matched)
+	fmt.Fprintf(w, "match rate: %.4f\n", report.MatchRate)
+	if report.Labelled {
+		fmt.Fprintf(w, "precision:  %.4f (tp %d, fp %d)\n", report.Precision, report.TP, report.FP)
+		fmt.Fprintf(w, "recall:     %.4f (tp %d, fn %d)\n", report.Recall, report.TP, report.FN)
+	}
+
+	langs := make([]string, 0, len(report.Langs))
+	for lang := range report.Langs {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	fmt.Fprintln(w, "\nlang  matched  share")
+	for _, lang := range langs {
+		ls := report.Langs[lang]
+		fmt.Fprintf(w, "%-4s  %7d  %.4f", lang, ls.Matched, ratio(ls.Matched, report.Matched))
+		if report.Labelled {
+			fmt.Fprintf(w, "  correct %d", ls.Correct)
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gocd-eval: ")
+
+	labelled := flag.Bool("labelled", false, "input lines are tab-separated name and gold designator")
+	jsonOut := flag.Bool("json", false, "write the report as JSON")
+	flag.Parse()
+
+	var r io.Reader = os.Stdin
+	if flag.NArg() > 0 {
+		fh, err := os.Open(flag.Arg(0))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer fh.Close()
+		r = fh
+	}
+
+	p, err := gocd.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	report, err := evaluate(p, r, *labelled)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *jsonOut {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	report.writeText(os.Stdout)
+}
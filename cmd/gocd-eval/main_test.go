@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ProfoundNetworks/gocd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluate(t *testing.T) {
+	p, err := gocd.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := strings.Join([]string{
+		"Profound Networks LLC\tLLC", // tp
+		"Acme GmbH\tGmbH",            // tp
+		"Acme Co\t",                  // fp (Co matched, no gold)
+		"Acme Sp. z o.o.\tSp. z o.o", // fp + fn (wrong designator)
+		"Acme Widgets\tWidgets",      // fn
+		"Acme Trading\t",             // tn
+		"",
+	}, "\n")
+
+	report, err := evaluate(p, strings.NewReader(input), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 6, report.Total, "total")
+	assert.Equal(t, 4, report.Matched, "matched")
+	assert.Equal(t, 2, report.TP, "tp")
+	assert.Equal(t, 2, report.FP, "fp")
+	assert.Equal(t, 2, report.FN, "fn")
+	assert.Equal(t, 0.5, report.Precision, "precision")
+	assert.Equal(t, 0.5, report.Recall, "recall")
+	assert.Equal(t, 2, report.Langs["en"].Matched, "en matched")
+	assert.Equal(t, 1, report.Langs["de"].Correct, "de correct")
+
+	var buf bytes.Buffer
+	report.writeText(&buf)
+	assert.Contains(t, buf.String(), "precision:  0.5000", "text report")
+
+	report, err = evaluate(p, strings.NewReader("Acme GmbH\nAcme\n"), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0.5, report.MatchRate, "unlabelled match rate")
+	assert.Equal(t, 0, report.TP, "unlabelled tp")
+
+	// Zero metrics are reported in JSON
+	report, err = evaluate(p, strings.NewReader("Acme Widgets\tWidgets\n"), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"tp", "fp", "fn", "precision", "recall"} {
+		assert.Contains(t, fields, key, "zero %s in JSON report", key)
+	}
+}
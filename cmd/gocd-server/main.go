@@ -0,0 +1,222 @@
+/*
+gocd-server serves the gocd HTTP API (see package server), with health,
+readiness, and Prometheus metrics endpoints, and the gocd gRPC API (see
+package gocdgrpc).
+
+Usage:
+
+	gocd-server [-addr host:port] [-grpc-addr host:port] [-dataset path] [-reload interval]
+	            [-max-batch n] [-max-in-flight n] [-rate r] [-burst n]
+	            [-timeout d] [-tls-cert file -tls-key file]
+
+Each flag defaults to the value of an environment variable:
+
+	-addr           GOCD_ADDR           HTTP listen address (default ":8080")
+	-grpc-addr      GOCD_GRPC_ADDR      gRPC listen address (default ":50051", empty to disable)
+	-dataset        GOCD_DATASET        dataset file (default the embedded dataset)
+	-reload         GOCD_RELOAD         dataset reload interval (default 0, never)
+	-max-batch      GOCD_MAX_BATCH      maximum names per batch request
+	-max-in-flight  GOCD_MAX_IN_FLIGHT  maximum concurrent API requests (default 0, unlimited)
+	-rate           GOCD_RATE           maximum API requests per second per client IP (default 0, unlimited)
+	-burst          GOCD_BURST          maximum burst of API requests per client IP
+	-timeout        GOCD_TIMEOUT        maximum batch or stream processing time (default 0, unlimited)
+	-tls-cert       GOCD_TLS_CERT       TLS certificate file
+	-tls-key        GOCD_TLS_KEY        TLS key file
+
+With -dataset and -reload, the dataset file is re-read every interval,
+and the parser swapped if it has changed and is valid. With -tls-cert
+and -tls-key, the server uses TLS and supports HTTP/2, which concurrent
+streaming parses (/v1/stream) require, and the gRPC API also uses TLS.
+The request limits (-max-in-flight, -rate, -burst, and -timeout) apply
+to the HTTP API only.
+*/
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/ProfoundNetworks/gocd"
+	"github.com/ProfoundNetworks/gocd/grpc"
+	"github.com/ProfoundNetworks/gocd/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// config holds the server configuration
+type config struct {
+	addr        string
+	grpcAddr    string
+	dataset     string
+	reload      time.Duration
+	maxBatch    int
+	maxInFlight int
+	rate        float64
+	burst       int
+	timeout     time.Duration
+	tlsCert     string
+	tlsKey      string
+}
+
+// envFlags maps flag names to the environment variables providing
+// their defaults
+var envFlags = map[string]string{
+	"addr":          "GOCD_ADDR",
+	"grpc-addr":     "GOCD_GRPC_ADDR",
+	"dataset":       gocd.DatasetEnv,
+	"reload":        "GOCD_RELOAD",
+	"max-batch":     "GOCD_MAX_BATCH",
+	"max-in-flight": "GOCD_MAX_IN_FLIGHT",
+	"rate":          "GOCD_RATE",
+	"burst":         "GOCD_BURST",
+	"timeout":       "GOCD_TIMEOUT",
+	"tls-cert":      "GOCD_TLS_CERT",
+	"tls-key":       "GOCD_TLS_KEY",
+}
+
+// parseConfig returns the configuration given by args, with defaults
+// from the environment
+func parseConfig(args []string) (config, error) {
+	var cfg config
+	fs := flag.NewFlagSet("gocd-server", flag.ContinueOnError)
+	fs.StringVar(&cfg.addr, "addr", ":8080", "HTTP address to listen on")
+	fs.StringVar(&cfg.grpcAddr, "grpc-addr", ":50051", "gRPC address to listen on, empty to disable")
+	fs.StringVar(&cfg.dataset, "dataset", "", "dataset file path")
+	fs.DurationVar(&cfg.reload, "reload", 0, "dataset reload interval, 0 to disable")
+	fs.IntVar(&cfg.maxBatch, "max-batch", server.DefaultMaxBatchSize, "maximum names per batch request")
+	fs.IntVar(&cfg.maxInFlight, "max-in-flight", 0, "maximum concurrent API requests, 0 for no limit")
+	fs.Float64Var(&cfg.rate, "rate", 0, "maximum API requests per second per client IP, 0 for no limit")
+	fs.IntVar(&cfg.burst, "burst", 0, "maximum burst of API requests per client IP (default -rate, rounded up)")
+	fs.DurationVar(&cfg.timeout, "timeout", 0, "maximum batch or stream processing time, 0 for no limit")
+	fs.StringVar(&cfg.tlsCert, "tls-cert", "", "TLS certificate file")
+	fs.StringVar(&cfg.tlsKey, "tls-key", "", "TLS key file")
+	for name, key := range envFlags {
+		fs.Lookup(name).Usage += " (env " + key + ")"
+		if v := os.Getenv(key); v != "" {
+			if err := fs.Set(name, v); err != nil {
+				return cfg, fmt.Errorf("invalid %s %q: %v", key, v, err)
+			}
+		}
+	}
+	if err := fs.Parse(args); err != nil {
+		return cfg, err
+	}
+	if (cfg.tlsCert == "") != (cfg.tlsKey == "") {
+		return cfg, errors.New("-tls-cert and -tls-key must be given together")
+	}
+	return cfg, nil
+}
+
+func main() {
+	log.SetFlags(log.LstdFlags)
+	log.SetPrefix("gocd-server: ")
+
+	cfg, err := parseConfig(os.Args[1:])
+	if err == flag.ErrHelp {
+		os.Exit(0)
+	}
+	if err != nil {
+		log.Print(err)
+		os.Exit(2)
+	}
+
+	metrics := gocd.NewMetrics()
+	opts := []gocd.Option{gocd.WithMetrics(metrics), gocd.WithoutDatasetEnv()}
+	var parser func() *gocd.Parser
+	if cfg.dataset != "" {
+		u, err := gocd.NewUpdater(gocd.FileSource(cfg.dataset), gocd.UpdaterOptions{
+			Interval: cfg.reload,
+			Options:  opts,
+			OnUpdate: func(p *gocd.Parser, err error) {
+				if err != nil {
+					log.Printf("dataset reload failed: %v", err)
+					return
+				}
+				log.Printf("dataset reloaded")
+			},
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		if cfg.reload > 0 {
+			u.Start()
+		}
+		parser = u.Parser
+	} else {
+		p, err := gocd.New(opts...)
+		if err != nil {
+			log.Fatal(err)
+		}
+		parser = func() *gocd.Parser { return p }
+	}
+
+	handler := server.New(parser, server.Options{
+		Metrics:        metrics,
+		MaxBatchSize:   cfg.maxBatch,
+		MaxInFlight:    cfg.maxInFlight,
+		RateLimit:      cfg.rate,
+		RateBurst:      cfg.burst,
+		RequestTimeout: cfg.timeout,
+	})
+	errc := make(chan error, 2)
+	if cfg.grpcAddr != "" {
+		go func() {
+			errc <- serveGRPC(cfg, parser)
+		}()
+	}
+	srv := newHTTPServer(cfg, handler)
+	go func() {
+		log.Printf("listening on %s", cfg.addr)
+		if cfg.tlsCert != "" {
+			errc <- srv.ListenAndServeTLS(cfg.tlsCert, cfg.tlsKey)
+			return
+		}
+		errc <- srv.ListenAndServe()
+	}()
+	log.Fatal(<-errc)
+}
+
+// HTTP server timeouts. There is no read or write timeout, as streaming
+// requests (/v1/stream) may be long-lived, and -timeout limits their
+// processing time instead.
+const (
+	readHeaderTimeout = 10 * time.Second
+	idleTimeout       = 2 * time.Minute
+)
+
+// newHTTPServer returns the HTTP server for handler on cfg.addr
+func newHTTPServer(cfg config, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:              cfg.addr,
+		Handler:           handler,
+		ReadHeaderTimeout: readHeaderTimeout,
+		IdleTimeout:       idleTimeout,
+	}
+}
+
+// serveGRPC serves the gRPC API on cfg.grpcAddr, parsing with the
+// Parser returned by parser
+func serveGRPC(cfg config, parser func() *gocd.Parser) error {
+	opts := []grpc.ServerOption{gocdgrpc.ServerCodec()}
+	if cfg.tlsCert != "" {
+		creds, err := credentials.NewServerTLSFromFile(cfg.tlsCert, cfg.tlsKey)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+	lis, err := net.Listen("tcp", cfg.grpcAddr)
+	if err != nil {
+		return err
+	}
+	s := grpc.NewServer(opts...)
+	gocdgrpc.Register(s, parser)
+	log.Printf("gRPC listening on %s", cfg.grpcAddr)
+	return s.Serve(lis)
+}
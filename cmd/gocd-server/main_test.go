@@ -0,0 +1,68 @@
+package main
+
+import (
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ProfoundNetworks/gocd/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConfig(t *testing.T) {
+	for _, key := range envFlags {
+		if v, ok := os.LookupEnv(key); ok {
+			defer os.Setenv(key, v)
+		} else {
+			defer os.Unsetenv(key)
+		}
+		os.Unsetenv(key)
+	}
+
+	cfg, err := parseConfig(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, config{addr: ":8080", grpcAddr: ":50051", maxBatch: server.DefaultMaxBatchSize}, cfg, "default config")
+
+	os.Setenv("GOCD_ADDR", "localhost:9000")
+	os.Setenv("GOCD_GRPC_ADDR", "localhost:9001")
+	os.Setenv("GOCD_DATASET", "/tmp/ds.yml")
+	os.Setenv("GOCD_RELOAD", "1m")
+	os.Setenv("GOCD_MAX_BATCH", "100")
+	os.Setenv("GOCD_MAX_IN_FLIGHT", "64")
+	os.Setenv("GOCD_RATE", "2.5")
+	os.Setenv("GOCD_TIMEOUT", "10s")
+	cfg, err = parseConfig(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, config{addr: "localhost:9000", grpcAddr: "localhost:9001", dataset: "/tmp/ds.yml", reload: time.Minute,
+		maxBatch: 100, maxInFlight: 64, rate: 2.5, timeout: 10 * time.Second},
+		cfg, "environment config")
+
+	cfg, err = parseConfig([]string{"-addr", ":9090", "-grpc-addr", "", "-reload", "30s", "-max-batch", "50",
+		"-burst", "10"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, config{addr: ":9090", dataset: "/tmp/ds.yml", reload: 30 * time.Second,
+		maxBatch: 50, maxInFlight: 64, rate: 2.5, burst: 10, timeout: 10 * time.Second},
+		cfg, "flags override environment")
+
+	_, err = parseConfig([]string{"-tls-cert", "cert.pem"})
+	assert.Error(t, err, "-tls-cert without -tls-key errors")
+
+	os.Setenv("GOCD_RELOAD", "soon")
+	_, err = parseConfig(nil)
+	assert.EqualError(t, err, `invalid GOCD_RELOAD "soon": parse error`,
+		"invalid GOCD_RELOAD errors")
+}
+
+func TestNewHTTPServer(t *testing.T) {
+	srv := newHTTPServer(config{addr: ":9090"}, http.NotFoundHandler())
+	assert.Equal(t, ":9090", srv.Addr, "address")
+	assert.Equal(t, readHeaderTimeout, srv.ReadHeaderTimeout, "read header timeout")
+	assert.Equal(t, idleTimeout, srv.IdleTimeout, "idle timeout")
+}
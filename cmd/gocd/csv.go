@@ -0,0 +1,101 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ProfoundNetworks/gocd"
+	"github.com/ProfoundNetworks/gocd/csvbatch"
+)
+
+// annotateCSV streams the CSV file input (or stdin, if "-" or empty)
+// through csvbatch.Process, writing to the file output (or stdout, if
+// "-" or empty). If output is the same file as input, it is replaced
+// only once processing succeeds.
+func annotateCSV(p *gocd.Parser, input, output string, opts csvbatch.Options) (err error) {
+	var r io.Reader = os.Stdin
+	if input != "" && input != "-" {
+		fh, err := os.Open(input)
+		if err != nil {
+			return err
+		}
+		defer fh.Close()
+		r = fh
+	}
+
+	if output == "" || output == "-" {
+		return csvbatch.Process(p, os.Stdout, r, opts)
+	}
+
+	// Write to a temporary file alongside output, renamed into place on
+	// success, so output may be input and is never left half-written
+	tmp, err := ioutil.TempFile(filepath.Dir(output), "."+filepath.Base(output)+".*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+	if err = csvbatch.Process(p, tmp, r, opts); err != nil {
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	if fi, statErr := os.Stat(output); statErr == nil {
+		os.Chmod(tmp.Name(), fi.Mode())
+	}
+	return os.Rename(tmp.Name(), output)
+}
+
+// csvMain runs the csv subcommand with args
+func csvMain(args []string) error {
+	fs := flag.NewFlagSet("csv", flag.ExitOnError)
+	nameCol := fs.String("name-col", "", "header of the column containing names")
+	nameIndex := fs.Int("name-index", 0, "zero-based index of the name column, if -name-col is not given")
+	addCols := fs.String("add-cols", strings.Join(csvbatch.DefaultColumns, ","),
+		"comma-separated result columns to append")
+	noHeader := fs.Bool("no-header", false, "input has no header record")
+	comma := fs.String("comma", ",", "field delimiter")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gocd csv [-name-col header | -name-index n] [-add-cols col,...] "+
+			"[-no-header] [-comma c] [input.csv [output.csv]]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() > 2 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	r, size := utf8.DecodeRuneInString(*comma)
+	if r == utf8.RuneError || size != len(*comma) {
+		return errors.New("-comma must be a single character")
+	}
+	columns, err := parseColumns(*addCols, "csv")
+	if err != nil {
+		return err
+	}
+
+	p, err := gocd.New()
+	if err != nil {
+		return err
+	}
+	return annotateCSV(p, fs.Arg(0), fs.Arg(1), csvbatch.Options{
+		NameColumn: *nameCol,
+		NameIndex:  *nameIndex,
+		NoHeader:   *noHeader,
+		Comma:      r,
+		Columns:    columns,
+	})
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ProfoundNetworks/gocd"
+	"github.com/ProfoundNetworks/gocd/csvbatch"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnnotateCSV(t *testing.T) {
+	p, err := gocd.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	input := filepath.Join(dir, "input.csv")
+	data := "id,company_name\n1,Profound Networks LLC\n2,\"\"\"Acme\"\" Widgets, Ltd\"\n"
+	if err := ioutil.WriteFile(input, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+	opts := csvbatch.Options{NameColumn: "company_name", Columns: []string{"short_name", "designator_std"}}
+	expected := "id,company_name,short_name,designator_std\n" +
+		"1,Profound Networks LLC,Profound Networks,LLC\n" +
+		"2,\"\"\"Acme\"\" Widgets, Ltd\",\"\"\"Acme\"\" Widgets\",Ltd.\n"
+
+	output := filepath.Join(dir, "output.csv")
+	if err := annotateCSV(p, input, output, opts); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, expected, string(got), "annotated output")
+
+	// In place
+	if err := annotateCSV(p, input, input, opts); err != nil {
+		t.Fatal(err)
+	}
+	got, err = ioutil.ReadFile(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, expected, string(got), "annotated in place")
+	fi, err := os.Stat(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, os.FileMode(0600), fi.Mode().Perm(), "in place mode preserved")
+
+	// Failures leave output untouched
+	opts.NameColumn = "missing"
+	err = annotateCSV(p, input, input, opts)
+	assert.Error(t, err, "missing name column errors")
+	got, err = ioutil.ReadFile(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, expected, string(got), "failed annotation leaves file")
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, entries, 2, "temporary files removed")
+}
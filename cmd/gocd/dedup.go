@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ProfoundNetworks/gocd"
+)
+
+// readNames returns the non-blank lines of r
+func readNames(r io.Reader) ([]string, error) {
+	var names []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), gocd.MaxLineLength)
+	for scanner.Scan() {
+		if name := scanner.Text(); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names, scanner.Err()
+}
+
+// dedup clusters names with p, returning the names in each cluster, or
+// only the first name in each if representatives is true
+func dedup(p *gocd.Parser, names []string, threshold float64, representatives bool) [][]string {
+	clusters := p.Cluster(names, gocd.ClusterOptions{Threshold: threshold})
+	groups := make([][]string, len(clusters))
+	for i, cluster := range clusters {
+		if representatives {
+			cluster = cluster[:1]
+		}
+		for _, j := range cluster {
+			groups[i] = append(groups[i], names[j])
+		}
+	}
+	return groups
+}
+
+// writeClusters writes groups to w as a JSON array of arrays, or as
+// tab-separated cluster number (from 1) and name lines
+func writeClusters(w io.Writer, groups [][]string, jsonOut bool) error {
+	if jsonOut {
+		if groups == nil {
+			groups = [][]string{}
+		}
+		return json.NewEncoder(w).Encode(groups)
+	}
+	bw := bufio.NewWriter(w)
+	for i, group := range groups {
+		for _, name := range group {
+			fmt.Fprintf(bw, "%d\t%s\n", i+1, name)
+		}
+	}
+	return bw.Flush()
+}
+
+// dedupMain runs the dedup subcommand with args
+func dedupMain(args []string) error {
+	fs := flag.NewFlagSet("dedup", flag.ExitOnError)
+	threshold := fs.Float64("threshold", 0,
+		"also cluster names with at least this similarity (0-1; quadratic in the number of names)")
+	representatives := fs.Bool("representatives", false, "write only the first name of each cluster")
+	jsonOut := fs.Bool("json", false, "write clusters as a JSON array of arrays of names")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gocd dedup [-threshold t] [-representatives] [-json] [file]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	var r io.Reader = os.Stdin
+	if fs.NArg() > 0 {
+		fh, err := os.Open(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer fh.Close()
+		r = fh
+	}
+	names, err := readNames(r)
+	if err != nil {
+		return err
+	}
+
+	p, err := gocd.New()
+	if err != nil {
+		return err
+	}
+	return writeClusters(os.Stdout, dedup(p, names, *threshold, *representatives), *jsonOut)
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ProfoundNetworks/gocd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDedup(t *testing.T) {
+	p, err := gocd.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, err := readNames(strings.NewReader(
+		"Acme Widgets Ltd\nProfound Networks LLC\n\nACME WIDGETS LIMITED\nProfound Networks, L.L.C.\nAcme Widgts Ltd\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, names, 5, "blank lines skipped")
+
+	groups := dedup(p, names, 0, false)
+	assert.Equal(t, [][]string{
+		{"Acme Widgets Ltd", "ACME WIDGETS LIMITED"},
+		{"Profound Networks LLC", "Profound Networks, L.L.C."},
+		{"Acme Widgts Ltd"},
+	}, groups, "exact clusters")
+
+	groups = dedup(p, names, 0.9, true)
+	assert.Equal(t, [][]string{{"Acme Widgets Ltd"}, {"Profound Networks LLC"}}, groups,
+		"fuzzy cluster representatives")
+
+	var buf bytes.Buffer
+	if err := writeClusters(&buf, groups, false); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "1\tAcme Widgets Ltd\n2\tProfound Networks LLC\n", buf.String(), "text output")
+
+	buf.Reset()
+	if err := writeClusters(&buf, groups, true); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, `[["Acme Widgets Ltd"],["Profound Networks LLC"]]`+"\n", buf.String(), "JSON output")
+}
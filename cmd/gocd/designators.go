@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ProfoundNetworks/gocd"
+)
+
+// filterDesignators returns the entries with language lang (if
+// non-empty), and only lead entries if lead is true
+func filterDesignators(entries []gocd.Entry, lang string, lead bool) []gocd.Entry {
+	var filtered []gocd.Entry
+	for _, e := range entries {
+		if (lang == "" || e.Lang == lang) && (!lead || e.Lead) {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
+
+// writeDesignators writes entries to w as a table, or as a JSON array
+// if jsonOut is true
+func writeDesignators(w io.Writer, entries []gocd.Entry, jsonOut bool) error {
+	if jsonOut {
+		if entries == nil {
+			entries = []gocd.Entry{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "long_name\tabbr\tlang\tlead")
+	for _, e := range entries {
+		abbr := append(append([]string(nil), e.Abbr...), e.AbbrRE...)
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", e.LongName, strings.Join(abbr, ", "),
+			e.Lang, strconv.FormatBool(e.Lead))
+	}
+	return tw.Flush()
+}
+
+// designatorsMain runs the designators subcommand with args
+func designatorsMain(args []string) error {
+	fs := flag.NewFlagSet("designators", flag.ExitOnError)
+	lang := fs.String("lang", "", "list only designators for this language (e.g. de)")
+	lead := fs.Bool("lead", false, "list only designators that can appear before the name")
+	jsonOut := fs.Bool("json", false, "write designators as JSON")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gocd designators [-lang xx] [-lead] [-json]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	p, err := gocd.New()
+	if err != nil {
+		return err
+	}
+	entries := filterDesignators(p.Designators(), *lang, *lead)
+	return writeDesignators(os.Stdout, entries, *jsonOut)
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ProfoundNetworks/gocd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDesignators(t *testing.T) {
+	entries := []gocd.Entry{
+		{LongName: "Aktiengesellschaft", Abbr: []string{"AG"}, Lang: "de"},
+		{LongName: "Besloten vennootschap", Abbr: []string{"B.V.", "BV"}, Lang: "nl", Lead: true},
+		{LongName: "Limited", Abbr: []string{"Ltd"}, Lang: "en"},
+	}
+
+	tests := []struct {
+		lang     string
+		lead     bool
+		expected []string
+	}{
+		{"", false, []string{"Aktiengesellschaft", "Besloten vennootschap", "Limited"}},
+		{"de", false, []string{"Aktiengesellschaft"}},
+		{"", true, []string{"Besloten vennootschap"}},
+		{"de", true, nil},
+	}
+	for _, tc := range tests {
+		var longs []string
+		for _, e := range filterDesignators(entries, tc.lang, tc.lead) {
+			longs = append(longs, e.LongName)
+		}
+		assert.Equal(t, tc.expected, longs, "filtered designators for lang %q, lead %t",
+			tc.lang, tc.lead)
+	}
+
+	var buf bytes.Buffer
+	err := writeDesignators(&buf, entries[1:2], false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "long_name              abbr      lang  lead\n"+
+		"Besloten vennootschap  B.V., BV  nl    true\n", buf.String(), "table output")
+
+	buf.Reset()
+	err = writeDesignators(&buf, entries[:1], true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded []gocd.Entry
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, entries[:1], decoded, "JSON output")
+
+	buf.Reset()
+	err = writeDesignators(&buf, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "[]", strings.TrimSpace(buf.String()), "empty JSON output")
+}
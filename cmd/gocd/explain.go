@@ -0,0 +1,111 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ProfoundNetworks/gocd"
+)
+
+// explanation is the JSON form of a gocd.Explanation
+type explanation struct {
+	Input        string              `json:"input"`
+	Preprocessed string              `json:"preprocessed"`
+	Tried        []gocd.PositionType `json:"tried"`
+	Pass         gocd.PositionType   `json:"pass"`
+	Variant      string              `json:"variant,omitempty"`
+	VariantRegex bool                `json:"variant_regex,omitempty"`
+	Groups       []string            `json:"groups,omitempty"`
+	Result       *gocd.Result        `json:"result"`
+	Entry        *gocd.Entry         `json:"entry,omitempty"`
+}
+
+func newExplanation(ex *gocd.Explanation) explanation {
+	return explanation{
+		Input:        ex.Result.Input,
+		Preprocessed: ex.Preprocessed,
+		Tried:        ex.Tried,
+		Pass:         ex.Pass,
+		Variant:      ex.Variant,
+		VariantRegex: ex.VariantRegex,
+		Groups:       ex.Groups,
+		Result:       ex.Result,
+		Entry:        ex.Result.Entry,
+	}
+}
+
+// writeText writes a human-readable version of ex to w
+func (ex explanation) writeText(w io.Writer) {
+	tried := make([]string, len(ex.Tried))
+	for i, t := range ex.Tried {
+		tried[i] = t.String()
+	}
+	fmt.Fprintf(w, "input:        %s\n", ex.Input)
+	fmt.Fprintf(w, "preprocessed: %s\n", ex.Preprocessed)
+	fmt.Fprintf(w, "passes tried: %s\n", strings.Join(tried, ", "))
+	if !ex.Result.Matched {
+		fmt.Fprintln(w, "matched:      false")
+		return
+	}
+	fmt.Fprintf(w, "matched pass: %s\n", ex.Pass)
+	variant := ex.Variant
+	if ex.VariantRegex {
+		variant += " (regex)"
+	}
+	fmt.Fprintf(w, "variant:      %s\n", variant)
+	if e := ex.Entry; e != nil {
+		fmt.Fprintf(w, "entry:        %s (lang %s, source %s)\n", e.LongName, e.Lang, e.Source)
+	}
+	fmt.Fprintf(w, "groups:       %q\n", ex.Groups)
+	fmt.Fprintf(w, "short name:   %s\n", ex.Result.ShortName)
+	fmt.Fprintf(w, "designator:   %s (std %s, %s)\n", ex.Result.Designator,
+		ex.Result.DesignatorStd, ex.Result.MatchKind)
+}
+
+// explain writes explanations of how p parses each of names to w
+func explain(p *gocd.Parser, w io.Writer, names []string, jsonOut bool) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	for i, name := range names {
+		ex, err := p.Explain(name)
+		if err != nil {
+			return err
+		}
+		if jsonOut {
+			if err := enc.Encode(newExplanation(ex)); err != nil {
+				return err
+			}
+			continue
+		}
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		newExplanation(ex).writeText(w)
+	}
+	return nil
+}
+
+// explainMain runs the explain subcommand with args
+func explainMain(args []string) error {
+	fs := flag.NewFlagSet("explain", flag.ExitOnError)
+	jsonOut := fs.Bool("json", false, "write explanations as JSON")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gocd explain [-json] name...")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	p, err := gocd.New()
+	if err != nil {
+		return err
+	}
+	return explain(p, os.Stdout, fs.Args(), *jsonOut)
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/ProfoundNetworks/gocd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplain(t *testing.T) {
+	p, err := gocd.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	err = explain(p, &buf, []string{"Acme Vennootschap Onder Firma", "Acme Widgets"}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	assert.Contains(t, out, "matched pass: end\n", "text reports pass")
+	assert.Contains(t, out, "variant:      Vennootschap onder firma\n", "text reports variant")
+	assert.Contains(t, out, "entry:        Vennootschap onder firma (lang nl, source core)\n",
+		"text reports entry")
+	assert.Contains(t, out, "short name:   Acme\n", "text reports short name")
+	assert.Contains(t, out, "\ninput:        Acme Widgets\n", "text reports second name")
+	assert.Contains(t, out, "matched:      false\n", "text reports no match")
+
+	buf.Reset()
+	err = explain(p, &buf, []string{"Acme Ltd"}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ex explanation
+	if err := json.Unmarshal(buf.Bytes(), &ex); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, gocd.End, ex.Pass, "JSON pass")
+	assert.Equal(t, "Ltd.", ex.Variant, "JSON variant")
+	assert.Equal(t, "Acme", ex.Result.ShortName, "JSON short name")
+	if assert.NotNil(t, ex.Entry, "JSON entry") {
+		assert.Equal(t, "Limited", ex.Entry.LongName, "JSON entry long name")
+	}
+}
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ProfoundNetworks/gocd"
+)
+
+// Formats lists the supported output formats
+var Formats = []string{"json", "ndjson", "csv", "tsv", "tabular", "raw"}
+
+// defaultTableColumns are the columns written by the csv, tsv, and
+// tabular formats if none are given (the JSON formats default to all
+// gocd.Fields)
+var defaultTableColumns = []string{
+	"input", "short_name", "designator", "designator_std", "position",
+}
+
+// resultWriter writes Results in an output format
+type resultWriter interface {
+	write(res *gocd.Result) error
+	flush() error
+}
+
+// filterWriter is a resultWriter writing only the Results for which
+// keep returns true
+type filterWriter struct {
+	resultWriter
+	keep func(res *gocd.Result) bool
+}
+
+func (fw filterWriter) write(res *gocd.Result) error {
+	if !fw.keep(res) {
+		return nil
+	}
+	return fw.resultWriter.write(res)
+}
+
+// matchedFilter returns rw filtered to write only matched Results if
+// matched is true, or only unmatched Results if unmatched is true
+func matchedFilter(rw resultWriter, matched, unmatched bool) resultWriter {
+	switch {
+	case matched:
+		return filterWriter{rw, func(res *gocd.Result) bool { return res.Matched }}
+	case unmatched:
+		return filterWriter{rw, func(res *gocd.Result) bool { return !res.Matched }}
+	}
+	return rw
+}
+
+// parseColumns returns the columns given by the comma-separated list
+// s, or the default columns for format if s is empty
+func parseColumns(s, format string) ([]string, error) {
+	if s == "" {
+		switch format {
+		case "json", "ndjson":
+			return gocd.Fields, nil
+		case "raw":
+			return []string{"short_name"}, nil
+		}
+		return defaultTableColumns, nil
+	}
+	columns := strings.Split(s, ",")
+	for i, col := range columns {
+		col = strings.TrimSpace(col)
+		if _, ok := (&gocd.Result{}).Field(col); !ok {
+			return nil, fmt.Errorf("unknown column %q (expected one of %s)", col,
+				strings.Join(gocd.Fields, ", "))
+		}
+		columns[i] = col
+	}
+	return columns, nil
+}
+
+// newResultWriter returns a resultWriter writing the given columns to w
+// in format. Raw output records are terminated by term.
+func newResultWriter(w io.Writer, format string, columns []string, term byte) (resultWriter, error) {
+	switch format {
+	case "raw":
+		if len(columns) != 1 {
+			return nil, fmt.Errorf("raw format requires a single column, not %d", len(columns))
+		}
+		return &rawWriter{w: bufio.NewWriter(w), column: columns[0], term: term}, nil
+	case "json":
+		return &jsonWriter{w: bufio.NewWriter(w), columns: columns, array: true}, nil
+	case "ndjson":
+		return &jsonWriter{w: bufio.NewWriter(w), columns: columns}, nil
+	case "csv", "tsv":
+		cw := csv.NewWriter(w)
+		if format == "tsv" {
+			cw.Comma = '\t'
+		}
+		return &csvWriter{w: cw, columns: columns}, nil
+	case "tabular":
+		tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+		return &tableWriter{tw: tw, columns: columns}, nil
+	}
+	return nil, fmt.Errorf("unknown format %q (expected one of %s)", format,
+		strings.Join(Formats, ", "))
+}
+
+// jsonWriter writes Results as JSON objects with the selected columns,
+// either newline-delimited or as a single array
+type jsonWriter struct {
+	w       *bufio.Writer
+	columns []string
+	array   bool
+	n       int
+}
+
+// resultFields maps Result JSON field names to their struct field
+// indices, so that every column has a value even if its field is
+// omitted from the marshalled Result when empty
+var resultFields = func() map[string]int {
+	fields := make(map[string]int)
+	rt := reflect.TypeOf(gocd.Result{})
+	for i := 0; i < rt.NumField(); i++ {
+		name := strings.Split(rt.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			fields[name] = i
+		}
+	}
+	return fields
+}()
+
+// jsonRecord is a Result restricted to the selected columns, which
+// marshals as a JSON object with its keys in column order
+type jsonRecord struct {
+	res     *gocd.Result
+	columns []string
+}
+
+func (rec jsonRecord) MarshalJSON() ([]byte, error) {
+	rv := reflect.ValueOf(rec.res).Elem()
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, col := range rec.columns {
+		idx, ok := resultFields[col]
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q", col)
+		}
+		key, err := json.Marshal(col)
+		if err != nil {
+			return nil, err
+		}
+		val, err := json.Marshal(rv.Field(idx).Interface())
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(val)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func (jw *jsonWriter) write(res *gocd.Result) error {
+	data, err := json.Marshal(jsonRecord{res: res, columns: jw.columns})
+	if err != nil {
+		return err
+	}
+	if jw.array {
+		if jw.n == 0 {
+			jw.w.WriteString("[\n")
+		} else {
+			jw.w.WriteString(",\n")
+		}
+	}
+	jw.n++
+	jw.w.Write(data)
+	if !jw.array {
+		jw.w.WriteByte('\n')
+	}
+	return nil
+}
+
+func (jw *jsonWriter) flush() error {
+	if jw.array {
+		if jw.n == 0 {
+			jw.w.WriteString("[")
+		}
+		jw.w.WriteString("\n]\n")
+	}
+	return jw.w.Flush()
+}
+
+// fieldValues appends the values of the given columns of res to record
+func fieldValues(record []string, res *gocd.Result, columns []string) []string {
+	for _, col := range columns {
+		val, _ := res.Field(col)
+		record = append(record, val)
+	}
+	return record
+}
+
+// csvWriter writes Results as delimited records, with a header
+type csvWriter struct {
+	w       *csv.Writer
+	columns []string
+	started bool
+	record  []string
+}
+
+func (cw *csvWriter) write(res *gocd.Result) error {
+	if !cw.started {
+		cw.started = true
+		if err := cw.w.Write(cw.columns); err != nil {
+			return err
+		}
+	}
+	cw.record = fieldValues(cw.record[:0], res, cw.columns)
+	return cw.w.Write(cw.record)
+}
+
+func (cw *csvWriter) flush() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+// tableWriter writes Results as aligned columns, with a header. Output
+// is buffered until flushed, so is intended for interactive use.
+type tableWriter struct {
+	tw      *tabwriter.Writer
+	columns []string
+	started bool
+	record  []string
+}
+
+// cellReplacer replaces characters that would break table alignment
+var cellReplacer = strings.NewReplacer("\t", " ", "\n", " ")
+
+func (tw *tableWriter) writeRow(record []string) error {
+	for i, val := range record {
+		record[i] = cellReplacer.Replace(val)
+	}
+	_, err := io.WriteString(tw.tw, strings.Join(record, "\t")+"\n")
+	return err
+}
+
+func (tw *tableWriter) write(res *gocd.Result) error {
+	if !tw.started {
+		tw.started = true
+		if err := tw.writeRow(append([]string(nil), tw.columns...)); err != nil {
+			return err
+		}
+	}
+	tw.record = fieldValues(tw.record[:0], res, tw.columns)
+	return tw.writeRow(tw.record)
+}
+
+func (tw *tableWriter) flush() error {
+	return tw.tw.Flush()
+}
+
+// rawWriter writes the value of a single Result column per record, with
+// no quoting or header
+type rawWriter struct {
+	w      *bufio.Writer
+	column string
+	term   byte
+}
+
+func (rw *rawWriter) write(res *gocd.Result) error {
+	val, _ := res.Field(rw.column)
+	rw.w.WriteString(val)
+	return rw.w.WriteByte(rw.term)
+}
+
+func (rw *rawWriter) flush() error {
+	return rw.w.Flush()
+}
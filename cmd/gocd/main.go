@@ -0,0 +1,131 @@
+/*
+gocd parses company names, reporting the designator found in each.
+
+Usage:
+
+	gocd [-format json|ndjson|csv|tsv|tabular|raw] [-columns col,...]
+	     [-only-matched | -only-unmatched] [-0] [-workers n] [-quiet] [file]
+	gocd explain [-json] name...
+	gocd designators [-lang xx] [-lead] [-json]
+	gocd csv [-name-col header | -name-index n] [-add-cols col,...]
+	         [-no-header] [-comma c] [input.csv [output.csv]]
+	gocd dedup [-threshold t] [-representatives] [-json] [file]
+
+Input is read from file (or stdin), one name per line, and results
+written to stdout in the given format (ndjson by default). Columns are
+gocd Result field names (e.g. short_name, designator_std); the JSON
+formats default to all fields, the raw format (which writes a single
+column per line, unquoted) to short_name, and the others to input,
+short_name, designator, designator_std, and position. With -0, input
+names are NUL-delimited rather than newline-delimited, and raw output
+is NUL-terminated, for use with e.g. find -print0 and xargs -0.
+
+With -only-matched or -only-unmatched, only results with (or without) a
+designator are written, so gocd can act as a filter e.g. to extract
+names with no recognisable designator for review.
+
+Names are parsed concurrently by -workers goroutines (by default one
+per CPU), with results written in input order. A summary of the run
+(names, match rate, elapsed time, and throughput) is written to stderr
+at the end, unless -quiet is given.
+
+The explain subcommand reports how each name is parsed: the passes
+tried (including fallback passes), the pass that matched, the dataset
+entry and designator variant matched, and the captured regex groups.
+
+The designators subcommand lists the loaded dataset entries (long name,
+abbreviations, language, and lead flag), optionally restricted to a
+language or to designators that can appear before the name.
+
+The csv subcommand streams a CSV file (or stdin) through the parser,
+appending result columns (by default short_name, designator,
+designator_std, and position) to each record, and writing to a file (or
+stdout). The output file may be the input file, which is replaced once
+processing succeeds.
+
+The dedup subcommand groups names whose designator-stripped, normalised
+forms are identical (or with -threshold, similar), writing each name
+with its cluster number, in order of first appearance. With
+-representatives, only the first name of each cluster is written.
+*/
+package main
+
+import (
+	"bufio"
+	"flag"
+	"io"
+	"log"
+	"os"
+
+	"github.com/ProfoundNetworks/gocd"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gocd: ")
+
+	if len(os.Args) > 1 {
+		subcommands := map[string]func([]string) error{
+			"explain":     explainMain,
+			"designators": designatorsMain,
+			"csv":         csvMain,
+			"dedup":       dedupMain,
+		}
+		if sub, ok := subcommands[os.Args[1]]; ok {
+			if err := sub(os.Args[2:]); err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
+	}
+
+	format := flag.String("format", "ndjson", "output format: json, ndjson, csv, tsv, tabular, or raw")
+	columnList := flag.String("columns", "", "comma-separated result columns to output")
+	workers := flag.Int("workers", 0, "number of concurrent parsing workers (default one per CPU)")
+	quiet := flag.Bool("quiet", false, "don't write a summary to stderr")
+	onlyMatched := flag.Bool("only-matched", false, "write only results with a designator")
+	onlyUnmatched := flag.Bool("only-unmatched", false, "write only results without a designator")
+	null := flag.Bool("0", false, "read NUL-delimited names, and NUL-terminate raw output")
+	flag.Parse()
+
+	if *onlyMatched && *onlyUnmatched {
+		log.Fatal("-only-matched and -only-unmatched are mutually exclusive")
+	}
+
+	columns, err := parseColumns(*columnList, *format)
+	if err != nil {
+		log.Fatal(err)
+	}
+	split, term := bufio.ScanLines, byte('\n')
+	if *null {
+		split, term = scanNull, 0
+	}
+	rw, err := newResultWriter(os.Stdout, *format, columns, term)
+	if err != nil {
+		log.Fatal(err)
+	}
+	rw = matchedFilter(rw, *onlyMatched, *onlyUnmatched)
+
+	var r io.Reader = os.Stdin
+	if flag.NArg() > 0 {
+		fh, err := os.Open(flag.Arg(0))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer fh.Close()
+		r = fh
+	}
+
+	p, err := gocd.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	stats, err := process(p, rw, r, split, *workers)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if !*quiet {
+		stats.writeSummary(os.Stderr)
+	}
+}
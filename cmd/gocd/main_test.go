@@ -0,0 +1,195 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ProfoundNetworks/gocd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessFormats(t *testing.T) {
+	p, err := gocd.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := "Profound Networks LLC\n\nAcme, Widgets\n"
+
+	tests := []struct {
+		format   string
+		columns  string
+		expected string
+	}{
+		{"ndjson", "short_name,matched",
+			`{"short_name":"Profound Networks","matched":true}` + "\n" +
+				`{"short_name":"Acme, Widgets","matched":false}` + "\n"},
+		{"json", "designator,position",
+			"[\n" + `{"designator":"LLC","position":"end"}` + ",\n" +
+				`{"designator":"","position":"none"}` + "\n]\n"},
+		{"csv", "input,designator",
+			"input,designator\nProfound Networks LLC,LLC\n\"Acme, Widgets\",\n"},
+		{"tsv", "input,designator",
+			"input\tdesignator\nProfound Networks LLC\tLLC\nAcme, Widgets\t\n"},
+		{"tabular", "input,designator",
+			"input                  designator\n" +
+				"Profound Networks LLC  LLC\n" +
+				"Acme, Widgets          \n"},
+	}
+	for _, tc := range tests {
+		columns, err := parseColumns(tc.columns, tc.format)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		rw, err := newResultWriter(&buf, tc.format, columns, '\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = process(p, rw, strings.NewReader(input), bufio.ScanLines, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.expected, buf.String(), "%s output matches", tc.format)
+	}
+
+	columns, err := parseColumns("", "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, gocd.Fields, columns, "json default columns")
+	columns, err = parseColumns("", "csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, defaultTableColumns, columns, "csv default columns")
+
+	_, err = parseColumns("input,bogus", "csv")
+	assert.Error(t, err, "unknown column errors")
+	_, err = newResultWriter(&bytes.Buffer{}, "xml", columns, '\n')
+	assert.Error(t, err, "unknown format errors")
+}
+
+func TestMatchedFilter(t *testing.T) {
+	p, err := gocd.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := "Profound Networks LLC\nAcme Widgets\nOOO Romashka\nBar Baz\n"
+
+	tests := []struct {
+		matched   bool
+		unmatched bool
+		expected  string
+	}{
+		{false, false, "input\nProfound Networks LLC\nAcme Widgets\nOOO Romashka\nBar Baz\n"},
+		{true, false, "input\nProfound Networks LLC\nOOO Romashka\n"},
+		{false, true, "input\nAcme Widgets\nBar Baz\n"},
+	}
+	for _, tc := range tests {
+		var buf bytes.Buffer
+		rw, err := newResultWriter(&buf, "csv", []string{"input"}, '\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		stats, err := process(p, matchedFilter(rw, tc.matched, tc.unmatched), strings.NewReader(input), bufio.ScanLines, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.expected, buf.String(), "output for matched %t, unmatched %t",
+			tc.matched, tc.unmatched)
+		assert.Equal(t, 4, stats.Names, "all names counted")
+	}
+}
+
+func TestRawNull(t *testing.T) {
+	p, err := gocd.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := "Acme\nWidgets\x00Profound Networks LLC\x00\x00Bar Baz"
+
+	columns, err := parseColumns("", "raw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	rw, err := newResultWriter(&buf, "raw", columns, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stats, err := process(p, rw, strings.NewReader(input), scanNull, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 3, stats.Names, "NUL-delimited names")
+	assert.Equal(t, "Acme\nWidgets\x00Profound Networks\x00Bar Baz\x00", buf.String(),
+		"NUL-terminated raw output")
+
+	buf.Reset()
+	rw, err = newResultWriter(&buf, "raw", []string{"designator_std"}, '\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = process(p, rw, strings.NewReader("Acme Ltd\nBar Baz\n"), bufio.ScanLines, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "Ltd.\n\n", buf.String(), "raw column output")
+
+	_, err = newResultWriter(&buf, "raw", []string{"input", "short_name"}, '\n')
+	assert.Error(t, err, "raw format with multiple columns errors")
+}
+
+func TestJSONDefaultColumns(t *testing.T) {
+	p, err := gocd.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := "Acme Ltd\nBar Baz\nSociété Générale SA\n"
+
+	for _, format := range []string{"ndjson", "json"} {
+		columns, err := parseColumns("", format)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		rw, err := newResultWriter(&buf, format, columns, '\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = process(p, rw, strings.NewReader(input), bufio.ScanLines, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var records []map[string]interface{}
+		if format == "json" {
+			err = json.Unmarshal(buf.Bytes(), &records)
+			assert.NoError(t, err, "json output decodes")
+		} else {
+			dec := json.NewDecoder(&buf)
+			for dec.More() {
+				var rec map[string]interface{}
+				if err := dec.Decode(&rec); err != nil {
+					t.Fatalf("ndjson output decodes: %s", err)
+				}
+				records = append(records, rec)
+			}
+		}
+		if !assert.Len(t, records, 3, "%s records", format) {
+			continue
+		}
+		for _, rec := range records {
+			assert.Len(t, rec, len(gocd.Fields), "%s record has all fields", format)
+		}
+		assert.Equal(t, "", records[1]["name_lang"], "%s empty field written", format)
+		assert.Equal(t, false, records[1]["lang_mismatch"], "%s false field written", format)
+		assert.Equal(t, 0.0, records[1]["ambiguity"], "%s zero field written", format)
+	}
+
+	_, err = json.Marshal(jsonRecord{res: &gocd.Result{}, columns: []string{"input", "bogus"}})
+	assert.Error(t, err, "unknown json column errors")
+}
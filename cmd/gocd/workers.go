@@ -0,0 +1,154 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"runtime"
+	"time"
+
+	"github.com/ProfoundNetworks/gocd"
+)
+
+// batchSize is the number of names parsed per batch by each worker
+const batchSize = 256
+
+// Stats summarises a processing run
+type Stats struct {
+	Names   int
+	Matched int
+	Elapsed time.Duration
+}
+
+// writeSummary writes a one-line summary of s to w
+func (s Stats) writeSummary(w io.Writer) {
+	rate, matchRate := 0.0, 0.0
+	if secs := s.Elapsed.Seconds(); secs > 0 {
+		rate = float64(s.Names) / secs
+	}
+	if s.Names > 0 {
+		matchRate = float64(s.Matched) / float64(s.Names)
+	}
+	fmt.Fprintf(w, "names: %d, matched: %d (%.2f%%), elapsed: %s, %.0f names/sec\n",
+		s.Names, s.Matched, 100*matchRate, s.Elapsed.Round(time.Millisecond), rate)
+}
+
+// scanNull is a bufio.SplitFunc splitting input into NUL-terminated
+// records, for use with e.g. find -print0
+func scanNull(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+	if i := bytes.IndexByte(data, 0); i >= 0 {
+		return i + 1, data[:i], nil
+	}
+	if atEOF {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// batch is a run of input names and their results
+type batch struct {
+	names   []string
+	results []*gocd.Result
+	err     error
+	done    chan struct{}
+}
+
+// process parses each name in r, as split by split (e.g. bufio.ScanLines
+// or scanNull), with p, writing results to rw in input order and
+// returning summary Stats. Empty names are skipped. Names are parsed in
+// batches by up to workers goroutines (GOMAXPROCS if workers is less
+// than 1).
+func process(p *gocd.Parser, rw resultWriter, r io.Reader, split bufio.SplitFunc, workers int) (Stats, error) {
+	start := time.Now()
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	// Batches are queued for workers and, in input order, for the writer
+	jobs := make(chan *batch)
+	queue := make(chan *batch, 2*workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			for b := range jobs {
+				b.results = make([]*gocd.Result, len(b.names))
+				for i, name := range b.names {
+					b.results[i], b.err = p.Parse(name)
+					if b.err != nil {
+						break
+					}
+				}
+				close(b.done)
+			}
+		}()
+	}
+
+	readErr := make(chan error, 1)
+	stop := make(chan struct{})
+	go func() {
+		defer close(queue)
+		defer close(jobs)
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), gocd.MaxLineLength)
+		scanner.Split(split)
+		b := &batch{done: make(chan struct{})}
+		send := func() bool {
+			select {
+			case queue <- b:
+			case <-stop:
+				return false
+			}
+			jobs <- b
+			b = &batch{done: make(chan struct{})}
+			return true
+		}
+		for scanner.Scan() {
+			if name := scanner.Text(); name != "" {
+				b.names = append(b.names, name)
+			}
+			if len(b.names) == batchSize && !send() {
+				return
+			}
+		}
+		if len(b.names) > 0 && !send() {
+			return
+		}
+		readErr <- scanner.Err()
+	}()
+
+	var stats Stats
+	var err error
+	for b := range queue {
+		<-b.done
+		if err != nil {
+			continue
+		}
+		if b.err != nil {
+			err = b.err
+			close(stop)
+			continue
+		}
+		for _, res := range b.results {
+			stats.Names++
+			if res.Matched {
+				stats.Matched++
+			}
+			if err = rw.write(res); err != nil {
+				close(stop)
+				break
+			}
+		}
+	}
+	if err != nil {
+		return stats, err
+	}
+	if err := <-readErr; err != nil {
+		return stats, err
+	}
+	err = rw.flush()
+	stats.Elapsed = time.Since(start)
+	return stats, err
+}
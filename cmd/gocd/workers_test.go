@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ProfoundNetworks/gocd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessWorkers(t *testing.T) {
+	p, err := gocd.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := []string{"Profound Networks LLC", "Acme Widgets", "OOO Romashka", "Acme GmbH"}
+	var input, expected strings.Builder
+	for i := 0; i < 1000; i++ {
+		name := names[i%len(names)]
+		input.WriteString(name + "\n")
+		res, err := p.Parse(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected.WriteString(res.ShortName + "\n")
+	}
+
+	for _, workers := range []int{0, 1, 4} {
+		var buf bytes.Buffer
+		rw, err := newResultWriter(&buf, "tsv", []string{"short_name"}, '\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		stats, err := process(p, rw, strings.NewReader(input.String()), bufio.ScanLines, workers)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "short_name\n"+expected.String(), buf.String(),
+			"output in input order with %d workers", workers)
+		assert.Equal(t, 1000, stats.Names, "names with %d workers", workers)
+		assert.Equal(t, 750, stats.Matched, "matched with %d workers", workers)
+	}
+
+	var buf bytes.Buffer
+	Stats{Names: 1000, Matched: 750, Elapsed: 2 * time.Second}.writeSummary(&buf)
+	assert.Equal(t, "names: 1000, matched: 750 (75.00%), elapsed: 2s, 500 names/sec\n",
+		buf.String(), "summary matches")
+}
+
+// failingWriter is a resultWriter that fails after n writes
+type failingWriter struct{ n int }
+
+func (fw *failingWriter) write(res *gocd.Result) error {
+	if fw.n == 0 {
+		return errors.New("write failed")
+	}
+	fw.n--
+	return nil
+}
+
+func (fw *failingWriter) flush() error { return nil }
+
+func TestProcessWriteError(t *testing.T) {
+	p, err := gocd.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := strings.Repeat("Acme Ltd\n", 10*batchSize)
+	_, err = process(p, &failingWriter{n: 300}, strings.NewReader(input), bufio.ScanLines, 4)
+	assert.EqualError(t, err, "write failed", "write errors are returned")
+}
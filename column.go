@@ -0,0 +1,140 @@
+package gocd
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidColumn is returned by ParseColumnBuffers for malformed
+// column buffers
+var ErrInvalidColumn = errors.New("invalid column buffers")
+
+// Columns holds Parse results for a batch of names in columnar
+// (struct-of-arrays) form, with one element per input name in each
+// slice. This layout maps directly onto columnar formats like Apache
+// Arrow (e.g. via array builders' AppendValues) without per-row
+// conversion.
+type Columns struct {
+	Matched        []bool
+	ShortName      []string
+	Designator     []string
+	DesignatorStd  []string
+	DesignatorLong []string
+	Position       []PositionType
+	MatchKind      []MatchKind
+	Qualifier      []string
+	Lang           []string
+}
+
+// newColumns returns Columns with n rows, allocating each column once
+// for the whole batch
+func newColumns(n int) *Columns {
+	return &Columns{
+		Matched:        make([]bool, n),
+		ShortName:      make([]string, n),
+		Designator:     make([]string, n),
+		DesignatorStd:  make([]string, n),
+		DesignatorLong: make([]string, n),
+		Position:       make([]PositionType, n),
+		MatchKind:      make([]MatchKind, n),
+		Qualifier:      make([]string, n),
+		Lang:           make([]string, n),
+	}
+}
+
+// Len returns the number of rows in c
+func (c *Columns) Len() int {
+	return len(c.Matched)
+}
+
+// set sets row i of c from res
+func (c *Columns) set(i int, res *Result) {
+	c.Matched[i] = res.Matched
+	c.ShortName[i] = res.ShortName
+	c.Designator[i] = res.Designator
+	c.DesignatorStd[i] = res.DesignatorStd
+	c.DesignatorLong[i] = res.DesignatorLong
+	c.Position[i] = res.Position
+	c.MatchKind[i] = res.MatchKind
+	c.Qualifier[i] = res.Qualifier
+	c.Lang[i] = res.Lang
+}
+
+// copyRow copies row j of c to row i
+func (c *Columns) copyRow(i, j int) {
+	c.Matched[i] = c.Matched[j]
+	c.ShortName[i] = c.ShortName[j]
+	c.Designator[i] = c.Designator[j]
+	c.DesignatorStd[i] = c.DesignatorStd[j]
+	c.DesignatorLong[i] = c.DesignatorLong[j]
+	c.Position[i] = c.Position[j]
+	c.MatchKind[i] = c.MatchKind[j]
+	c.Qualifier[i] = c.Qualifier[j]
+	c.Lang[i] = c.Lang[j]
+}
+
+// columnBatch parses the rows of a column, sharing a single Result
+// across rows and parsing repeated names once
+type columnBatch struct {
+	p    *Parser
+	c    *Columns
+	res  Result
+	seen map[string]int // First row, by name
+}
+
+// parse parses name into row i of the batch
+func (b *columnBatch) parse(i int, name string) error {
+	if j, exists := b.seen[name]; exists {
+		b.c.copyRow(i, j)
+		return nil
+	}
+	if err := b.p.ParseInto(name, &b.res); err != nil {
+		return err
+	}
+	b.c.set(i, &b.res)
+	b.seen[name] = i
+	return nil
+}
+
+// ParseColumn parses a column of company names, returning the results
+// as Columns. Work is shared across the batch: column slices are
+// allocated once up front, rows are parsed into a single reused Result
+// (as with ParseInto), and repeated names are parsed only once.
+func (p *Parser) ParseColumn(names []string) (*Columns, error) {
+	b := columnBatch{p: p, c: newColumns(len(names)), seen: make(map[string]int)}
+	for i, name := range names {
+		if err := b.parse(i, name); err != nil {
+			return nil, err
+		}
+	}
+	return b.c, nil
+}
+
+// ParseColumnBuffers is like ParseColumn for a column of names in the
+// Arrow variable-size binary layout, as returned by an Arrow String
+// array's ValueOffsets and ValueBytes (or held by a decoded Parquet
+// BYTE_ARRAY column chunk): name i is data[offsets[i]:offsets[i+1]].
+// The data buffer is converted to a string once for the whole column,
+// with names (and, for ASCII input, result strings) sliced from it, so
+// there are no per-row input copies. Null slots are parsed as empty
+// names, so callers should carry over the input array's validity
+// bitmap. Malformed offsets return an ErrInvalidColumn error.
+func (p *Parser) ParseColumnBuffers(offsets []int32, data []byte) (*Columns, error) {
+	if len(offsets) == 0 {
+		return newColumns(0), nil
+	}
+	s := string(data)
+	n := len(offsets) - 1
+	b := columnBatch{p: p, c: newColumns(n), seen: make(map[string]int)}
+	for i := 0; i < n; i++ {
+		start, end := offsets[i], offsets[i+1]
+		if start < 0 || int(end) > len(data) || end < start {
+			return nil, fmt.Errorf("%w: row %d offsets [%d, %d] invalid for data length %d",
+				ErrInvalidColumn, i, start, end, len(data))
+		}
+		if err := b.parse(i, s[start:end]); err != nil {
+			return nil, err
+		}
+	}
+	return b.c, nil
+}
@@ -0,0 +1,117 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseColumn(t *testing.T) {
+	tests := loadStripTests()
+
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := make([]string, len(tests))
+	for i, tc := range tests {
+		names[i] = tc.Name
+	}
+	c, err := p.ParseColumn(names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, len(names), c.Len(), "Columns length matches")
+
+	for i, name := range names {
+		res, err := p.Parse(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, res.Matched, c.Matched[i], "Matched matches")
+		assert.Equal(t, res.ShortName, c.ShortName[i], "ShortName matches")
+		assert.Equal(t, res.Designator, c.Designator[i], "Designator matches")
+		assert.Equal(t, res.DesignatorStd, c.DesignatorStd[i], "DesignatorStd matches")
+		assert.Equal(t, res.DesignatorLong, c.DesignatorLong[i], "DesignatorLong matches")
+		assert.Equal(t, res.Position, c.Position[i], "Position matches")
+		assert.Equal(t, res.MatchKind, c.MatchKind[i], "MatchKind matches")
+		assert.Equal(t, res.Qualifier, c.Qualifier[i], "Qualifier matches")
+		assert.Equal(t, res.Lang, c.Lang[i], "Lang matches")
+	}
+}
+
+func TestParseColumnBuffers(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Repeated names and empty (null) slots
+	names := []string{"Acme Ltd", "OOO Ромашка", "", "Acme Ltd", "Bar Baz", "OOO Ромашка"}
+	var data []byte
+	offsets := []int32{0}
+	for _, name := range names {
+		data = append(data, name...)
+		offsets = append(offsets, int32(len(data)))
+	}
+	c, err := p.ParseColumnBuffers(offsets, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := p.ParseColumn(names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, expected, c, "ParseColumnBuffers matches ParseColumn")
+	for i, name := range names {
+		res, err := p.Parse(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, res.ShortName, c.ShortName[i], "ShortName matches for %q", name)
+		assert.Equal(t, res.DesignatorStd, c.DesignatorStd[i], "DesignatorStd matches for %q", name)
+	}
+
+	c, err = p.ParseColumnBuffers(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0, c.Len(), "empty column")
+
+	_, err = p.ParseColumnBuffers([]int32{0, 4}, []byte("Acm"))
+	assert.ErrorIs(t, err, ErrInvalidColumn, "offset past data errors")
+	_, err = p.ParseColumnBuffers([]int32{0, 3, 2, 3}, []byte("Acm"))
+	assert.ErrorIs(t, err, ErrInvalidColumn, "decreasing offset errors")
+	_, err = p.ParseColumnBuffers([]int32{0, 100, 5}, []byte("0123456789"))
+	assert.ErrorIs(t, err, ErrInvalidColumn, "middle offset past data errors")
+	_, err = p.ParseColumnBuffers([]int32{-1, 3}, []byte("Acm"))
+	assert.ErrorIs(t, err, ErrInvalidColumn, "negative offset errors")
+}
+
+func BenchmarkParseColumn(b *testing.B) {
+	p, err := New()
+	if err != nil {
+		b.Fatal(err)
+	}
+	var names []string
+	for _, tc := range loadStripTests() {
+		names = append(names, tc.Name)
+	}
+	b.Run("Parse", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, name := range names {
+				if _, err := p.Parse(name); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("ParseColumn", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := p.ParseColumn(names); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
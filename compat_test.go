@@ -0,0 +1,117 @@
+package gocd
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"testing"
+)
+
+var compatReport = flag.String("compat-report", "",
+	"write a JSON upstream compatibility report to this file")
+
+// CompatCase is the compatibility report entry for a single upstream
+// test case
+type CompatCase struct {
+	Name          string   `json:"name"`
+	Status        string   `json:"status"`   // "pass" or "fail"
+	Upstream      string   `json:"upstream"` // "active", "skip", "skip_unless_lang", or "mid"
+	Failures      []string `json:"failures,omitempty"`
+	ShortName     string   `json:"short_name"`
+	Designator    string   `json:"designator"`
+	DesignatorStd string   `json:"designator_std"`
+	Position      string   `json:"position"`
+}
+
+// CompatReport summarises how gocd compares to the upstream Perl
+// Business::CompanyDesignator test corpus
+type CompatReport struct {
+	Total  int          `json:"total"`
+	Passed int          `json:"passed"`
+	Failed int          `json:"failed"`
+	Cases  []CompatCase `json:"cases"`
+}
+
+func compatCase(p *Parser, tc TestCase) (CompatCase, error) {
+	cc := CompatCase{Name: tc.Name, Upstream: "active"}
+	switch {
+	case tc.Skip:
+		cc.Upstream = "skip"
+	case tc.SkipUnlessLang:
+		cc.Upstream = "skip_unless_lang"
+	case tc.Position == "mid":
+		cc.Upstream = "mid"
+	}
+
+	res, err := p.Parse(tc.Name)
+	if tc.SkipUnlessLang {
+		res, err = p.ParseLang(tc.Name, tc.Lang)
+	}
+	if err != nil {
+		return cc, err
+	}
+	cc.ShortName = res.ShortName
+	cc.Designator = res.Designator
+	cc.DesignatorStd = res.DesignatorStd
+	cc.Position = res.Position.String()
+
+	short := tc.Before
+	if short == "" {
+		short = tc.After
+	}
+	if short == "" {
+		short = tc.Name
+	}
+	check := func(field, expected, got string) {
+		if expected != got {
+			cc.Failures = append(cc.Failures, field)
+		}
+	}
+	check("short_name", short, cc.ShortName)
+	check("designator", tc.Designator, cc.Designator)
+	check("designator_std", tc.DesignatorStd, cc.DesignatorStd)
+	check("position", tc.Position, cc.Position)
+
+	cc.Status = "pass"
+	if len(cc.Failures) > 0 {
+		cc.Status = "fail"
+	}
+	return cc, nil
+}
+
+// TestUpstreamCompat runs the full upstream test corpus, including the
+// cases skipped elsewhere, and reports (rather than fails on) any
+// differences. Use -compat-report to write a machine-readable report.
+func TestUpstreamCompat(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := CompatReport{}
+	for _, tc := range loadTests() {
+		cc, err := compatCase(p, tc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		report.Total++
+		if cc.Status == "pass" {
+			report.Passed++
+		} else {
+			report.Failed++
+		}
+		report.Cases = append(report.Cases, cc)
+	}
+	t.Logf("upstream compatibility: %d/%d passed", report.Passed, report.Total)
+
+	if *compatReport != "" {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(*compatReport, append(data, '\n'), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
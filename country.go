@@ -0,0 +1,286 @@
+package gocd
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EntityKind is a kind of legal entity, for DesignatorsFor
+type EntityKind int
+
+const (
+	PrivateLimited              EntityKind = iota + 1 // Private limited company e.g. Ltd, GmbH, LLC
+	PublicLimited                                     // Public limited company e.g. PLC, AG, S.A.
+	Corporation                                       // Corporation e.g. Inc., Corp.
+	LimitedPartnership                                // Limited partnership e.g. L.P., KG
+	GeneralPartnership                                // General partnership e.g. OHG, SNC
+	LimitedLiabilityPartnership                       // Limited liability partnership e.g. LLP
+	Cooperative                                       // Cooperative e.g. Coop., e.G.
+	SoleProprietor                                    // Sole proprietor e.g. e.K., ИП
+)
+
+var entityKindNames = [...]string{
+	"", "private_limited", "public_limited", "corporation", "limited_partnership",
+	"general_partnership", "limited_liability_partnership", "cooperative",
+	"sole_proprietor",
+}
+
+func (k EntityKind) String() string {
+	if k < PrivateLimited || k > SoleProprietor {
+		return fmt.Sprintf("EntityKind(%d)", int(k))
+	}
+	return entityKindNames[k]
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (k EntityKind) MarshalText() ([]byte, error) {
+	if k < PrivateLimited || k > SoleProprietor {
+		return nil, fmt.Errorf("invalid EntityKind %d", int(k))
+	}
+	return []byte(k.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (k *EntityKind) UnmarshalText(text []byte) error {
+	for t := PrivateLimited; t <= SoleProprietor; t++ {
+		if t.String() == string(text) {
+			*k = t
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid EntityKind %q", text)
+}
+
+// CountryForms maps ISO 3166-1 alpha-2 country codes and entity kinds to
+// the long names of the dataset entries for the conventional forms, most
+// common first
+var CountryForms = map[string]map[EntityKind][]string{
+	"AL": {
+		PrivateLimited: {"Shoqeri me pergjegjesi te kufizuar"},
+		PublicLimited:  {"Shoqeri Aksionere"},
+	},
+	"AT": {
+		PrivateLimited:     {"Gesellschaft mit beschränkter Haftung"},
+		PublicLimited:      {"Aktiengesellschaft"},
+		LimitedPartnership: {"Kommanditgesellschaft"},
+		GeneralPartnership: {"offene Gesellschaft"},
+		SoleProprietor:     {"eingetragenes Einzelunternehmen"},
+	},
+	"AU": {
+		PrivateLimited: {"Proprietary Limited"},
+		PublicLimited:  {"Limited"},
+	},
+	"BE": {
+		PrivateLimited: {"Besloten vennootschap met beperkte aansprakelijkheid",
+			"Société privée à responsabilité limitée"},
+		PublicLimited: {"Naamloze vennootschap", "Société anonyme"},
+	},
+	"BG": {
+		PrivateLimited: {"Дружество с Ограничена Отговорност"},
+		PublicLimited:  {"Акционерно дружество"},
+	},
+	"BR": {
+		PrivateLimited: {"Sociedade limitada"},
+		PublicLimited:  {"Sociedade anônima"},
+	},
+	"CH": {
+		PrivateLimited:     {"Gesellschaft mit beschränkter Haftung"},
+		PublicLimited:      {"Aktiengesellschaft"},
+		LimitedPartnership: {"Kommanditgesellschaft"},
+		GeneralPartnership: {"Kollektivgesellschaft"},
+	},
+	"CN": {
+		PrivateLimited:     {"有限责任公司"},
+		PublicLimited:      {"股份有限公司"},
+		LimitedPartnership: {"有限合伙企业"},
+	},
+	"CZ": {
+		PrivateLimited: {"Společnost s ručením omezeným"},
+	},
+	"DE": {
+		PrivateLimited:     {"Gesellschaft mit beschränkter Haftung"},
+		PublicLimited:      {"Aktiengesellschaft"},
+		LimitedPartnership: {"Kommanditgesellschaft"},
+		GeneralPartnership: {"offene Handelsgesellschaft"},
+		Cooperative:        {"eingetragene Genossenschaft"},
+		SoleProprietor:     {"eingetragener Kaufmann"},
+	},
+	"DK": {
+		PrivateLimited:     {"Anpartsselskab"},
+		PublicLimited:      {"Aktieselskab"},
+		LimitedPartnership: {"Kommanditselskab"},
+	},
+	"ES": {
+		PrivateLimited:     {"Sociedad Limitada"},
+		PublicLimited:      {"Sociedad Anónima"},
+		LimitedPartnership: {"Sociedad Comanditaria"},
+		GeneralPartnership: {"Sociedad Colectiva"},
+		Cooperative:        {"Sociedad Cooperativa"},
+	},
+	"FI": {
+		PrivateLimited: {"Osakeyhtiö"},
+		PublicLimited:  {"Julkinen osakeyhtiö"},
+	},
+	"FR": {
+		PrivateLimited:     {"Société à responsabilité limitée", "Société par actions simplifiée"},
+		PublicLimited:      {"Société anonyme"},
+		LimitedPartnership: {"Société en commandite simple"},
+		GeneralPartnership: {"Société en nom collectif"},
+	},
+	"GB": {
+		PrivateLimited:              {"Limited"},
+		PublicLimited:               {"Public Limited Company"},
+		LimitedPartnership:          {"Limited Partnership"},
+		LimitedLiabilityPartnership: {"Limited Liability Partnership"},
+	},
+	"HU": {
+		PrivateLimited:     {"Korlátolt Felelősségű Társaság"},
+		PublicLimited:      {"Nyilvánosan Működő Részvénytársaság"},
+		LimitedPartnership: {"Betéti Társasá"},
+		GeneralPartnership: {"Közkereseti Társaság"},
+	},
+	"ID": {
+		PrivateLimited: {"Perseroan Terbatas"},
+		PublicLimited:  {"Perseroan Terbatas Terbuka"},
+	},
+	"IE": {
+		PrivateLimited:     {"Limited"},
+		PublicLimited:      {"Public Limited Company"},
+		LimitedPartnership: {"Limited Partnership"},
+	},
+	"IN": {
+		PrivateLimited:              {"Private Limited"},
+		PublicLimited:               {"Limited"},
+		LimitedLiabilityPartnership: {"Limited Liability Partnership"},
+	},
+	"IS": {
+		PrivateLimited:     {"einkahlutafélag"},
+		PublicLimited:      {"hlutafélag"},
+		GeneralPartnership: {"sameignarfélag"},
+	},
+	"IT": {
+		PrivateLimited: {"Società a responsabilità limitata"},
+		PublicLimited:  {"Società per Azioni"},
+		Cooperative:    {"Società cooperativa a responsabilità limitata"},
+	},
+	"JP": {
+		PrivateLimited:     {"株式会社", "合同会社"},
+		PublicLimited:      {"株式会社"},
+		LimitedPartnership: {"合資会社"},
+		GeneralPartnership: {"合名会社"},
+	},
+	"KR": {
+		PrivateLimited:     {"유한회사"},
+		PublicLimited:      {"주식회사"},
+		LimitedPartnership: {"합자회사"},
+		GeneralPartnership: {"합명회사"},
+	},
+	"LU": {
+		PrivateLimited: {"Société à responsabilité limitée"},
+		PublicLimited:  {"Société anonyme"},
+	},
+	"LV": {
+		PrivateLimited: {"SIA"},
+		PublicLimited:  {"Akciju sabiedrība"},
+	},
+	"MX": {
+		PrivateLimited: {"Sociedad de Resposabilidad Limitada"},
+		PublicLimited:  {"Sociedad Anónima", "Sociedad Anónima Bursátil de Capital Variable"},
+	},
+	"MY": {
+		PrivateLimited: {"Sendirian Berhad"},
+		PublicLimited:  {"Berhad"},
+	},
+	"NL": {
+		PrivateLimited:     {"Besloten vennootschap"},
+		PublicLimited:      {"Naamloze vennootschap"},
+		LimitedPartnership: {"Commanditaire vennootschap"},
+		GeneralPartnership: {"Vennootschap onder firma"},
+	},
+	"NO": {
+		PrivateLimited: {"Aksjeselskap"},
+		PublicLimited:  {"Allmennaksjeselskap"},
+	},
+	"NZ": {
+		PrivateLimited: {"Limited"},
+		PublicLimited:  {"Limited"},
+	},
+	"PL": {
+		PrivateLimited:     {"spółka z ograniczoną odpowiedzialnością"},
+		PublicLimited:      {"spółka akcyjna"},
+		LimitedPartnership: {"spółka komandytowa"},
+		GeneralPartnership: {"spółka jawna"},
+	},
+	"PT": {
+		PrivateLimited: {"Sociedade limitada"},
+		PublicLimited:  {"Sociedade anônima"},
+		Cooperative:    {"Cooperativa de Responsabilidade Limitada"},
+	},
+	"RU": {
+		PrivateLimited: {"Общество с ограниченной ответственностью"},
+		PublicLimited:  {"Публичное акционерное общество"},
+		SoleProprietor: {"Индивидуальный предприниматель"},
+	},
+	"SE": {
+		PrivateLimited:     {"Aktiebolag"},
+		PublicLimited:      {"Aktiebolag"},
+		LimitedPartnership: {"Kommanditbolag"},
+		GeneralPartnership: {"Handelsbolag"},
+	},
+	"SG": {
+		PrivateLimited: {"Private Limited"},
+		PublicLimited:  {"Limited"},
+	},
+	"SI": {
+		PrivateLimited:     {"Družba z omejeno odgovornostjo"},
+		PublicLimited:      {"Delniška družba"},
+		LimitedPartnership: {"Komanditna družba"},
+		GeneralPartnership: {"Družba z neomejeno odgovornostjo"},
+		SoleProprietor:     {"Samostojni podjetnik"},
+	},
+	"TR": {
+		PrivateLimited:     {"Limited Şirket"},
+		PublicLimited:      {"Anonim Şirket"},
+		LimitedPartnership: {"Komandit Şirket"},
+		GeneralPartnership: {"Kolektif Şirket"},
+		Cooperative:        {"Kooperatif Şirket"},
+	},
+	"US": {
+		PrivateLimited:              {"Limited Liability Company"},
+		Corporation:                 {"Incorporated", "Corporation"},
+		LimitedPartnership:          {"Limited Partnership"},
+		LimitedLiabilityPartnership: {"Limited Liability Partnership"},
+		Cooperative:                 {"Cooperative"},
+	},
+}
+
+// DesignatorsFor returns the conventional designators for an entity of
+// the given kind in country (an ISO 3166-1 alpha-2 code e.g. "DE"), most
+// common first, or nil if none are known. Each form is given by its
+// standard abbreviation (or first abbreviation), followed by its long
+// name e.g. ["GmbH", "Gesellschaft mit beschränkter Haftung"] for DE and
+// PrivateLimited.
+func (p *Parser) DesignatorsFor(country string, kind EntityKind) []string {
+	var designators []string
+	seen := make(map[string]bool)
+	add := func(des string) {
+		if !seen[des] {
+			seen[des] = true
+			designators = append(designators, des)
+		}
+	}
+	for _, long := range CountryForms[strings.ToUpper(country)][kind] {
+		e, exists := (*p.ds)[long]
+		if !exists {
+			continue
+		}
+		switch {
+		case e.AbbrStd != "":
+			add(e.AbbrStd)
+		case len(e.Abbr) > 0:
+			add(e.Abbr[0])
+		}
+		add(long)
+	}
+	return designators
+}
@@ -0,0 +1,61 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDesignatorsFor(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		country  string
+		kind     EntityKind
+		expected []string
+	}{
+		{"DE", PrivateLimited, []string{"GmbH", "Gesellschaft mit beschränkter Haftung"}},
+		{"de", PublicLimited, []string{"AG", "Aktiengesellschaft"}},
+		{"GB", PrivateLimited, []string{"Ltd.", "Limited"}},
+		{"GB", PublicLimited, []string{"plc", "Public Limited Company"}},
+		{"US", Corporation, []string{"Inc.", "Incorporated", "Corp.", "Corporation"}},
+		{"US", PrivateLimited, []string{"LLC", "Limited Liability Company"}},
+		{"CN", PublicLimited, []string{"股份有限公司"}},
+		{"DE", LimitedLiabilityPartnership, nil},
+		{"XX", PrivateLimited, nil},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, p.DesignatorsFor(tc.country, tc.kind),
+			"DesignatorsFor matches for %s %s", tc.country, tc.kind)
+	}
+
+	// All CountryForms are dataset entries
+	for country, forms := range CountryForms {
+		for kind, longs := range forms {
+			for _, long := range longs {
+				_, exists := (*p.ds)[long]
+				assert.True(t, exists, "CountryForms %s %s entry %q exists", country, kind, long)
+			}
+		}
+	}
+}
+
+func TestEntityKindText(t *testing.T) {
+	for k := PrivateLimited; k <= SoleProprietor; k++ {
+		text, err := k.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded EntityKind
+		err = decoded.UnmarshalText(text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, k, decoded, "EntityKind round trip matches")
+	}
+	var k EntityKind
+	assert.Error(t, k.UnmarshalText([]byte("bogus")), "invalid EntityKind errors")
+}
@@ -0,0 +1,114 @@
+/*
+csvbatch provides streaming CSV batch processing for gocd, parsing
+a company name column and appending parsed result columns to each
+record.
+*/
+package csvbatch
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/ProfoundNetworks/gocd"
+)
+
+// DefaultColumns are the result columns appended to each record if
+// Options.Columns is empty
+var DefaultColumns = []string{
+	"short_name", "designator", "designator_std", "position",
+}
+
+// Options configures Process
+type Options struct {
+	NameColumn string   // Header of the column containing names (requires a header)
+	NameIndex  int      // Zero-based index of the name column, if NameColumn is empty
+	NoHeader   bool     // Input has no header record (and none is written)
+	Comma      rune     // Field delimiter, defaults to ','
+	Columns    []string // Result fields to append (see gocd.Fields), defaults to DefaultColumns
+}
+
+// Process streams CSV records from r to w, parsing the configured name
+// column of each record with p and appending the configured result
+// columns. Records are processed one at a time, so arbitrarily large
+// inputs can be handled in constant memory.
+func Process(p *gocd.Parser, w io.Writer, r io.Reader, opts Options) error {
+	columns := opts.Columns
+	if len(columns) == 0 {
+		columns = DefaultColumns
+	}
+	for _, col := range columns {
+		if _, ok := (&gocd.Result{}).Field(col); !ok {
+			return fmt.Errorf("unknown result column %q", col)
+		}
+	}
+	if opts.NameColumn != "" && opts.NoHeader {
+		return fmt.Errorf("NameColumn requires a header record")
+	}
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+	cw := csv.NewWriter(w)
+	if opts.Comma != 0 {
+		cr.Comma = opts.Comma
+		cw.Comma = opts.Comma
+	}
+
+	nameIndex := opts.NameIndex
+	if !opts.NoHeader {
+		header, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if opts.NameColumn != "" {
+			nameIndex = -1
+			for i, h := range header {
+				if h == opts.NameColumn {
+					nameIndex = i
+					break
+				}
+			}
+			if nameIndex < 0 {
+				return fmt.Errorf("name column %q not found in header", opts.NameColumn)
+			}
+		}
+		if err := cw.Write(append(header, columns...)); err != nil {
+			return err
+		}
+	}
+	if nameIndex < 0 {
+		return fmt.Errorf("invalid name column index %d", nameIndex)
+	}
+
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		res := &gocd.Result{}
+		if nameIndex < len(record) {
+			res, err = p.Parse(record[nameIndex])
+			if err != nil {
+				return err
+			}
+		}
+		for _, col := range columns {
+			val, _ := res.Field(col)
+			record = append(record, val)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,69 @@
+package csvbatch
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ProfoundNetworks/gocd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcess(t *testing.T) {
+	tests := []struct {
+		input    string
+		opts     Options
+		expected string
+	}{
+		{
+			"id,company\n1,Profound Networks LLC\n2,\"Acme, Widgets & Sons\"\n",
+			Options{NameColumn: "company"},
+			"id,company,short_name,designator,designator_std,position\n" +
+				"1,Profound Networks LLC,Profound Networks,LLC,LLC,end\n" +
+				"2,\"Acme, Widgets & Sons\",\"Acme, Widgets & Sons\",,,none\n",
+		},
+		{
+			"Open Fusion Pty Ltd\t1\n",
+			Options{NoHeader: true, Comma: '\t', Columns: []string{"short_name", "matched"}},
+			"Open Fusion Pty Ltd\t1\tOpen Fusion\ttrue\n",
+		},
+		{
+			"id,company\n1\n",
+			Options{NameIndex: 1, Columns: []string{"short_name"}},
+			"id,company,short_name\n1,\n",
+		},
+	}
+
+	p, err := gocd.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range tests {
+		var buf bytes.Buffer
+		err := Process(p, &buf, strings.NewReader(tc.input), tc.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.expected, buf.String(), "Process output matches")
+	}
+}
+
+func TestProcessErrors(t *testing.T) {
+	p, err := gocd.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []Options{
+		{NameColumn: "missing"},
+		{NameColumn: "company", NoHeader: true},
+		{Columns: []string{"bogus"}},
+		{NameIndex: -1},
+	}
+	for _, opts := range tests {
+		var buf bytes.Buffer
+		err := Process(p, &buf, strings.NewReader("id,company\n1,Acme Ltd\n"), opts)
+		assert.Error(t, err, "Process errors with %+v", opts)
+	}
+}
@@ -0,0 +1,104 @@
+package gocd
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+)
+
+// init finalises the entries in a freshly unmarshalled ds, from the
+// dataset layer source with the given priority
+func (ds *dataset) init(source string, priority int) {
+	for long, e := range *ds {
+		if e == nil {
+			e = &Entry{}
+			(*ds)[long] = e
+		}
+		e.LongName = long
+		e.Public = e.Public || PublicForms[long]
+		e.initAmbiguity()
+		e.Source = source
+		e.priority = priority
+	}
+}
+
+// merge unmarshals the YAML dataset data for the layer source and merges
+// its entries into ds, replacing any existing entries with the same long
+// name and no higher priority
+func (ds *dataset) merge(data []byte, source string, priority int) error {
+	overlay := make(dataset)
+	err := yaml.Unmarshal(data, overlay)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrDatasetParse, source, err)
+	}
+	overlay.init(source, priority)
+	for long, e := range overlay {
+		if existing, exists := (*ds)[long]; exists && existing.priority > priority {
+			continue
+		}
+		(*ds)[long] = e
+	}
+	return nil
+}
+
+// validate checks ds for entries we can't compile
+func (ds *dataset) validate() error {
+	for long, e := range *ds {
+		if err := e.validateAmbiguity(); err != nil {
+			return err
+		}
+		for _, a := range e.AbbrRE {
+			re, err := regexp.Compile(a)
+			if err != nil {
+				return fmt.Errorf("%w: invalid abbr_re %q for %q: %v", ErrDatasetParse, a, long, err)
+			}
+			// Capturing groups would break our match indices
+			if re.NumSubexp() > 0 {
+				return fmt.Errorf("%w: invalid abbr_re %q for %q: capturing groups not supported",
+					ErrDatasetParse, a, long)
+			}
+		}
+	}
+	return nil
+}
+
+// Designators returns copies of all the Parser's dataset entries
+// (including any layers and overlays), sorted by long name
+func (p *Parser) Designators() []Entry {
+	return p.designators(func(e *Entry) bool { return true })
+}
+
+// DesignatorsForLang returns copies of the dataset entries for language
+// lang (an ISO 639-1 code e.g. "de"), sorted by long name
+func (p *Parser) DesignatorsForLang(lang string) []Entry {
+	return p.designators(func(e *Entry) bool { return e.Lang == lang })
+}
+
+// designators returns copies of the dataset entries for which keep
+// returns true, sorted by long name
+func (p *Parser) designators(keep func(*Entry) bool) []Entry {
+	var entries []Entry
+	for _, e := range *p.ds {
+		if keep(e) {
+			entries = append(entries, *e)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].LongName < entries[j].LongName
+	})
+	return entries
+}
+
+// filter returns a new dataset containing the entries in ds for which
+// keep returns true
+func (ds *dataset) filter(keep func(*Entry) bool) *dataset {
+	filtered := make(dataset)
+	for long, e := range *ds {
+		if keep(e) {
+			filtered[long] = e
+		}
+	}
+	return &filtered
+}
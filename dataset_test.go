@@ -0,0 +1,93 @@
+package gocd
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDesignatorsForLang(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := p.DesignatorsForLang("de")
+	var longs []string
+	for _, e := range entries {
+		assert.Equal(t, "de", e.Lang, "Lang matches")
+		longs = append(longs, e.LongName)
+	}
+	assert.Contains(t, longs, "Gesellschaft mit beschränkter Haftung", "de includes GmbH")
+	assert.Contains(t, longs, "Aktiengesellschaft", "de includes AG")
+	assert.IsIncreasing(t, longs, "entries sorted")
+
+	assert.Empty(t, p.DesignatorsForLang("xx"), "unknown lang has no entries")
+}
+
+func TestDesignators(t *testing.T) {
+	p, err := New(WithLayer(NonprofitLayer))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := p.Designators()
+	assert.Len(t, entries, len(*p.ds), "all entries returned")
+	longs := make([]string, len(entries))
+	langs := make(map[string]bool)
+	for i, e := range entries {
+		longs[i] = e.LongName
+		langs[e.Lang] = true
+	}
+	assert.IsIncreasing(t, longs, "entries sorted")
+	assert.Contains(t, longs, "Limited Liability Company", "includes LLC")
+	assert.True(t, langs["en"] && langs["de"] && langs["ru"], "includes multiple langs")
+
+	entries[0].Abbr = nil
+	assert.NotEqual(t, entries[0], *(*p.ds)[entries[0].LongName], "entries are copies")
+}
+
+func TestDatasetEnv(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dataset.yml")
+	err := ioutil.WriteFile(path, []byte(`
+Widgetschaft:
+  abbr:
+    - WSch
+  lang: de
+`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(DatasetEnv, path)
+
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := p.Parse("Acme WSch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "WSch", res.Designator, "GOCD_DATASET designator matches")
+	res, err = p.Parse("Acme GmbH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, res.Matched, "GOCD_DATASET replaces default dataset")
+
+	p, err = New(WithoutDatasetEnv())
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err = p.Parse("Acme GmbH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, res.Matched, "WithoutDatasetEnv uses default dataset")
+
+	t.Setenv(DatasetEnv, filepath.Join(t.TempDir(), "missing.yml"))
+	_, err = New()
+	assert.ErrorIs(t, err, ErrDatasetOpen, "missing GOCD_DATASET file errors")
+}
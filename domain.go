@@ -0,0 +1,102 @@
+package gocd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidDomain is returned by ParseDomain for input with no name
+// label
+var ErrInvalidDomain = errors.New("invalid domain name")
+
+// secondLevelDomains are generic second-level labels used under country
+// code TLDs (e.g. "co" in "acme.co.uk"), which are stripped along with
+// the TLD
+var secondLevelDomains = map[string]bool{
+	"ac": true, "biz": true, "co": true, "com": true, "edu": true,
+	"gen": true, "go": true, "gob": true, "gov": true, "info": true,
+	"ltd": true, "ne": true, "net": true, "or": true, "org": true,
+	"plc": true,
+}
+
+// domainLabel returns the name label of domain, which may be a URL:
+// the label preceding the TLD and any generic second-level label, with
+// any scheme, port, path, and subdomains removed
+func domainLabel(domain string) string {
+	s := strings.TrimSpace(domain)
+	if i := strings.Index(s, "://"); i >= 0 {
+		s = s[i+3:]
+	}
+	if i := strings.IndexAny(s, "/?#"); i >= 0 {
+		s = s[:i]
+	}
+	if i := strings.LastIndexByte(s, '@'); i >= 0 {
+		s = s[i+1:]
+	}
+	if i := strings.IndexByte(s, ':'); i >= 0 {
+		s = s[:i]
+	}
+	labels := strings.Split(strings.TrimSuffix(s, "."), ".")
+	if len(labels) < 2 {
+		return ""
+	}
+	labels = labels[:len(labels)-1]
+	if len(labels) > 1 && secondLevelDomains[strings.ToLower(labels[len(labels)-1])] {
+		labels = labels[:len(labels)-1]
+	}
+	return labels[len(labels)-1]
+}
+
+// splitLabel splits the domain label into words at hyphens, underscores,
+// and camel case boundaries. A word boundary is inferred before an
+// uppercase letter following a lowercase one if it begins a capitalised
+// word (e.g. "AcmeWidgets") or an uppercase run of two or more letters
+// ending the label (e.g. "AcmeAG"), so that mixed case designators such
+// as "GmbH" are kept intact.
+func splitLabel(label string) string {
+	rs := []rune(label)
+	var b strings.Builder
+	for i, r := range rs {
+		if r == '-' || r == '_' {
+			b.WriteByte(' ')
+			continue
+		}
+		if i > 0 && unicode.IsUpper(r) && unicode.IsLower(rs[i-1]) {
+			rest := rs[i+1:]
+			if len(rest) > 0 && (unicode.IsLower(rest[0]) || allUpper(rest)) {
+				b.WriteByte(' ')
+			}
+		}
+		b.WriteRune(r)
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// allUpper returns true if rs consists entirely of uppercase letters
+func allUpper(rs []rune) bool {
+	for _, r := range rs {
+		if !unicode.IsUpper(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseDomain infers a company name from a domain name or URL (e.g.
+// "acme-gmbh.de", "https://www.AcmeWidgets.co.uk/about") and parses it.
+// The TLD, any generic second-level label (e.g. "co" in ".co.uk"), and
+// any subdomains are stripped, and the remaining label split into words
+// at hyphens, underscores, and camel case boundaries, so that e.g.
+// "acme-gmbh.de" gives ShortName "acme" and Designator "gmbh". Result
+// Input is the inferred name. Internationalised domains should be
+// decoded from punycode first. Returns an ErrInvalidDomain error if
+// domain has no name label.
+func (p *Parser) ParseDomain(domain string) (*Result, error) {
+	name := splitLabel(domainLabel(domain))
+	if name == "" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidDomain, domain)
+	}
+	return p.Parse(name)
+}
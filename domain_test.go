@@ -0,0 +1,44 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDomain(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		domain     string
+		input      string
+		shortName  string
+		designator string
+	}{
+		{"acme-gmbh.de", "acme gmbh", "acme", "gmbh"},
+		{"acme-widgets-ltd.co.uk", "acme widgets ltd", "acme widgets", "ltd"},
+		{"https://www.AcmeWidgetsGmbH.de/impressum?lang=en", "Acme Widgets GmbH", "Acme Widgets", "GmbH"},
+		{"AcmeAG.ch", "Acme AG", "Acme", "AG"},
+		{"shop.acme_llc.com.", "acme llc", "acme", "llc"},
+		{"http://user@acme-sa.com.br:8080", "acme sa", "acme", "sa"},
+		{"acme.co", "acme", "acme", ""},
+		{"acmewidgets.com", "acmewidgets", "acmewidgets", ""},
+	}
+	for _, tc := range tests {
+		res, err := p.ParseDomain(tc.domain)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.input, res.Input, "Input matches for %q", tc.domain)
+		assert.Equal(t, tc.shortName, res.ShortName, "ShortName matches for %q", tc.domain)
+		assert.Equal(t, tc.designator, res.Designator, "Designator matches for %q", tc.domain)
+	}
+
+	for _, bad := range []string{"", "localhost", "https://", "-.com"} {
+		_, err := p.ParseDomain(bad)
+		assert.ErrorIs(t, err, ErrInvalidDomain, "ParseDomain errors for %q", bad)
+	}
+}
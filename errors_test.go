@@ -0,0 +1,12 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMustNew(t *testing.T) {
+	assert.NotPanics(t, func() { MustNew() }, "MustNew ok")
+	assert.Panics(t, func() { MustNew(WithOverlay([]byte("X: ["))) }, "MustNew panics on error")
+}
@@ -0,0 +1,48 @@
+package gocd
+
+// Explanation describes how Parse arrived at a Result, for debugging
+// surprising matches
+type Explanation struct {
+	Result       *Result        // The parse Result (including the matched dataset Entry)
+	Preprocessed string         // The input after normalisation and cleanup, as matched
+	Tried        []PositionType // The passes run against the input, in order
+	Pass         PositionType   // The pass that matched (None if no match)
+	Variant      string         // The dataset designator (long name or abbreviation) matched
+	VariantRegex bool           // True if Variant is a regex (abbr_re) abbreviation
+	Groups       []string       // The groups captured by the matching pass regex
+}
+
+// Explain parses input like Parse, additionally reporting which pass
+// fired, which dataset designator variant matched, and the captured
+// regex groups. Explain bypasses any result cache.
+func (p *Parser) Explain(input string) (*Explanation, error) {
+	ex := &Explanation{Result: &Result{}}
+	err := p.parse(nil, []byte(input), "", ex.Result, ex)
+	if err != nil {
+		return nil, err
+	}
+	return ex, nil
+}
+
+// preprocessed records the preprocessed input in ex, if non-nil
+func (ex *Explanation) preprocessed(in []byte) {
+	if ex == nil {
+		return
+	}
+	ex.Preprocessed = nfc(in)
+}
+
+// tried records a pass t run with the resulting matches in ex, if non-nil
+func (ex *Explanation) tried(t PositionType, matches [][]byte) {
+	if ex == nil {
+		return
+	}
+	ex.Tried = append(ex.Tried, t)
+	if matches == nil {
+		return
+	}
+	ex.Groups = make([]string, len(matches))
+	for i, m := range matches {
+		ex.Groups[i] = nfc(m)
+	}
+}
@@ -0,0 +1,48 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplain(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ex, err := p.Explain("Acme Widgets Pty. Ltd.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, ex.Result.Matched, "Matched")
+	assert.Equal(t, End, ex.Pass, "Pass matches")
+	assert.Equal(t, []PositionType{End}, ex.Tried, "Tried matches")
+	assert.Equal(t, "Pty. Ltd.", ex.Variant, "Variant matches")
+	assert.False(t, ex.VariantRegex, "Variant not regex")
+	assert.Equal(t, "Pty. Ltd.", ex.Groups[3], "designator group matches")
+	assert.Equal(t, "Acme Widgets", ex.Groups[1], "short name group matches")
+
+	ex, err = p.Explain("ООО Ромашка")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, Begin, ex.Pass, "Pass matches")
+	assert.Equal(t, "ООО", ex.Variant, "Variant matches")
+	assert.Equal(t, Begin, ex.Tried[len(ex.Tried)-1], "Begin pass tried last")
+
+	ex, err = p.Explain("P .J . S . C Acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "P. J. S. C Acme", ex.Preprocessed, "Preprocessed matches")
+
+	ex, err = p.Explain("Acme Widgets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, ex.Result.Matched, "not Matched")
+	assert.Equal(t, None, ex.Pass, "Pass is None")
+	assert.Nil(t, ex.Groups, "no Groups")
+}
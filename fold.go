@@ -0,0 +1,48 @@
+package gocd
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Go's regexp (?i) matching uses simple case folding, which maps
+// characters one-to-one, so spellings that differ by a full case folding
+// (e.g. German "ß" and "ss") don't match. We handle these explicitly:
+// SharpSTransform makes designator patterns match either spelling, and
+// foldSpecial/appendFold apply the same folding to index keys and
+// prefilter tokens, so either spelling in input maps back to the entry.
+//
+// Greek sigma has a distinct final form ("ς"), which regexp and foldRune
+// fold together with "σ" and "Σ", but strings.ToLower does not produce
+// (lowercasing "Σ" always gives "σ"), so foldSpecial also folds final
+// sigma, so that e.g. "ΕΤΑΙΡΕΙΑ ΠΕΡΙΟΡΙΣΜΕΝΗΣ ΕΥΘΥΝΗΣ" and "Εταιρεία
+// Περιορισμένης Ευθύνης" share index keys.
+
+// reDesSharpS matches the spellings of sharp s in a designator
+var reDesSharpS = regexp.MustCompile(`(?i)ss|[ßẞ]`)
+
+// specialFolder folds sharp s (and capital sharp s) to "ss", and Greek
+// final sigma to sigma
+var specialFolder = strings.NewReplacer("ß", "ss", "ẞ", "ss", "ς", "σ")
+
+// SharpSTransform allows German sharp s ("ß", or capital "ẞ") and "ss"
+// to match each other, e.g. for traditional and reformed spellings
+func SharpSTransform(des string) string {
+	return reDesSharpS.ReplaceAllString(des, `(?:ss|ß)`)
+}
+
+// foldSpecial returns the lowercase s with the full case foldings
+// regexp doesn't handle applied
+func foldSpecial(s string) string {
+	return specialFolder.Replace(s)
+}
+
+// appendFoldRune appends the token key form of the folded rune r
+// (see foldRune) to dst
+func appendFoldRune(dst []byte, r rune) []byte {
+	if r == 'ß' {
+		return append(dst, "ss"...)
+	}
+	return utf8.AppendRune(dst, r)
+}
@@ -0,0 +1,86 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestSharpS(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input string
+		short string
+		des   string
+		std   string
+	}{
+		{"Acme eingetragene Genossenschaft", "Acme", "eingetragene Genossenschaft", "eingetragene Genossenschaft"},
+		{"Acme eingetragene Genoßenschaft", "Acme", "eingetragene Genoßenschaft", "eingetragene Genossenschaft"},
+		{"ACME EINGETRAGENE GENOẞENSCHAFT", "ACME", "EINGETRAGENE GENOẞENSCHAFT", "eingetragene Genossenschaft"},
+		{"ACME EINGETRAGENE GENOSSENSCHAFT", "ACME", "EINGETRAGENE GENOSSENSCHAFT", "eingetragene Genossenschaft"},
+		{"Acme Anpartsselskab", "Acme", "Anpartsselskab", "Anpartsselskab"},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, res.Matched, "Matched for %q", tc.input)
+		assert.Equal(t, tc.short, res.ShortName, "ShortName matches for %q", tc.input)
+		assert.Equal(t, tc.des, res.Designator, "Designator matches for %q", tc.input)
+		assert.Equal(t, tc.std, res.DesignatorStd, "DesignatorStd matches for %q", tc.input)
+	}
+
+	assert.Equal(t, exactKey("Genossenschaft"), exactKey("GENOẞENSCHAFT"), "exactKey folds sharp s")
+	assert.Equal(t, "genossenschaft", lastToken([]byte("Acme Genoßenschaft")), "lastToken folds sharp s")
+	assert.Equal(t, `Geno(?:ss|ß)enschaft`, SharpSTransform("Genoßenschaft"), "SharpSTransform matches")
+}
+
+func TestGreekSigma(t *testing.T) {
+	overlay := []byte(`
+'Ανώνυμη Εταιρεία':
+  abbr:
+    - 'Α.Ε.'
+  lang: el
+'Εταιρεία Περιορισμένης Ευθύνης':
+  abbr:
+    - 'Ε.Π.Ε.'
+  lang: el
+`)
+	p, err := New(WithOverlay(overlay))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input string
+		short string
+		std   string
+	}{
+		{"Ακμή Εταιρεία Περιορισμένης Ευθύνης", "Ακμή", "Εταιρεία Περιορισμένης Ευθύνης"},
+		{"ΑΚΜΗ ΕΤΑΙΡΕΙΑ ΠΕΡΙΟΡΙΣΜΕΝΗΣ ΕΥΘΥΝΗΣ", "ΑΚΜΗ", "Εταιρεία Περιορισμένης Ευθύνης"},
+		{"ακμή εταιρεία περιορισμένησ ευθύνησ", "ακμή", "Εταιρεία Περιορισμένης Ευθύνης"},
+		{"ΑΚΜΗ Ε.Π.Ε.", "ΑΚΜΗ", "Ε.Π.Ε."},
+		{"Ακμή ε.π.ε.", "Ακμή", "Ε.Π.Ε."},
+		{"ΑΚΜΗ ΑΝΩΝΥΜΗ ΕΤΑΙΡΕΙΑ", "ΑΚΜΗ", "Ανώνυμη Εταιρεία"},
+		{"Ακμή α.ε.", "Ακμή", "Α.Ε."},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, res.Matched, "Matched for %q", tc.input)
+		assert.Equal(t, tc.short, res.ShortName, "ShortName matches for %q", tc.input)
+		assert.Equal(t, tc.std, res.DesignatorStd, "DesignatorStd matches for %q", tc.input)
+	}
+
+	assert.Equal(t, exactKey("Περιορισμένης"), exactKey("ΠΕΡΙΟΡΙΣΜΕΝΗΣ"), "exactKey folds final sigma")
+	assert.Equal(t, lastToken(norm.NFD.Bytes([]byte("Ακμή περιορισμένης"))), lastToken([]byte("ΑΚΜΗ ΠΕΡΙΟΡΙΣΜΕΝΗΣ")),
+		"lastToken folds sigma")
+}
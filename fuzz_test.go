@@ -0,0 +1,66 @@
+package gocd
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// nfdRunes returns the number of runes in the NFD form of s
+func nfdRunes(s string) int {
+	return utf8.RuneCountInString(norm.NFD.String(s))
+}
+
+func FuzzParse(f *testing.F) {
+	for _, tc := range loadTests() {
+		f.Add(tc.Name)
+	}
+	f.Add("")
+	f.Add("\xff\xfe GmbH")
+	f.Add("Acme (UK) Ltd")
+
+	p, err := New()
+	if err != nil {
+		f.Fatal(err)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		res, err := p.Parse(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !res.Matched {
+			if res.ShortName != res.Input || res.Designator != "" || res.Position != None {
+				t.Fatalf("unmatched result has match fields set: %+v", res)
+			}
+			return
+		}
+
+		// ShortName, Designator and Qualifier are all taken from input
+		if res.Designator == "" || res.Position == None {
+			t.Fatalf("matched result missing match fields: %+v", res)
+		}
+		parts := nfdRunes(res.ShortName) + nfdRunes(res.Designator) + nfdRunes(res.Qualifier)
+		if parts > nfdRunes(res.Input) {
+			t.Fatalf("result parts longer than input: %+v", res)
+		}
+
+		// Re-parsing ShortName is stable, and never grows it
+		res2, err := p.Parse(res.ShortName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if nfdRunes(res2.ShortName) > nfdRunes(res.ShortName) {
+			t.Fatalf("re-parsed ShortName %q longer than %q", res2.ShortName, res.ShortName)
+		}
+		res3, err := p.Parse(res.ShortName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *res3 != *res2 {
+			t.Fatalf("re-parsing %q not deterministic: %+v vs %+v", res.ShortName, res2, res3)
+		}
+	})
+}
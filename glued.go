@@ -0,0 +1,72 @@
+package gocd
+
+import (
+	"bytes"
+	"unicode"
+	"unicode/utf8"
+)
+
+// WithGluedDesignators enables a final pass matching designators glued
+// to the end of a Latin-script name without a word break (e.g.
+// "AcmeLtd", "MuellerGmbH"), as found in usernames, domains, and OCR
+// output. The designator must start at a case change within the final
+// token (e.g. "Acme|Ltd", "IBM|GmbH", "Acme2000|Ltd"), and matches are
+// reported with the Glued MatchKind (and the EndGlued pass), so they
+// can be treated more cautiously.
+func WithGluedDesignators() Option {
+	return func(o *options) {
+		o.glued = true
+	}
+}
+
+// matchGlued checks whether the final token of the NFD input in (from
+// src) ends with a designator glued to the name at a case change, and
+// if so fills in res with the End Position, recording the outcome in ex
+func (p *Parser) matchGlued(src source, in []byte, res *Result, ex *Explanation) bool {
+	trimmed := bytes.TrimRightFunc(in, unicode.IsSpace)
+	start := bytes.LastIndexFunc(trimmed, unicode.IsSpace) + 1
+	for _, i := range caseBreaks(trimmed[start:]) {
+		short, des := trimmed[:start+i], trimmed[start+i:]
+		if !p.wholeKeys[looseKey(string(des))] {
+			continue
+		}
+		ex.tried(EndGlued, [][]byte{trimmed, short, des})
+		res.Matched = true
+		res.ShortName = src.str(bytes.TrimSpace(short))
+		res.Designator = src.str(des)
+		res.Position = End
+		return true
+	}
+	ex.tried(EndGlued, nil)
+	return false
+}
+
+// caseBreaks returns the offsets of the case changes in the NFD token
+// tok at which a glued designator could start: uppercase letters
+// following a lowercase letter or digit, or starting a capitalised word
+// after other uppercase letters (e.g. "IBM|GmbH"). Tokens containing
+// non-Latin letters have no breaks.
+func caseBreaks(tok []byte) []int {
+	var breaks []int
+	var prev rune
+	for i := 0; i < len(tok); {
+		r, size := utf8.DecodeRune(tok[i:])
+		if unicode.IsLetter(r) && !unicode.Is(unicode.Latin, r) {
+			return nil
+		}
+		if unicode.Is(unicode.Mn, r) {
+			i += size
+			continue
+		}
+		if unicode.IsUpper(r) && i > 0 {
+			next, _ := utf8.DecodeRune(tok[i+size:])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) ||
+				(unicode.IsUpper(prev) && unicode.IsLower(next)) {
+				breaks = append(breaks, i)
+			}
+		}
+		prev = r
+		i += size
+	}
+	return breaks
+}
@@ -0,0 +1,80 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGluedDesignators(t *testing.T) {
+	p, err := New(WithGluedDesignators())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps, err := New(WithGluedDesignators(), WithSuffixIndex())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input     string
+		shortName string
+		des       string
+		std       string
+	}{
+		{"AcmeLtd", "Acme", "Ltd", "Ltd."},
+		{"MuellerGmbH", "Mueller", "GmbH", "GmbH"},
+		{"MüllerGmbH", "Müller", "GmbH", "GmbH"},
+		{"IBMGmbH", "IBM", "GmbH", "GmbH"},
+		{"Acme2000Ltd", "Acme2000", "Ltd", "Ltd."},
+		{"Tokyo AcmeLtd.", "Tokyo Acme", "Ltd.", "Ltd."},
+	}
+	for _, tc := range tests {
+		for _, parser := range []*Parser{p, ps} {
+			res, err := parser.Parse(tc.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.True(t, res.Matched, "Matched for %q", tc.input)
+			assert.Equal(t, tc.shortName, res.ShortName, "ShortName matches for %q", tc.input)
+			assert.Equal(t, tc.des, res.Designator, "Designator matches for %q", tc.input)
+			assert.Equal(t, tc.std, res.DesignatorStd, "DesignatorStd matches for %q", tc.input)
+			assert.Equal(t, End, res.Position, "Position matches for %q", tc.input)
+			assert.Equal(t, Glued, res.MatchKind, "MatchKind matches for %q", tc.input)
+		}
+	}
+
+	// No case change, or no designator after one
+	for _, input := range []string{"Acmeltd", "ACMELTD", "McDonald", "PayPal"} {
+		res, err := p.Parse(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.False(t, res.Matched, "Matched for %q", input)
+	}
+
+	// Designators with word breaks match as usual
+	res, err := p.Parse("Acme Ltd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, Abbreviation, res.MatchKind, "MatchKind matches for %q", "Acme Ltd")
+
+	ex, err := p.Explain("AcmeLtd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, EndGlued, ex.Pass, "Pass matches")
+	assert.Equal(t, EndGlued, ex.Tried[len(ex.Tried)-1], "EndGlued pass tried last")
+
+	// Not matched by default
+	def, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err = def.Parse("AcmeLtd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, res.Matched, "Matched by default")
+}
@@ -10,9 +10,16 @@ gocd is a go library for matching and parsing company designators
 package gocd
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"io/ioutil"
+	"os"
 	"regexp"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"golang.org/x/text/unicode/norm"
 	"gopkg.in/yaml.v2"
@@ -39,6 +46,11 @@ var EndDesignatorBlacklist = map[string]bool{
 	"Co. L.L.C.":   true, // vs. `& Co. L.L.C.` (ampersand matched as punct)
 }
 
+// DatasetEnv is the environment variable New checks for the path of an
+// external dataset file to use in place of the embedded default dataset
+// (see WithoutDatasetEnv)
+const DatasetEnv = "GOCD_DATASET"
+
 const (
 	DefaultDataset   = "/company_designator.yml"
 	StrBeginBefore   = `^\pZ*`
@@ -49,6 +61,12 @@ const (
 	StrEndContAfter  = `\pZ*$`
 )
 
+var (
+	ErrDatasetOpen    = errors.New("error opening dataset")
+	ErrDatasetParse   = errors.New("error parsing dataset")
+	ErrPatternCompile = errors.New("error compiling designator patterns")
+)
+
 type PositionType int
 
 const (
@@ -58,29 +76,72 @@ const (
 	EndCont
 	Begin
 	BeginFallback
+	Whole    // The entire input is a designator
+	EndGlued // A designator glued to the end of the name (see WithGluedDesignators)
 )
 
 func (p PositionType) String() string {
 	return [...]string{
 		"none", "end", "end_fallback", "end_cont", "begin", "begin_fallback",
+		"whole", "end_glued",
 	}[p]
 }
 
-type entry struct {
-	LongName string
-	AbbrStd  string   `yaml:"abbr_std"`
-	Abbr     []string `yaml:"abbr"`
-	Lang     string   `yaml:"lang"`
-	Lead     bool     `yaml:"lead"`
-	Doc      string   `yaml:"doc"`
+// MarshalText implements encoding.TextMarshaler, so PositionTypes
+// are serialised using their string names
+func (p PositionType) MarshalText() ([]byte, error) {
+	if p < None || p > EndGlued {
+		return nil, fmt.Errorf("invalid PositionType %d", int(p))
+	}
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (p *PositionType) UnmarshalText(text []byte) error {
+	for t := None; t <= EndGlued; t++ {
+		if t.String() == string(text) {
+			*p = t
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid PositionType %q", text)
+}
+
+// Entry is a company designator dataset entry. Entries returned by a
+// Parser are shared, and must not be modified.
+type Entry struct {
+	LongName  string             `yaml:"-" json:"long_name"`                   // The designator long name
+	AbbrStd   string             `yaml:"abbr_std" json:"abbr_std,omitempty"`   // The standard abbreviation, if any
+	Abbr      []string           `yaml:"abbr" json:"abbr,omitempty"`           // Abbreviations
+	AbbrRE    []string           `yaml:"abbr_re" json:"abbr_re,omitempty"`     // Abbreviations given as regular expressions
+	Lang      string             `yaml:"lang" json:"lang"`                     // ISO 639-1 language code
+	Lead      bool               `yaml:"lead" json:"lead"`                     // True if the designator can appear before the name
+	Doc       string             `yaml:"doc" json:"doc,omitempty"`             // Optional documentation
+	LangOnly  bool               `yaml:"lang_only" json:"lang_only,omitempty"` // True if the designator only matches given a language hint
+	Category  string             `yaml:"category" json:"category,omitempty"`   // Optional non-company category e.g. "government"
+	Public    bool               `yaml:"public" json:"public,omitempty"`       // True if the form permits public trading of shares (see PublicForms)
+	Ambiguity map[string]float64 `yaml:"ambiguity" json:"ambiguity,omitempty"` // Abbreviation ambiguity weights (see DefaultAmbiguity)
+	Source    string             `yaml:"-" json:"source"`                      // The dataset layer the entry came from e.g. "core"
+
+	priority int // The priority of the Source layer
 }
 
 type Remap map[string]*regexp.Regexp
-type dataset map[string]entry
+type dataset map[string]*Entry
 
 type Parser struct {
+	opts            options
 	re              Remap
 	ds              *dataset
+	idx             *desIndex
+	lastTokens      map[string]bool
+	wholeKeys       map[string]bool
+	contMask        scriptMask
+	suffix          *suffixIndex
+	patterns        []string
+	shards          []*Parser
+	langs           *langCache
+	cache           *resultCache
 	reEnd           *regexp.Regexp
 	reEndFallback   *regexp.Regexp
 	reEndCont       *regexp.Regexp
@@ -98,48 +159,69 @@ type Context struct {
 }
 
 type Result struct {
-	Input      string       // Initial input string
-	Matched    bool         // True if a Designator was found
-	ShortName  string       // Input with any matched Designator removed
-	Designator string       // The Designator found in input, if any (verbatim)
-	Position   PositionType // The Designator position, if found
+	Input          string       `json:"input"`                      // Initial input string
+	Matched        bool         `json:"matched"`                    // True if a Designator was found
+	ShortName      string       `json:"short_name"`                 // Input with any matched Designator removed
+	ShortNameASCII string       `json:"short_name_ascii,omitempty"` // ShortName folded to ASCII, with WithShortNameASCII
+	Designator     string       `json:"designator"`                 // The Designator found in input, if any (verbatim)
+	DesignatorStd  string       `json:"designator_std"`             // The standardised form of Designator, if found
+	DesignatorLong string       `json:"designator_long"`            // The long form of Designator, if found
+	Position       PositionType `json:"position"`                   // The Designator position, if found
+	MatchKind      MatchKind    `json:"match_kind"`                 // How the Designator was matched, if found
+	Qualifier      string       `json:"qualifier"`                  // Bracketed qualifier preceding an end Designator e.g. "UK" in "Acme (UK) Ltd"
+	Lang           string       `json:"lang"`                       // The language of the matched Designator, if found
+	NameLang       string       `json:"name_lang,omitempty"`        // Heuristic language of the name, with WithNameLang
+	LangMismatch   bool         `json:"lang_mismatch,omitempty"`    // True if the Designator's language conflicts with the name's script, suggesting a false positive
+	Category       string       `json:"category"`                   // The category of the matched Designator, if non-company e.g. "government"
+	Source         string       `json:"source"`                     // The dataset layer of the matched Designator, if found e.g. "core"
+	Public         bool         `json:"public"`                     // True if the matched Designator is a publicly tradable form e.g. PLC, AG
+	Ambiguity      float64      `json:"ambiguity,omitempty"`        // How often the matched Designator occurs as a normal word, from 0 to 1 (see DefaultAmbiguity)
+	Entry          *Entry       `json:"-"`                          // The dataset Entry for the matched Designator, if found
 }
 
+// NameParser is the parsing interface shared by Parser, Updater, and
+// the remote HTTP and gRPC clients (client.Client and gocdgrpc.Client),
+// so applications can switch between embedded and remote parsing
+type NameParser interface {
+	Parse(input string) (*Result, error)
+}
+
+// loadDataset loads the embedded default dataset
 func loadDataset() (*dataset, error) {
 	fh, err := assets.Open(DefaultDataset)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %v", ErrDatasetOpen, err)
 	}
+	defer fh.Close()
 	data, err := ioutil.ReadAll(fh)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %v", ErrDatasetOpen, err)
 	}
+	return parseDataset(data)
+}
 
+// parseDataset parses the YAML dataset data
+func parseDataset(data []byte) (*dataset, error) {
 	ds := make(dataset)
-	err = yaml.Unmarshal(data, ds)
+	err := yaml.Unmarshal(data, ds)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %v", ErrDatasetParse, err)
 	}
-
-	//fmt.Fprintf(os.Stderr, "+ loaded %d entries from dataset %q\n", len(ds), filepath)
+	ds.init(SourceCore, CorePriority)
 
 	return &ds, nil
 }
 
-// escapeDes does some standard escaping of designators
-func escapeDes(des string, re Remap) string {
-	// Allow ampersands to match more broadly
-	des = re["Ampersand"].ReplaceAllString(des, `\s*[&+]\s*`)
-	// Escape parentheses in the designator itself
-	des = re["Paren"].ReplaceAllString(des, `\$1`)
-	// Periods are treated as optional literals, with optional trailing stff
-	des = re["PeriodSpace"].ReplaceAllString(des, `\.*[\pZ,()-]*`)
-	// Interpret embedded spaces in designators pretty liberally
-	des = re["Space"].ReplaceAllString(des, `[\pZ,()-]+`)
+// escapeDes converts des into a regex pattern by applying the
+// designator transforms xf in order
+func escapeDes(des string, xf []DesignatorTransform) string {
+	for _, f := range xf {
+		des = f(des)
+	}
 	return des
 }
 
-func addPattern(patterns []string, s string, t PositionType, re Remap) []string {
+func addPattern(patterns []string, s string, t PositionType, re Remap, xf []DesignatorTransform) []string {
 	// Skip Begin/End strings if they are blacklisted
 	if (t == End || t == Begin) && EndDesignatorBlacklist[s] {
 		return patterns
@@ -153,7 +235,7 @@ func addPattern(patterns []string, s string, t PositionType, re Remap) []string
 	s = norm.NFD.String(s)
 
 	// Do our standard designator escaping
-	s = escapeDes(s, re)
+	s = escapeDes(s, xf)
 
 	// Add s to patterns
 	patterns = append(patterns, s)
@@ -167,10 +249,18 @@ func addPattern(patterns []string, s string, t PositionType, re Remap) []string
 	return patterns
 }
 
-func compileREPatterns(ds *dataset, t PositionType, re Remap) string {
+func compileREPatterns(ds *dataset, t PositionType, re Remap, xf []DesignatorTransform) string {
 	var patterns []string
 
-	for long, e := range *ds {
+	// Visit entries in sorted order, so patterns are deterministic
+	longs := make([]string, 0, len(*ds))
+	for long := range *ds {
+		longs = append(longs, long)
+	}
+	sort.Strings(longs)
+
+	for _, long := range longs {
+		e := (*ds)[long]
 		// FIXME: dev
 		/*
 			if long != "Company" {
@@ -187,12 +277,12 @@ func compileREPatterns(ds *dataset, t PositionType, re Remap) string {
 		}
 
 		// Add long to patterns
-		patterns = addPattern(patterns, long, t, re)
+		patterns = addPattern(patterns, long, t, re, xf)
 
 		// Add AbbrStd to patterns
 		/*
 			if e.AbbrStd != "" {
-				patterns = addPattern(patterns, e.AbbrStd, t, re)
+				patterns = addPattern(patterns, e.AbbrStd, t, re, xf)
 			}
 		*/
 
@@ -202,7 +292,14 @@ func compileREPatterns(ds *dataset, t PositionType, re Remap) string {
 			if t == EndCont && re["ASCII"].MatchString(a) {
 				continue
 			}
-			patterns = addPattern(patterns, a, t, re)
+			patterns = addPattern(patterns, a, t, re, xf)
+		}
+
+		// Add regex Abbrs to patterns verbatim, skipping fallback passes
+		if t != EndFallback && t != BeginFallback {
+			for _, a := range e.AbbrRE {
+				patterns = append(patterns, norm.NFD.String(a))
+			}
 		}
 	}
 	if len(patterns) == 0 {
@@ -212,168 +309,677 @@ func compileREPatterns(ds *dataset, t PositionType, re Remap) string {
 	// Join patterns as alternates, and always allow outer parentheses
 	pattern := `\(?` + `(?:` + strings.Join(patterns, "|") + `)` + `\)?`
 
-	//fmt.Fprintf(os.Stderr, "+ compiled %d %q patterns from dataset\n", len(patterns), t.String())
-	//fmt.Fprintf(os.Stderr, "++ %s\n", pattern)
-
 	return pattern
 }
 
-// New returns a new Parser using the default company designator dataset
-func New() (*Parser, error) {
-	p := Parser{}
-
+// newRemap returns the helper regexes used in building and matching patterns
+func newRemap() Remap {
 	re := make(Remap)
-	re["PeriodSpace"] = regexp.MustCompile(`\.\pZ*`)
-	re["Space"] = regexp.MustCompile(`\pZ+`)
 	re["SpaceDotSpace"] = regexp.MustCompile(`\pZ+\.\pZ*`)
-	re["Ampersand"] = regexp.MustCompile(`\pZ*&\pZ*`)
-	re["Paren"] = regexp.MustCompile("([()\uff08\uff09])")
 	re["ParenSpace"] = regexp.MustCompile("\\pZ*[()\uff08\uff09]\\pZ*")
 	re["UnicodeMarks"] = regexp.MustCompile(`\pM`)
 	re["ASCII"] = regexp.MustCompile("^[[:ascii:]]+$")
-	p.re = re
+	re["NBSpace"] = regexp.MustCompile("[\u00a0\u2007\u202f]")
+	re["ZeroWidth"] = regexp.MustCompile("[\u200b-\u200f\u202a-\u202e\u2060\u2066-\u2069\u061c\ufeff]")
+	re["Apostrophe"] = regexp.MustCompile("[\u2018\u2019\u201a\u201b\u00b4`\u02bc\u2032]")
+	re["Quote"] = regexp.MustCompile("[\u201c-\u201f\u2033]")
+	re["Qualifier"] = regexp.MustCompile("^(.+?)\\pZ*[(\uff08]\\pZ*([^()\uff08\uff09]+?)\\pZ*[)\uff09]$")
+	return re
+}
+
+// MustNew is like New but panics if the Parser cannot be created.
+// It simplifies safe initialisation of global variables holding Parsers.
+func MustNew(opts ...Option) *Parser {
+	p, err := New(opts...)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// New returns a new Parser using the default company designator dataset
+// (or the dataset file named by the GOCD_DATASET environment variable, if
+// set), configured with any Options supplied. Errors wrap ErrDatasetOpen or
+// ErrDatasetParse for dataset problems (including invalid overlays), and
+// ErrPatternCompile for pattern compilation failures.
+func New(opts ...Option) (*Parser, error) {
+	return newParser(nil, opts)
+}
+
+// NewFromDataset is like New, but uses the YAML dataset data (in the
+// upstream dataset schema) in place of the default dataset
+func NewFromDataset(data []byte, opts ...Option) (*Parser, error) {
+	return newParser(data, opts)
+}
 
-	ds, err := loadDataset()
+// newParser does the work for New and NewFromDataset, using dataset
+// data if non-nil
+func newParser(data []byte, opts []Option) (*Parser, error) {
+	p := Parser{}
+	for _, opt := range opts {
+		opt(&p.opts)
+	}
+
+	p.re = newRemap()
+
+	path := ""
+	var ds *dataset
+	var err error
+	if data == nil && !p.opts.noDatasetEnv {
+		path = os.Getenv(DatasetEnv)
+		if path != "" {
+			data, err = ioutil.ReadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrDatasetOpen, err)
+			}
+		}
+	}
+	if data != nil {
+		// External datasets are verified before use
+		if p.opts.verifier != nil {
+			err = p.opts.verifier(data)
+			if err != nil {
+				return nil, err
+			}
+		}
+		ds, err = parseDataset(data)
+	} else {
+		ds, err = loadDataset()
+	}
 	if err != nil {
 		return nil, err
 	}
-	p.ds = ds
+	err = ds.mergeLayers(p.opts.layers)
+	if err != nil {
+		return nil, err
+	}
+	for _, overlay := range p.opts.overlays {
+		err = ds.merge(overlay, SourceOverlay, OverlayPriority)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = ds.mergeDatasetLayers(p.opts.datasetLayers)
+	if err != nil {
+		return nil, err
+	}
+	err = ds.validate()
+	if err != nil {
+		return nil, err
+	}
+	if p.opts.logger != nil {
+		p.opts.logger.Debug("gocd loaded dataset", "path", path,
+			"entries", len(*ds), "layers", len(p.opts.layers)+len(p.opts.datasetLayers),
+			"overlays", len(p.opts.overlays))
+	}
 
-	// Compile End patterns
-	endPattern := compileREPatterns(ds, End, re)
-	//fmt.Fprintf(os.Stderr, "+ endPattern: %s\n", endPattern)
-	endFallbackPattern := compileREPatterns(ds, EndFallback, re)
-	//fmt.Fprintf(os.Stderr, "+ endFallbackPattern: %s\n", endFallbackPattern)
-	endContPattern := compileREPatterns(ds, EndCont, re)
-	//fmt.Fprintf(os.Stderr, "+ endContPattern: %s\n", endContPattern)
-	beginPattern := compileREPatterns(ds, Begin, re)
-	//fmt.Fprintf(os.Stderr, "+ beginPattern: %s\n", beginPattern)
-	beginFallbackPattern := compileREPatterns(ds, BeginFallback, re)
-	//fmt.Fprintf(os.Stderr, "+ beginFallbackPattern: %s\n", beginFallbackPattern)
-
-	if endPattern != "" {
-		p.reEnd = regexp.MustCompile(`(?i)` +
-			StrEndBefore + `(` + endPattern + `)` + StrEndAfter)
-		//fmt.Fprintf(os.Stderr, "+ reEnd: %s\n", p.reEnd)
-	}
-	if endFallbackPattern != "" {
-		p.reEndFallback = regexp.MustCompile(`(?i)` +
-			StrEndBefore + `(` + endFallbackPattern + `)` + StrEndAfter)
-		//fmt.Fprintf(os.Stderr, "+ reEndFallback: %s\n", p.reEndFallback)
-	}
-	if endContPattern != "" {
-		p.reEndCont = regexp.MustCompile(`(?i)` +
-			StrEndContBefore + `(` + endContPattern + `)` + StrEndContAfter)
-		//fmt.Fprintf(os.Stderr, "+ reEndCont: %s\n", p.reEndCont)
-	}
-	if beginPattern != "" {
-		p.reBegin = regexp.MustCompile(`(?i)` +
-			StrBeginBefore + `(` + beginPattern + `)` + StrBeginAfter)
-	}
-	//fmt.Fprintf(os.Stderr, "+ reBegin: %s\n", p.reBegin)
-	if beginFallbackPattern != "" {
-		p.reBeginFallback = regexp.MustCompile(`(?i)` +
-			StrBeginBefore + `(` + beginFallbackPattern + `)` + StrBeginAfter)
-		//fmt.Fprintf(os.Stderr, "+ reBeginFallback: %s\n", p.reBeginFallback)
+	// Entries flagged LangOnly only match given a language hint
+	err = p.compile(ds, ds.filter(func(e *Entry) bool { return !e.LangOnly }), nil)
+	if err != nil {
+		return nil, err
 	}
+	p.init()
 
 	return &p, nil
 }
 
+// Close releases resources held by the Parser. All Parser resources are
+// garbage collected, so Close only drops cached results and language
+// Parsers (see WithCache and ParseLang) and always returns nil; the
+// Parser remains usable, rebuilding caches on demand. It is safe to call
+// Close concurrently with parsing.
+func (p *Parser) Close() error {
+	p.langs.Lock()
+	p.langs.parsers = make(map[string]*Parser)
+	p.langs.Unlock()
+	if p.cache != nil {
+		p.cache.clear()
+	}
+	return nil
+}
+
+// init sets up the Parser's caches once compiled
+func (p *Parser) init() {
+	p.langs = &langCache{parsers: make(map[string]*Parser)}
+	if p.opts.cacheSize > 0 {
+		p.cache = newResultCache(p.opts.cacheSize)
+	}
+}
+
+// passBounds holds the patterns bracketing the designator alternation
+// for each pass
+var passBounds = map[PositionType][2]string{
+	End:           {StrEndBefore, StrEndAfter},
+	EndFallback:   {StrEndBefore, StrEndAfter},
+	EndCont:       {StrEndContBefore, StrEndContAfter},
+	Begin:         {StrBeginBefore, StrBeginAfter},
+	BeginFallback: {StrBeginBefore, StrBeginAfter},
+}
+
+// buildPattern returns the full regex pattern for pass t from the entries
+// in matchDs, or an empty string if there are none
+func buildPattern(matchDs *dataset, t PositionType, re Remap, xf []DesignatorTransform) string {
+	pattern := compileREPatterns(matchDs, t, re, xf)
+	if pattern == "" {
+		return ""
+	}
+	return `(?i)` + passBounds[t][0] + `(` + pattern + `)` + passBounds[t][1]
+}
+
+// compile builds the Parser's designator index from ds, and its
+// matching regexes from the entries in matchDs. If patterns is non-nil
+// it supplies prebuilt pass patterns, indexed by PositionType.
+func (p *Parser) compile(ds, matchDs *dataset, patterns []string) error {
+	p.ds = ds
+	idx, err := newDesIndex(ds)
+	if err != nil {
+		return err
+	}
+	p.idx = idx
+	if p.opts.translit {
+		p.idx.addTransliterations(ds)
+	}
+	matchDs, err = p.restrict(matchDs)
+	if err != nil {
+		return err
+	}
+	if p.opts.translit {
+		matchDs = matchDs.transliterated()
+	}
+	p.lastTokens = nil
+	if p.opts.desTransforms == nil {
+		p.lastTokens = compileLastTokens(matchDs)
+	}
+	p.wholeKeys = compileWholeKeys(matchDs)
+	p.contMask = 0
+	if p.opts.desTransforms == nil {
+		p.contMask = contScripts(matchDs)
+	}
+	p.suffix = nil
+	if p.opts.suffixIndex && p.opts.desTransforms == nil {
+		p.suffix = newSuffixIndex(matchDs, &p.opts)
+	}
+	p.patterns = make([]string, BeginFallback+1)
+	copy(p.patterns, patterns)
+
+	// Build and compile the pass patterns concurrently, since compiling
+	// the large alternations dominates New() latency. Passes are not
+	// sharded further, as splitting an alternation would change its
+	// leftmost-first match semantics.
+	targets := []**regexp.Regexp{
+		End:           &p.reEnd,
+		EndFallback:   &p.reEndFallback,
+		EndCont:       &p.reEndCont,
+		Begin:         &p.reBegin,
+		BeginFallback: &p.reBeginFallback,
+	}
+	errs := make([]error, len(targets))
+	var wg sync.WaitGroup
+	for t := End; t <= BeginFallback; t++ {
+		*targets[t] = nil
+		if !p.opts.passEnabled(t) {
+			continue
+		}
+		wg.Add(1)
+		go func(t PositionType) {
+			defer wg.Done()
+			start := time.Now()
+			// Build missing patterns, including any empty in patterns as
+			// the pass was disabled when they were built
+			if p.patterns[t] == "" {
+				p.patterns[t] = buildPattern(matchDs, t, p.re, p.opts.transforms())
+			}
+			if p.patterns[t] == "" {
+				return
+			}
+			*targets[t], errs[t] = compileRE(p.patterns[t])
+			if p.opts.logger != nil {
+				p.opts.logger.Debug("gocd compiled pattern", "pass", t,
+					"entries", len(*matchDs), "pattern_bytes", len(p.patterns[t]),
+					"elapsed", time.Since(start))
+			}
+		}(t)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	p.shards = nil
+	if p.opts.scriptShards {
+		return p.compileShards(matchDs)
+	}
+
+	return nil
+}
+
+// compileRE compiles a designator regex, wrapping any error as an
+// ErrPatternCompile
+func compileRE(pattern string) (*regexp.Regexp, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrPatternCompile, err)
+	}
+	return re, nil
+}
+
 // checkDesPunct handles the reEnd situation where our breaking
 // punctuation character before the designator might be something
 // we should include in the designator e.g. '&' or '('
-func (p *Parser) checkDesPunct(punct, des string) string {
-	if punct != "(" {
-		return des
+func (p *Parser) checkDesPunct(src source, punct, des []byte) string {
+	if string(punct) != "(" {
+		return src.str(des)
 	}
-	return punct + des
+	return "(" + src.str(des)
+}
+
+// splitQualifier handles the reEnd situation where the short name is
+// followed by a bracketed country or jurisdiction qualifier e.g. "Acme
+// (UK) Ltd", returning the short name and qualifier strings from src.
+// The closing parenthesis is typically consumed as our breaking
+// punctuation character, so we reattach it before checking, and keep it
+// in the short name for other bracketed text (e.g. "Acme (Holdings) Ltd").
+func (p *Parser) splitQualifier(src source, short, punct []byte) (string, string) {
+	if string(punct) != ")" && string(punct) != "\uff09" {
+		return src.str(short), ""
+	}
+	closed := make([]byte, 0, len(short)+len(punct))
+	closed = append(append(closed, short...), punct...)
+	m := p.re["Qualifier"].FindSubmatchIndex(closed)
+	if m == nil {
+		return src.str(short), ""
+	}
+	// Both groups precede the closing parenthesis, so slice them from
+	// short rather than closed
+	qualifier := short[m[4]:m[5]]
+	if !isQualifier(nfc(qualifier)) {
+		return src.str(short) + src.str(punct), ""
+	}
+	return src.str(short[m[2]:m[3]]), src.str(qualifier)
+}
+
+// nfc returns b as an NFC-normalised string
+func nfc(b []byte) string {
+	return string(norm.NFC.Bytes(b))
 }
 
 // Parse matches an input company name string against the company
 // designator dataset and returns a Result object containing match
 // results and any parsed components
 func (p *Parser) Parse(input string) (*Result, error) {
-	inputNFD := norm.NFD.String(input)
-	inputNFC := norm.NFC.String(input)
-	res := Result{Input: inputNFC, ShortName: inputNFC}
-	ctx := Context{}
-	ctx.in = []byte(inputNFD)
+	return p.ParseBytes([]byte(input))
+}
+
+// scratchPool holds input buffers for ParseInto
+var scratchPool = sync.Pool{
+	New: func() interface{} { return new([]byte) },
+}
+
+// ParseInto is a version of Parse that fills in a caller-supplied Result,
+// which is reset first, for high-throughput callers that want to avoid
+// per-call allocations. For ASCII input, result strings are slices of
+// input rather than copies, and ParseInto allocates only when a
+// designator matches (or when using options that themselves allocate,
+// like WithArticles).
+func (p *Parser) ParseInto(input string, res *Result) error {
+	buf := scratchPool.Get().(*[]byte)
+	*buf = append((*buf)[:0], input...)
+	*res = Result{}
+	err := p.parse(nil, *buf, input, res, nil)
+	scratchPool.Put(buf)
+	return err
+}
+
+// ParseBytes is a version of Parse that works directly on a byte slice,
+// avoiding string conversions for callers that already hold input as bytes.
+// input is not modified. Invalid UTF-8 input is handled according to the
+// Parser's InvalidUTF8Policy (see WithInvalidUTF8). If a parse timeout is
+// exceeded (see WithParseTimeout), the unmatched Result is returned along
+// with an ErrParseTimeout error.
+func (p *Parser) ParseBytes(input []byte) (*Result, error) {
+	return p.parseResult(nil, input, "")
+}
+
+// parseResult returns a new Result for input, including the partial
+// Result on ErrParseTimeout
+func (p *Parser) parseResult(ctx context.Context, input []byte, str string) (*Result, error) {
+	res := &Result{}
+	err := p.parse(ctx, input, str, res, nil)
+	if err != nil && !errors.Is(err, ErrParseTimeout) {
+		return nil, err
+	}
+	return res, err
+}
+
+// parse does the work for Parse, ParseBytes, ParseInto, and Explain,
+// filling in res. If str is non-empty it holds input as a string, from
+// which result strings may be sliced without allocating. If ex is
+// non-nil, matching details are recorded in it, bypassing any cache.
+// Matching is abandoned if ctx (which may be nil) is done, or the
+// Parser's parse timeout is exceeded.
+func (p *Parser) parse(ctx context.Context, input []byte, str string, res *Result, ex *Explanation) error {
+	var start time.Time
+	if p.opts.metrics != nil {
+		start = time.Now()
+	}
 
-	// Minimal preprocessing
+	if p.cache != nil && ex == nil {
+		if cached, pass, exists := p.cache.get(input); exists {
+			*res = *cached
+			if p.opts.logger != nil {
+				p.opts.logger.Debug("gocd parse", "input", res.Input,
+					"cached", true, "pass", pass)
+			}
+			if p.opts.metrics != nil {
+				p.opts.metrics.record(res, pass, time.Since(start))
+			}
+			return nil
+		}
+	}
+	key := input
+
+	checked, err := p.checkUTF8(input)
+	if err != nil {
+		return err
+	}
+	if len(checked) != len(input) {
+		str = ""
+	}
+	input = checked
+
+	var orig string
+	if p.opts.nfkc || len(p.opts.preprocessors) > 0 {
+		input, str, orig = p.preprocess(input)
+	}
+
+	skip := false
+	if p.opts.maxInputLen > 0 {
+		input, skip, err = p.checkLength(input)
+		if err != nil {
+			return err
+		}
+		if len(input) < len(str) {
+			str = str[:len(input)]
+		}
+	}
+
+	// Record passes tried for logging, if not explaining already
+	trace := ex
+	if trace == nil && p.opts.logger != nil {
+		trace = &Explanation{}
+	}
+
+	pass := None
+	if skip {
+		res.Input = newSource(input, str, p.opts.outputForm).str(input)
+		res.ShortName = res.Input
+	} else {
+		b := p.newBudget(ctx)
+		pass, err = p.shard(input).match(newSource(input, str, p.opts.outputForm), res, trace, b)
+		if err != nil {
+			if p.opts.logger != nil {
+				p.opts.logger.Debug("gocd parse", "input", res.Input,
+					"tried", trace.Tried, "timeout", true)
+			}
+			return err
+		}
+	}
+	var ref desRef
+	if res.Matched {
+		des := res.Designator
+		if p.opts.translit {
+			// Original output may retain Latin lookalikes
+			des = string(reMixedWord.ReplaceAllFunc([]byte(des), foldHomoglyphs))
+		}
+		ref, _ = p.idx.lookup(des)
+		res.DesignatorStd = p.refStd(ref)
+		res.MatchKind = matchKind(res.Designator, ref, pass)
+		res.Entry = (*p.ds)[ref.long]
+		if res.Entry != nil {
+			res.DesignatorLong = res.Entry.LongName
+			res.Lang = res.Entry.Lang
+			res.Category = res.Entry.Category
+			res.Source = res.Entry.Source
+			res.Public = res.Entry.Public
+			res.Ambiguity = res.Entry.Ambiguity[ref.des]
+		}
+		if ex != nil {
+			ex.Pass = pass
+			ex.Variant = ref.des
+			ex.VariantRegex = ref.regex
+		}
+	}
+
+	if res.Matched && p.opts.unquoteShort {
+		res.ShortName = unquote(res.ShortName)
+	}
+	if p.opts.articles != nil {
+		res.ShortName = p.stripArticle(res.ShortName, res.Lang)
+	}
+	if (p.opts.minShortRunes > 0 || p.opts.minShortTokens > 0) && res.Position != Whole {
+		p.checkShortName(res)
+	}
+	if p.opts.minAbbrTokens > 0 && res.Position != Whole {
+		p.checkShortAbbr(res)
+	}
+	if p.opts.checkAmbiguity && res.Ambiguity > p.opts.maxAmbiguity {
+		res.clearMatch(res.Input)
+	}
+	res.LangMismatch = langMismatch(res)
+	// Report the input preprocessors received, rather than their output
+	text := res.Input
+	if len(p.opts.preprocessors) > 0 {
+		res.Input = newSource([]byte(orig), orig, p.opts.outputForm).str([]byte(orig))
+	}
+	p.setShortNameFields(res)
+	if len(p.opts.postprocessors) > 0 && !p.postprocess(res) {
+		res.clearMatch(text)
+		p.setShortNameFields(res)
+	}
+
+	if p.opts.metrics != nil {
+		p.opts.metrics.record(res, pass, time.Since(start))
+	}
+	if p.opts.logger != nil {
+		p.opts.logger.Debug("gocd parse", "input", res.Input, "skipped", skip,
+			"tried", trace.Tried, "pass", pass, "designator", res.Designator)
+	}
+	if p.cache != nil && ex == nil {
+		p.cache.put(key, res, pass)
+	}
+
+	return nil
+}
+
+// setShortNameFields sets the Result fields derived from res.ShortName
+// that the Parser's options enable
+func (p *Parser) setShortNameFields(res *Result) {
+	if p.opts.shortASCII {
+		res.ShortNameASCII = asciiFold(res.ShortName)
+	}
+	if p.opts.nameLang {
+		res.NameLang = nameLang(res)
+	}
+}
+
+// runPass runs the regex re for pass t against in, recording the
+// outcome in ex and any Tracer. An ErrParseTimeout error is returned
+// without running the pass if budget b is exhausted.
+func (p *Parser) runPass(t PositionType, re *regexp.Regexp, in []byte, ex *Explanation, b budget) ([][]byte, error) {
+	if err := b.check(); err != nil {
+		return nil, err
+	}
+	var start time.Time
+	if p.opts.tracer != nil {
+		start = time.Now()
+	}
+	matches := re.FindSubmatch(in)
+	ex.tried(t, matches)
+	if p.opts.tracer != nil {
+		p.opts.tracer.TracePass(t, start, time.Since(start), matches != nil)
+	}
+	return matches, nil
+}
+
+// match does the actual designator matching for Parse, filling in res
+// and returning the pass that matched (None if no match). If ex is
+// non-nil, the passes tried and groups captured are recorded in it.
+// If budget b is exhausted between passes, matching stops with res
+// unmatched and an ErrParseTimeout error.
+func (p *Parser) match(src source, res *Result, ex *Explanation, b budget) (PositionType, error) {
+	inputNFD := src.nfd()
+	res.Input = src.str(src.b)
+	res.ShortName = res.Input
+	ctx := Context{}
+	ctx.in = inputNFD
+
+	// Minimal preprocessing, checking for matches first to avoid copying
+	// Normalise non-breaking spaces and strip zero-width characters
+	// (including bidi controls)
+	if !p.opts.keepInvisible && !src.ascii {
+		inputNFD = src.replaceAll(p.re["NBSpace"], inputNFD, []byte(" "))
+		inputNFD = src.replaceAll(p.re["ZeroWidth"], inputNFD, nil)
+	}
+	// Fold Arabic tatweel, punctuation, and presentation forms
+	if !src.ascii && reArabicTypography.Match(inputNFD) {
+		inputNFD = src.replaceAllFunc(reArabicTypography, inputNFD, foldArabic)
+	}
+	// Replace Latin lookalikes in mixed Cyrillic and Latin words
+	if p.opts.translit && !src.ascii {
+		inputNFD = src.replaceAllFunc(reMixedWord, inputNFD, foldHomoglyphs)
+	}
+	// Normalise typographic apostrophes and quotes to their ASCII forms
+	if !p.opts.keepQuotes {
+		if p.re["Apostrophe"].Match(inputNFD) {
+			inputNFD = src.replaceAll(p.re["Apostrophe"], inputNFD, []byte("'"))
+		}
+		if !src.ascii && p.re["Quote"].Match(inputNFD) {
+			inputNFD = src.replaceAll(p.re["Quote"], inputNFD, []byte(`"`))
+		}
+	}
 	// Try and normalise strange dot-space pattern with initials e.g. P .J . S . C
-	inputNFD = p.re["SpaceDotSpace"].ReplaceAllString(inputNFD, ". ")
+	if p.re["SpaceDotSpace"].Match(inputNFD) {
+		inputNFD = src.replaceAll(p.re["SpaceDotSpace"], inputNFD, []byte(". "))
+	}
+
+	ex.preprocessed(inputNFD)
+
+	// Designators are usually final, so try end matching first, skipping
+	// the (expensive) end passes if the final token can't be a designator
+	var matches [][]byte
+	var err error
+	endCandidate := p.endCandidate(inputNFD)
+
+	// Check whether the entire input is a designator, which the passes
+	// (requiring a name alongside the designator) don't handle
+	if endCandidate && p.matchWhole(src, inputNFD, res) {
+		return Whole, nil
+	}
 
-	// Designators are usually final, so try end matching first
-	var matches []string
-	if p.reEnd != nil {
-		matches = p.reEnd.FindStringSubmatch(inputNFD)
+	// Use the suffix index for ASCII input, if enabled
+	if p.suffix != nil && src.ascii {
+		pass, err := p.matchSuffix(src, inputNFD, res, endCandidate, ex, b)
+		if err != nil {
+			return None, err
+		}
+		if pass == None && p.opts.glued && p.matchGlued(src, inputNFD, res, ex) {
+			return EndGlued, nil
+		}
+		return pass, nil
+	}
+	if p.reEnd != nil && endCandidate {
+		matches, err = p.runPass(End, p.reEnd, inputNFD, ex, b)
+		if err != nil {
+			return None, err
+		}
 		if matches != nil {
-			//fmt.Printf("+ reEnd matches: %q %q %q\n", matches[1], matches[2], matches[3])
 			res.Matched = true
-			res.ShortName = norm.NFC.String(matches[1])
-			res.Designator = norm.NFC.String(p.checkDesPunct(matches[2], matches[3]))
+			res.ShortName, res.Qualifier = p.splitQualifier(src, matches[1], matches[2])
+			res.Designator = p.checkDesPunct(src, matches[2], matches[3])
 			res.Position = End
-			return &res, nil
+			return End, nil
 		}
 	}
 
 	// No final designator - retry using the fallback endings we blacklisted
 	// for the previous run
-	if p.reEndFallback != nil {
-		matches = p.reEndFallback.FindStringSubmatch(inputNFD)
+	if p.reEndFallback != nil && endCandidate {
+		matches, err = p.runPass(EndFallback, p.reEndFallback, inputNFD, ex, b)
+		if err != nil {
+			return None, err
+		}
 		if matches != nil {
-			//fmt.Printf("+ reEndFallback matches: %q %q %q\n", matches[1], matches[2], matches[3])
 			res.Matched = true
-			res.ShortName = norm.NFC.String(matches[1])
-			res.Designator = norm.NFC.String(p.checkDesPunct(matches[2], matches[3]))
+			res.ShortName, res.Qualifier = p.splitQualifier(src, matches[1], matches[2])
+			res.Designator = p.checkDesPunct(src, matches[2], matches[3])
 			// Note we use End here rather than EndFallback
 			res.Position = End
-			return &res, nil
+			return EndFallback, nil
 		}
 	}
 
 	// No final designator - retry without a word break for the subset of
 	// languages that use continuous scripts (see LangContinua above)
-	// Strip all parentheses for continuous script matches
-	if p.reEndCont != nil {
-		inputNFDStripped := p.re["ParenSpace"].ReplaceAllString(inputNFD, "")
-		matches = p.reEndCont.FindStringSubmatch(inputNFDStripped)
+	// Strip all parentheses for continuous script matches, skipping the
+	// pass if the input has no letters in the scripts of its designators
+	if p.reEndCont != nil && p.contCandidate(inputNFD, src.ascii) {
+		inputNFDStripped := inputNFD
+		if p.re["ParenSpace"].Match(inputNFD) {
+			inputNFDStripped = src.replaceAll(p.re["ParenSpace"], inputNFD, nil)
+		}
+		matches, err = p.runPass(EndCont, p.reEndCont, inputNFDStripped, ex, b)
+		if err != nil {
+			return None, err
+		}
 		if matches != nil {
 			res.Matched = true
-			res.ShortName = norm.NFC.String(matches[1])
-			res.Designator = norm.NFC.String(matches[2])
+			res.ShortName = src.str(matches[1])
+			res.Designator = src.str(matches[2])
 			// Note we use End here rather than EndCont
 			res.Position = End
-			return &res, nil
+			return EndCont, nil
 		}
 	}
 
 	// No final designator - check for a lead designator instead (e.g. ru, nl, etc.)
 	if p.reBegin != nil {
-		matches = p.reBegin.FindStringSubmatch(inputNFD)
+		matches, err = p.runPass(Begin, p.reBegin, inputNFD, ex, b)
+		if err != nil {
+			return None, err
+		}
 		if matches != nil {
 			res.Matched = true
-			res.ShortName = norm.NFC.String(matches[2])
-			res.Designator = norm.NFC.String(matches[1])
+			res.ShortName = src.str(matches[2])
+			res.Designator = src.str(matches[1])
 			res.Position = Begin
-			return &res, nil
+			return Begin, nil
 		}
 	}
 
 	// No lead designator either - retry using the fallback endings we
 	// blacklisted for the previous run
 	if p.reBeginFallback != nil {
-		matches = p.reBeginFallback.FindStringSubmatch(inputNFD)
+		matches, err = p.runPass(BeginFallback, p.reBeginFallback, inputNFD, ex, b)
+		if err != nil {
+			return None, err
+		}
 		if matches != nil {
 			res.Matched = true
-			res.ShortName = norm.NFC.String(matches[2])
-			res.Designator = norm.NFC.String(matches[1])
+			res.ShortName = src.str(matches[2])
+			res.Designator = src.str(matches[1])
 			// Note we use Begin here rather than BeginFallback
 			res.Position = Begin
-			return &res, nil
+			return BeginFallback, nil
 		}
 	}
 
-	return &res, nil
+	// No designator with a word break - check for one glued to the name
+	if p.opts.glued && p.matchGlued(src, inputNFD, res, ex) {
+		return EndGlued, nil
+	}
+
+	return None, nil
 }
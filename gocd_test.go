@@ -1,9 +1,11 @@
 package gocd
 
 import (
+	"flag"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -55,6 +57,24 @@ func TestGOCDBasic(t *testing.T) {
 	}
 }
 
+func TestPositionTypeText(t *testing.T) {
+	for pos := None; pos <= BeginFallback; pos++ {
+		text, err := pos.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var pos2 PositionType
+		err = pos2.UnmarshalText(text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, pos, pos2, "PositionType text round trip")
+	}
+
+	var pos PositionType
+	assert.Error(t, pos.UnmarshalText([]byte("middle")), "invalid PositionType errors")
+}
+
 func fatal(msg string) {
 	fmt.Fprintln(os.Stderr, msg)
 	os.Exit(1)
@@ -86,7 +106,7 @@ func loadStripTests() []TestCase {
 		if tc.Position == "" {
 			fatal(fmt.Sprintf("missing position for test entry %q", tc.Name))
 		}
-		if tc.Skip || tc.SkipUnlessLang {
+		if tc.Skip {
 			s++
 			continue
 		}
@@ -117,6 +137,9 @@ func TestGOCDFull(t *testing.T) {
 	c := 0
 	for _, tc := range tests {
 		res, err := p.Parse(tc.Name)
+		if tc.SkipUnlessLang {
+			res, err = p.ParseLang(tc.Name, tc.Lang)
+		}
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -125,12 +148,14 @@ func TestGOCDFull(t *testing.T) {
 			assert.Equal(t, tc.Name, res.Input, "Input matches")
 			assert.Equal(t, tc.Before, res.ShortName, "ShortName matches")
 			assert.Equal(t, tc.Designator, res.Designator, "Designator matches")
+			assert.Equal(t, tc.DesignatorStd, res.DesignatorStd, "DesignatorStd matches")
 			assert.Equal(t, tc.Position, res.Position.String(), "Position matches")
 		} else if tc.After != "" {
 			c++
 			assert.Equal(t, tc.Name, res.Input, "Input matches")
 			assert.Equal(t, tc.After, res.ShortName, "ShortName matches")
 			assert.Equal(t, tc.Designator, res.Designator, "Designator matches")
+			assert.Equal(t, tc.DesignatorStd, res.DesignatorStd, "DesignatorStd matches")
 			assert.Equal(t, tc.Position, res.Position.String(), "Position matches")
 		}
 	}
@@ -160,3 +185,110 @@ func BenchmarkRE(b *testing.B) {
 		}
 	}
 }
+
+func TestClose(t *testing.T) {
+	p, err := New(WithCache(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = p.Parse("Acme GmbH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = p.ParseLang("Acme GmbH", "de")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, p.Close(), "Close ok")
+	assert.Equal(t, 0, p.cache.len(), "Close clears cache")
+	assert.Empty(t, p.langs.parsers, "Close drops language Parsers")
+
+	res, err := p.Parse("Acme GmbH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "GmbH", res.Designator, "Parser usable after Close")
+}
+
+var updateGolden = flag.Bool("update-golden", false,
+	"update the golden compiled pattern file")
+
+const patternsGolden = "testdata/patterns.golden"
+
+// TestPatternsGolden checks the compiled pass patterns are deterministic
+// and unchanged. Run with -update-golden after intended changes.
+func TestPatternsGolden(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	for pass := End; pass <= BeginFallback; pass++ {
+		fmt.Fprintf(&b, "# %s\n%s\n\n", pass, p.patterns[pass])
+	}
+	got := b.String()
+
+	// Patterns must not depend on map iteration order
+	p2, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, p.patterns, p2.patterns, "patterns deterministic")
+
+	if *updateGolden {
+		err = ioutil.WriteFile(patternsGolden, []byte(got), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := ioutil.ReadFile(patternsGolden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, string(want), got, "patterns match golden file")
+}
+
+func TestCompileRE(t *testing.T) {
+	_, err := compileRE(`(?i)(`)
+	assert.ErrorIs(t, err, ErrPatternCompile, "compileRE error is ErrPatternCompile")
+}
+
+func BenchmarkNew(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, err := New()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestGOCDWithoutPasses(t *testing.T) {
+	tests := []struct {
+		input   string
+		opt     Option
+		matched bool
+	}{
+		{"ООО Ромашка", nil, true},
+		{"ООО Ромашка", WithoutBeginPass(), false},
+		{"Acme GmbH", WithoutBeginPass(), true},
+		{"トヨタ自動車株式会社", nil, true},
+		{"トヨタ自動車株式会社", WithoutContinuousPass(), false},
+		{"Acme GmbH", WithoutContinuousPass(), true},
+	}
+
+	for _, tc := range tests {
+		var opts []Option
+		if tc.opt != nil {
+			opts = append(opts, tc.opt)
+		}
+		p, err := New(opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.matched, res.Matched, "Matched for %q", tc.input)
+	}
+}
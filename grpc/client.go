@@ -0,0 +1,128 @@
+package gocdgrpc
+
+import (
+	"context"
+
+	"github.com/ProfoundNetworks/gocd"
+	"google.golang.org/grpc"
+)
+
+// Client is a client for the gocd gRPC API, safe for concurrent use.
+// Like the HTTP client in package client, it implements
+// gocd.NameParser, and remote Results have no Entry.
+type Client struct {
+	conn grpc.ClientConnInterface
+}
+
+var _ gocd.NameParser = (*Client)(nil)
+
+// NewClient returns a Client making calls on conn (e.g. a
+// *grpc.ClientConn returned by grpc.Dial)
+func NewClient(conn grpc.ClientConnInterface) *Client {
+	return &Client{conn: conn}
+}
+
+// Parse parses input remotely, like gocd.Parser.Parse
+func (c *Client) Parse(input string) (*gocd.Result, error) {
+	return c.ParseLangContext(context.Background(), input, "")
+}
+
+// ParseLang parses input remotely with the language hint lang, like
+// gocd.Parser.ParseLang
+func (c *Client) ParseLang(input, lang string) (*gocd.Result, error) {
+	return c.ParseLangContext(context.Background(), input, lang)
+}
+
+// ParseLangContext is a version of ParseLang making the call with ctx.
+// An empty input is not sent, and gets an unmatched Result, as locally.
+func (c *Client) ParseLangContext(ctx context.Context, input, lang string) (*gocd.Result, error) {
+	if input == "" {
+		return &gocd.Result{}, nil
+	}
+	var res gocd.Result
+	err := c.conn.Invoke(ctx, ParseMethod, &ParseRequest{Name: input, Lang: lang}, &res,
+		grpc.CallContentSubtype(CodecName))
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// Stream is a ParseStream call, returning a Result for each name sent,
+// in order. Send and Recv may be called concurrently with each other,
+// but not with themselves.
+type Stream struct {
+	s grpc.ClientStream
+}
+
+// ParseStream starts a ParseStream call with ctx, which aborts the
+// stream when done
+func (c *Client) ParseStream(ctx context.Context) (*Stream, error) {
+	s, err := c.conn.NewStream(ctx, &serviceDesc.Streams[0], ParseStreamMethod,
+		grpc.CallContentSubtype(CodecName))
+	if err != nil {
+		return nil, err
+	}
+	return &Stream{s: s}, nil
+}
+
+// Send sends name to be parsed with the language hint lang, blocking
+// while the server is not keeping up. The server aborts the stream if
+// name is empty.
+func (s *Stream) Send(name, lang string) error {
+	return s.s.SendMsg(&ParseRequest{Name: name, Lang: lang})
+}
+
+// CloseSend closes the sending side of the stream, after which Recv
+// returns io.EOF once all Results have been received
+func (s *Stream) CloseSend() error {
+	return s.s.CloseSend()
+}
+
+// Recv returns the Result for the next name sent
+func (s *Stream) Recv() (*gocd.Result, error) {
+	var res gocd.Result
+	if err := s.s.RecvMsg(&res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ParseBatch parses names remotely over a single ParseStream call,
+// returning a Result for each, in order. Unlike the HTTP client's
+// ParseBatch, batches are not limited in size. Empty names are not
+// sent, and get unmatched Results, as locally.
+func (c *Client) ParseBatch(ctx context.Context, names []string) ([]*gocd.Result, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.ParseStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Send concurrently, so the server's flow control doesn't block
+	// sending with results unread
+	go func() {
+		for _, name := range names {
+			if name == "" {
+				continue
+			}
+			if stream.Send(name, "") != nil {
+				// The error is returned by Recv
+				return
+			}
+		}
+		stream.CloseSend()
+	}()
+	results := make([]*gocd.Result, len(names))
+	for i, name := range names {
+		if name == "" {
+			results[i] = &gocd.Result{}
+			continue
+		}
+		if results[i], err = stream.Recv(); err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
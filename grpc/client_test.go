@@ -0,0 +1,62 @@
+package gocdgrpc
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/ProfoundNetworks/gocd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClient(t *testing.T) {
+	p, err := gocd.New(gocd.WithInvalidUTF8(gocd.RejectInvalidUTF8))
+	require.NoError(t, err)
+	var parser gocd.NameParser = NewClient(testConnOpts(t, func() *gocd.Parser { return p }, nil))
+	c := parser.(*Client)
+
+	res, err := parser.Parse("Profound Networks LLC")
+	require.NoError(t, err)
+	assert.Equal(t, "Profound Networks", res.ShortName)
+	assert.Equal(t, "LLC", res.Designator)
+
+	res, err = c.ParseLang("Acme GmbH", "de")
+	require.NoError(t, err)
+	assert.Equal(t, "de", res.Lang)
+
+	_, err = c.Parse("Acme \xff Ltd")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Stream
+	stream, err := c.ParseStream(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, stream.Send("Acme Ltd", ""))
+	require.NoError(t, stream.Send("Acme", ""))
+	require.NoError(t, stream.CloseSend())
+	res, err = stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "Ltd", res.Designator)
+	res, err = stream.Recv()
+	require.NoError(t, err)
+	assert.False(t, res.Matched)
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+
+	// Batch
+	names := []string{"Acme Ltd", "GmbH Acme", "Acme"}
+	results, err := c.ParseBatch(context.Background(), names)
+	require.NoError(t, err)
+	require.Len(t, results, len(names))
+	for i, name := range names {
+		want, err := p.Parse(name)
+		require.NoError(t, err)
+		want.Entry = nil
+		assert.Equal(t, want, results[i], name)
+	}
+
+	_, err = c.ParseBatch(context.Background(), []string{"Acme Ltd", "Acme \xff Ltd"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
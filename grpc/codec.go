@@ -0,0 +1,66 @@
+package gocdgrpc
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/proto"
+)
+
+// protoMarshaler is implemented by messages that encode themselves
+// without generated protobuf code (e.g. gocd.Result)
+type protoMarshaler interface {
+	MarshalProto() ([]byte, error)
+}
+
+// protoUnmarshaler is implemented by messages that decode themselves
+// without generated protobuf code (e.g. gocd.Result)
+type protoUnmarshaler interface {
+	UnmarshalProto(b []byte) error
+}
+
+// CodecName is the name of the codec for ParserService messages, which
+// Client selects with grpc.CallContentSubtype. Its wire format is the
+// standard protobuf one.
+const CodecName = "gocd"
+
+// codec encodes protoMarshaler and protoUnmarshaler messages directly
+// and all other messages with the default gRPC proto codec, so it may
+// be used for other services on the same server
+type codec struct {
+	fallback encoding.Codec
+}
+
+func newCodec() codec {
+	return codec{fallback: encoding.GetCodec(proto.Name)}
+}
+
+func init() {
+	encoding.RegisterCodec(newCodec())
+}
+
+// ServerCodec returns a server option using the ParserService codec for
+// all calls, for servers with clients that don't select it by name
+// (e.g. clients generated from the .proto files, which use the "proto"
+// content subtype). Other services on the server are unaffected, as
+// their messages are encoded with the default proto codec.
+func ServerCodec() grpc.ServerOption {
+	return grpc.ForceServerCodec(newCodec())
+}
+
+func (c codec) Marshal(v interface{}) ([]byte, error) {
+	if m, ok := v.(protoMarshaler); ok {
+		return m.MarshalProto()
+	}
+	return c.fallback.Marshal(v)
+}
+
+func (c codec) Unmarshal(data []byte, v interface{}) error {
+	if m, ok := v.(protoUnmarshaler); ok {
+		return m.UnmarshalProto(data)
+	}
+	return c.fallback.Unmarshal(data, v)
+}
+
+func (codec) Name() string {
+	return CodecName
+}
@@ -0,0 +1,64 @@
+package gocdgrpc
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ProfoundNetworks/gocd"
+	"github.com/ProfoundNetworks/gocd/client"
+	"github.com/ProfoundNetworks/gocd/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// langParser is implemented by gocd.Parser and both clients
+type langParser interface {
+	gocd.NameParser
+	ParseLang(input, lang string) (*gocd.Result, error)
+}
+
+// TestNameParserContract checks the local Parser and the HTTP and gRPC
+// clients return the same Results for the same inputs
+func TestNameParserContract(t *testing.T) {
+	p, err := gocd.New()
+	require.NoError(t, err)
+	ts := httptest.NewServer(server.New(func() *gocd.Parser { return p }, server.Options{}))
+	defer ts.Close()
+	httpClient := client.New(ts.URL, nil)
+	grpcClient := NewClient(testConnOpts(t, func() *gocd.Parser { return p }, nil))
+
+	names := []string{"", "Profound Networks LLC", "Acme & Sons", "OOO Romashka", "Acme GmbH & Co. KG",
+		"LLC", "  ", "Société Générale S.A."}
+	parsers := map[string]langParser{"local": p, "http": httpClient, "grpc": grpcClient}
+	for _, name := range names {
+		for _, lang := range []string{"", "fr"} {
+			want, err := p.ParseLang(name, lang)
+			require.NoError(t, err)
+			want.Entry = nil
+			for impl, parser := range parsers {
+				res, err := parser.ParseLang(name, lang)
+				if assert.NoError(t, err, "%s ParseLang(%q, %q) succeeds", impl, name, lang) {
+					res.Entry = nil
+					assert.Equal(t, want, res, "%s ParseLang(%q, %q) matches", impl, name, lang)
+				}
+			}
+		}
+	}
+
+	// Batches
+	want := make([]*gocd.Result, len(names))
+	for i, name := range names {
+		want[i], err = p.Parse(name)
+		require.NoError(t, err)
+		want[i].Entry = nil
+	}
+	results, err := httpClient.ParseBatch(names)
+	if assert.NoError(t, err, "http ParseBatch succeeds") {
+		assert.Equal(t, want, results, "http ParseBatch matches")
+	}
+	results, err = grpcClient.ParseBatch(context.Background(), names)
+	if assert.NoError(t, err, "grpc ParseBatch succeeds") {
+		assert.Equal(t, want, results, "grpc ParseBatch matches")
+	}
+}
@@ -0,0 +1,65 @@
+package gocdgrpc
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// ErrProto is returned by ParseRequest.UnmarshalProto for malformed data
+var ErrProto = errors.New("invalid protobuf ParseRequest")
+
+// ParseRequest is a gocd.v1.ParseRequest message (see
+// proto/gocd/v1/parser.proto)
+type ParseRequest struct {
+	Name string // The company name to parse
+	Lang string // Optional language hint (see gocd.Parser.ParseLang)
+}
+
+// MarshalProto encodes r as a gocd.v1.ParseRequest Protocol Buffers
+// message
+func (r *ParseRequest) MarshalProto() ([]byte, error) {
+	var b []byte
+	if r.Name != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, r.Name)
+	}
+	if r.Lang != "" {
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, r.Lang)
+	}
+	return b, nil
+}
+
+// UnmarshalProto decodes a gocd.v1.ParseRequest Protocol Buffers
+// message into r, skipping unknown fields
+func (r *ParseRequest) UnmarshalProto(b []byte) error {
+	*r = ParseRequest{}
+	for len(b) > 0 {
+		field, wire, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return fmt.Errorf("%w: %v", ErrProto, protowire.ParseError(n))
+		}
+		b = b[n:]
+		if wire == protowire.BytesType && (field == 1 || field == 2) {
+			s, n := protowire.ConsumeString(b)
+			if n < 0 {
+				return fmt.Errorf("%w: field %d: %v", ErrProto, field, protowire.ParseError(n))
+			}
+			if field == 1 {
+				r.Name = s
+			} else {
+				r.Lang = s
+			}
+			b = b[n:]
+			continue
+		}
+		n = protowire.ConsumeFieldValue(field, wire, b)
+		if n < 0 {
+			return fmt.Errorf("%w: field %d: %v", ErrProto, field, protowire.ParseError(n))
+		}
+		b = b[n:]
+	}
+	return nil
+}
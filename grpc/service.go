@@ -0,0 +1,163 @@
+/*
+gocdgrpc provides a gRPC API for gocd, implementing the
+gocd.v1.ParserService defined in proto/gocd/v1/parser.proto:
+
+	s := grpc.NewServer(gocdgrpc.ServerCodec())
+	gocdgrpc.Register(s, updater.Parser)
+
+ParseStream is a bidirectional streaming RPC for high-volume clients:
+names are streamed in and Results are streamed back in order as each
+name is parsed, avoiding per-call overhead, with gRPC flow control
+providing backpressure.
+
+Client is a client for the API, implementing gocd.NameParser:
+
+	conn, err := grpc.Dial("gocd.internal:50051", grpc.WithTransportCredentials(creds))
+	var parser gocd.NameParser = gocdgrpc.NewClient(conn)
+	res, err := parser.Parse("Profound Networks LLC")
+
+Messages are encoded with gocd.Result's MarshalProto and
+UnmarshalProto rather than generated code, by the codec named
+CodecName, using the standard protobuf wire format. Clients may be
+generated from the .proto files in any language, if the server uses
+ServerCodec.
+*/
+package gocdgrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ProfoundNetworks/gocd"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ServiceName is the full name of the gRPC service
+const ServiceName = "gocd.v1.ParserService"
+
+// Full method names, for interceptors
+const (
+	ParseMethod       = "/" + ServiceName + "/Parse"
+	ParseStreamMethod = "/" + ServiceName + "/ParseStream"
+)
+
+// service implements ParserService
+type service struct {
+	parser func() *gocd.Parser
+}
+
+// serviceDesc describes ParserService, as generated code would
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Parse", Handler: parseHandler},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ParseStream",
+			Handler:       parseStreamHandler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "gocd/v1/parser.proto",
+}
+
+// Register registers ParserService with s, parsing with the Parser
+// returned by parser, which is called for each call so the Parser may
+// be swapped (e.g. by gocd.Updater.Parser). Calls fail with an
+// Unavailable status while parser returns nil.
+func Register(s grpc.ServiceRegistrar, parser func() *gocd.Parser) {
+	s.RegisterService(&serviceDesc, &service{parser: parser})
+}
+
+func parseHandler(srv interface{}, ctx context.Context, dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(ParseRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	s := srv.(*service)
+	if interceptor == nil {
+		return s.parse(ctx, req)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: ParseMethod}
+	return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.parse(ctx, req.(*ParseRequest))
+	})
+}
+
+func parseStreamHandler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(*service).parseStream(stream)
+}
+
+// currentParser returns the current Parser, or an Unavailable error if
+// there is none
+func (s *service) currentParser() (*gocd.Parser, error) {
+	p := s.parser()
+	if p == nil {
+		return nil, status.Error(codes.Unavailable, "parser not ready")
+	}
+	return p, nil
+}
+
+func (s *service) parse(ctx context.Context, req *ParseRequest) (*gocd.Result, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing name")
+	}
+	p, err := s.currentParser()
+	if err != nil {
+		return nil, err
+	}
+	res, err := p.ParseLangContext(ctx, req.Name, req.Lang)
+	if err != nil {
+		return nil, parseError(err)
+	}
+	return res, nil
+}
+
+// parseStream parses each name received on stream, sending its Result
+// before receiving the next, so Results are in order and a slow client
+// stops the stream being read
+func (s *service) parseStream(stream grpc.ServerStream) error {
+	p, err := s.currentParser()
+	if err != nil {
+		return err
+	}
+	for n := 1; ; n++ {
+		var req ParseRequest
+		if err := stream.RecvMsg(&req); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if req.Name == "" {
+			return status.Errorf(codes.InvalidArgument, "name %d: missing name", n)
+		}
+		res, err := p.ParseLangContext(stream.Context(), req.Name, req.Lang)
+		if err != nil {
+			return parseError(fmt.Errorf("name %d: %w", n, err))
+		}
+		if err := stream.SendMsg(res); err != nil {
+			return err
+		}
+	}
+}
+
+// parseError returns a status error for a Parse error
+func parseError(err error) error {
+	code := codes.Internal
+	switch {
+	case errors.Is(err, gocd.ErrInvalidUTF8), errors.Is(err, gocd.ErrInputTooLong):
+		code = codes.InvalidArgument
+	case errors.Is(err, gocd.ErrParseTimeout):
+		code = codes.DeadlineExceeded
+	}
+	return status.Error(code, err.Error())
+}
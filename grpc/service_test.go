@@ -0,0 +1,169 @@
+package gocdgrpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/ProfoundNetworks/gocd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/proto"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// testConn returns a client connection to an in-memory server for
+// ParserService parsing with the Parser returned by parser, selecting
+// the ParserService codec for all calls
+func testConn(t *testing.T, parser func() *gocd.Parser) *grpc.ClientConn {
+	return testConnOpts(t, parser, nil, grpc.WithDefaultCallOptions(grpc.CallContentSubtype(CodecName)))
+}
+
+// testConnOpts is a version of testConn taking server and dial options
+func testConnOpts(t *testing.T, parser func() *gocd.Parser, serverOpts []grpc.ServerOption,
+	dialOpts ...grpc.DialOption) *grpc.ClientConn {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer(serverOpts...)
+	Register(s, parser)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	dialOpts = append(dialOpts,
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial("passthrough:///bufnet", dialOpts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// protoCodec is the ParserService codec under the default codec's
+// name, standing in for the codec of generated clients
+type protoCodec struct {
+	codec
+}
+
+func (protoCodec) Name() string {
+	return proto.Name
+}
+
+func TestServerCodec(t *testing.T) {
+	p, err := gocd.New()
+	require.NoError(t, err)
+	ctx := context.Background()
+	req := &ParseRequest{Name: "Acme Ltd"}
+
+	// The default codec is unaffected
+	conn := testConnOpts(t, func() *gocd.Parser { return p }, nil)
+	err = conn.Invoke(ctx, ParseMethod, req, &gocd.Result{}, grpc.ForceCodec(protoCodec{newCodec()}))
+	assert.Equal(t, codes.Internal, status.Code(err), "default codec fails")
+
+	// Unless the server uses ServerCodec
+	conn = testConnOpts(t, func() *gocd.Parser { return p }, []grpc.ServerOption{ServerCodec()})
+	var res gocd.Result
+	err = conn.Invoke(ctx, ParseMethod, req, &res, grpc.ForceCodec(protoCodec{newCodec()}))
+	require.NoError(t, err)
+	assert.Equal(t, "Ltd", res.Designator, "Designator matches")
+}
+
+func TestParseRequestProto(t *testing.T) {
+	req := ParseRequest{Name: "Acme Ltd", Lang: "en"}
+	b, err := req.MarshalProto()
+	require.NoError(t, err)
+
+	// Unknown fields are skipped
+	b = append(b, 3<<3|0, 1)
+	var got ParseRequest
+	require.NoError(t, got.UnmarshalProto(b))
+	assert.Equal(t, req, got)
+
+	assert.ErrorIs(t, got.UnmarshalProto([]byte{1<<3 | 2, 10, 'A'}), ErrProto)
+}
+
+func TestParse(t *testing.T) {
+	p, err := gocd.New(gocd.WithInvalidUTF8(gocd.RejectInvalidUTF8))
+	require.NoError(t, err)
+	conn := testConn(t, func() *gocd.Parser { return p })
+	ctx := context.Background()
+
+	var res gocd.Result
+	err = conn.Invoke(ctx, ParseMethod, &ParseRequest{Name: "Acme Ltd"}, &res)
+	require.NoError(t, err)
+	want, err := p.Parse("Acme Ltd")
+	require.NoError(t, err)
+	want.Entry = nil
+	assert.Equal(t, *want, res)
+
+	err = conn.Invoke(ctx, ParseMethod, &ParseRequest{}, &res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "missing name")
+
+	err = conn.Invoke(ctx, ParseMethod, &ParseRequest{Name: "Acme \xff Ltd"}, &res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "invalid UTF-8")
+
+	// Not ready
+	conn = testConn(t, func() *gocd.Parser { return nil })
+	err = conn.Invoke(ctx, ParseMethod, &ParseRequest{Name: "Acme Ltd"}, &res)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestParseStream(t *testing.T) {
+	p, err := gocd.New(gocd.WithInvalidUTF8(gocd.RejectInvalidUTF8))
+	require.NoError(t, err)
+	conn := testConn(t, func() *gocd.Parser { return p })
+	ctx := context.Background()
+
+	stream, err := conn.NewStream(ctx, &serviceDesc.Streams[0], ParseStreamMethod)
+	require.NoError(t, err)
+
+	// Results are returned in order, while names are still being sent
+	var names []string
+	for i := 0; i < 1000; i++ {
+		names = append(names, fmt.Sprintf("Acme %d Ltd", i), fmt.Sprintf("GmbH Acme %d", i), fmt.Sprintf("Acme %d", i))
+	}
+	errc := make(chan error, 1)
+	go func() {
+		for _, name := range names {
+			if err := stream.SendMsg(&ParseRequest{Name: name}); err != nil {
+				errc <- err
+				return
+			}
+		}
+		errc <- stream.CloseSend()
+	}()
+	for _, name := range names {
+		var res gocd.Result
+		require.NoError(t, stream.RecvMsg(&res))
+		want, err := p.Parse(name)
+		require.NoError(t, err)
+		want.Entry = nil
+		assert.Equal(t, *want, res)
+	}
+	require.NoError(t, <-errc)
+	assert.Equal(t, io.EOF, stream.RecvMsg(&gocd.Result{}))
+
+	// Parse errors abort the stream
+	stream, err = conn.NewStream(ctx, &serviceDesc.Streams[0], ParseStreamMethod)
+	require.NoError(t, err)
+	require.NoError(t, stream.SendMsg(&ParseRequest{Name: "Acme Ltd"}))
+	require.NoError(t, stream.SendMsg(&ParseRequest{Name: "Acme \xff Ltd"}))
+	require.NoError(t, stream.RecvMsg(&gocd.Result{}))
+	err = stream.RecvMsg(&gocd.Result{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "name 2")
+
+	// As are missing names
+	stream, err = conn.NewStream(ctx, &serviceDesc.Streams[0], ParseStreamMethod)
+	require.NoError(t, err)
+	require.NoError(t, stream.SendMsg(&ParseRequest{}))
+	err = stream.RecvMsg(&gocd.Result{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "missing name")
+	assert.Contains(t, err.Error(), "name 1")
+}
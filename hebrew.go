@@ -0,0 +1,26 @@
+package gocd
+
+import "regexp"
+
+// Hebrew abbreviations mark omitted letters with gershayim, written as
+// the Hebrew punctuation mark (U+05F4), an ASCII or typographic double
+// quote, or two apostrophes, and often omitted altogether in registry
+// data (e.g. "בע״מ", "בע"מ", "בע''מ", "בעמ"). GershayimTransform makes
+// designator patterns match each of these, and addDesTokens treats
+// gershayim as optional separators for the final-token prefilter.
+
+// reDesGershayim matches gershayim following a Hebrew letter in a
+// designator
+var reDesGershayim = regexp.MustCompile(`(\p{Hebrew}\pM*)["\x{05F4}]`)
+
+// GershayimTransform allows the gershayim in Hebrew designators (e.g.
+// "בע"מ") to match any of their spellings, or be omitted
+func GershayimTransform(des string) string {
+	return reDesGershayim.ReplaceAllString(des, `$1(?:["\x{05F4}\x{201C}\x{201D}]|'')?`)
+}
+
+// isGershayimSep returns true if sep is a gershayim (cf.
+// GershayimTransform)
+func isGershayimSep(sep string) bool {
+	return sep == `"` || sep == "״"
+}
@@ -0,0 +1,53 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGershayim(t *testing.T) {
+	tests := []struct {
+		input     string
+		shortName string
+		des       string
+	}{
+		{`אבגד בע"מ`, "אבגד", `בע"מ`},
+		{"אבגד בע״מ", "אבגד", "בע״מ"},
+		{"אבגד בע”מ", "אבגד", `בע"מ`},
+		{"אבגד בע''מ", "אבגד", "בע''מ"},
+		{"אבגד בע’’מ", "אבגד", "בע''מ"},
+		{"אבגד בעמ", "אבגד", "בעמ"},
+		{`אבגד, בע"מ`, "אבגד", `בע"מ`},
+		{`אבגד-בע"מ`, "אבגד", `בע"מ`},
+		{"אבגד בערבון מוגבל", "אבגד", "בערבון מוגבל"},
+	}
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, res.Matched, "Matched for %+q", tc.input)
+		assert.Equal(t, tc.shortName, res.ShortName, "ShortName matches for %+q", tc.input)
+		assert.Equal(t, tc.des, res.Designator, "Designator matches for %+q", tc.input)
+		assert.Equal(t, `בע"מ`, res.DesignatorStd, "DesignatorStd matches for %+q", tc.input)
+		assert.Equal(t, "he", res.Lang, "Lang matches for %+q", tc.input)
+	}
+
+	// Typographic gershayim match without quote normalisation
+	p, err = p.Clone(WithoutQuoteNormalization())
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := "אבגד בע”מ"
+	res, err := p.Parse(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "בע”מ", res.Designator, "Designator matches for %+q", input)
+	assert.Equal(t, `בע"מ`, res.DesignatorStd, "DesignatorStd matches for %+q", input)
+}
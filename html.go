@@ -0,0 +1,27 @@
+package gocd
+
+import (
+	"html"
+	"strings"
+)
+
+// UnescapeHTML is a Preprocessor that decodes HTML entities in input
+// (e.g. "Acme &amp; Co. Ltd", "S&#46;A&#46;"), including doubly-encoded
+// entities (e.g. "&amp;amp;"), which are common in scraped data
+func UnescapeHTML(input string) string {
+	for i := 0; i < 2 && strings.IndexByte(input, '&') >= 0; i++ {
+		s := html.UnescapeString(input)
+		if s == input {
+			break
+		}
+		input = s
+	}
+	return input
+}
+
+// WithHTMLEntityDecoding decodes HTML entities in input before matching,
+// adding UnescapeHTML to the Parser's preprocessors (see
+// WithPreprocessor)
+func WithHTMLEntityDecoding() Option {
+	return WithPreprocessor(UnescapeHTML)
+}
@@ -0,0 +1,39 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTMLEntityDecoding(t *testing.T) {
+	p, err := New(WithHTMLEntityDecoding())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input      string
+		shortName  string
+		designator string
+	}{
+		{"Acme &amp; Co. Ltd", "Acme", "Co. Ltd"},
+		{"Acme S&#46;A&#46;", "Acme", "S.A."},
+		{"Acme S&#x2E;A&#x2E;", "Acme", "S.A."},
+		{"Smith &amp;amp; Sons Ltd", "Smith & Sons", "Ltd"},
+		{"Soci&eacute;t&eacute; G&eacute;n&eacute;rale S.A.", "Société Générale", "S.A."},
+		{"Acme&nbsp;Widgets&nbsp;Ltd", "Acme Widgets", "Ltd"},
+		{"Acme & Co. Ltd", "Acme", "Co. Ltd"},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, res.Matched, "Matched for %q", tc.input)
+		assert.Equal(t, tc.shortName, res.ShortName, "ShortName matches for %q", tc.input)
+		assert.Equal(t, tc.designator, res.Designator, "Designator matches for %q", tc.input)
+	}
+
+	assert.Equal(t, "AT&amp;T", UnescapeHTML("AT&amp;amp;amp;T"), "UnescapeHTML decodes at most twice")
+}
@@ -0,0 +1,275 @@
+package gocd
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	reIndexMarks = regexp.MustCompile(`\pM`)
+	reIndexSpace = regexp.MustCompile(`\pZ+`)
+	reIndexLoose = regexp.MustCompile(`[^\pL\pN&]+`)
+)
+
+// desRef references a designator string within the dataset
+type desRef struct {
+	long  string // The dataset entry key (long name)
+	des   string // The dataset designator string (long name or abbreviation)
+	regex bool   // True if the designator is a regex (abbr_re) abbreviation
+}
+
+// desRE is a regex (abbr_re) designator and its desRef
+type desRE struct {
+	re  *regexp.Regexp
+	ref desRef
+}
+
+// desIndex maps normalised designator strings back to the dataset
+// entries they come from. Lookups try a loose match ignoring case,
+// diacritics, punctuation and whitespace, and then any regex
+// designators. Where a key is ambiguous the candidate spelled most like
+// the matched designator wins (see bestRef), with entries visited in
+// sorted order for determinism.
+type desIndex struct {
+	loose map[string][]desRef
+	res   []desRE
+	std   map[string]bool // Entries (long names) with an AbbrStd
+}
+
+// exactKey returns the exact index key for des
+func exactKey(des string) string {
+	s := norm.NFD.String(foldArabicString(des))
+	s = reIndexMarks.ReplaceAllString(s, "")
+	s = reIndexSpace.ReplaceAllString(s, " ")
+	return foldSpecial(strings.ToLower(strings.TrimSpace(s)))
+}
+
+// looseKey returns the loose index key for des
+func looseKey(des string) string {
+	s := strings.Replace(exactKey(des), "+", "&", -1)
+	return reIndexLoose.ReplaceAllString(s, "")
+}
+
+func (idx *desIndex) add(long, des string) {
+	if k := looseKey(des); k != "" {
+		idx.loose[k] = append(idx.loose[k], desRef{long: long, des: des})
+	}
+}
+
+// newDesIndex returns the designator index for ds, or an
+// ErrPatternCompile error if a regex designator doesn't compile
+func newDesIndex(ds *dataset) (*desIndex, error) {
+	idx := desIndex{
+		loose: make(map[string][]desRef),
+		std:   make(map[string]bool),
+	}
+
+	longs := make([]string, 0, len(*ds))
+	for long := range *ds {
+		longs = append(longs, long)
+	}
+	sort.Strings(longs)
+
+	for _, long := range longs {
+		idx.add(long, long)
+		if (*ds)[long].AbbrStd != "" {
+			idx.std[long] = true
+		}
+		for _, a := range (*ds)[long].Abbr {
+			idx.add(long, a)
+		}
+		// Regex designators have no literal form, so reference the long name
+		for _, a := range (*ds)[long].AbbrRE {
+			re, err := compileRE(`(?i)^\pZ*\(?(?:` + norm.NFD.String(a) + `)\)?\pZ*$`)
+			if err != nil {
+				return nil, err
+			}
+			idx.res = append(idx.res, desRE{re: re, ref: desRef{long: long, des: long, regex: true}})
+		}
+	}
+
+	return &idx, nil
+}
+
+// lookup returns the desRef for the (matched) designator des, if found
+func (idx *desIndex) lookup(des string) (desRef, bool) {
+	if refs, ok := idx.loose[looseKey(des)]; ok {
+		return idx.bestRef(des, refs), true
+	}
+	if len(idx.res) > 0 {
+		desNFD := norm.NFD.String(des)
+		for _, dre := range idx.res {
+			if dre.re.MatchString(desNFD) {
+				return dre.ref, true
+			}
+		}
+	}
+	return desRef{}, false
+}
+
+// bestRef returns the candidate in refs spelled most like des: the
+// one spelled exactly like des apart from case and diacritics, then the
+// one agreeing with des on the case of the most letters (so "E. V." is
+// the German "e.V." rather than the Hungarian "e.v."), then one from an
+// entry with a standard abbreviation (the canonical form of an
+// abbreviation shared between entries), then one agreeing with des on
+// parentheses, and otherwise the first
+func (idx *desIndex) bestRef(des string, refs []desRef) desRef {
+	if len(refs) == 1 {
+		return refs[0]
+	}
+	key := exactKey(des)
+	parens := strings.ContainsAny(des, "()")
+	score := func(ref desRef) []int {
+		return []int{
+			boolInt(exactKey(ref.des) == key),
+			caseAgreement(des, ref.des),
+			boolInt(idx.std[ref.long]),
+			boolInt(strings.ContainsAny(ref.des, "()") == parens),
+		}
+	}
+
+	best, bestScore := refs[0], score(refs[0])
+	for _, ref := range refs[1:] {
+		s := score(ref)
+		for i := range s {
+			if s[i] != bestScore[i] {
+				if s[i] > bestScore[i] {
+					best, bestScore = ref, s
+				}
+				break
+			}
+		}
+	}
+	return best
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// caseAgreement returns the number of letters of a and b (compared in
+// order, ignoring diacritics and all other characters) with the same case
+func caseAgreement(a, b string) int {
+	letters := func(s string) []rune {
+		s = reIndexMarks.ReplaceAllString(norm.NFD.String(s), "")
+		return []rune(strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) {
+				return r
+			}
+			return -1
+		}, s))
+	}
+	ra, rb := letters(a), letters(b)
+	n := 0
+	for i := 0; i < len(ra) && i < len(rb); i++ {
+		if ra[i] == rb[i] {
+			n++
+		}
+	}
+	return n
+}
+
+// StdVariants maps alternate spellings of dataset abbreviations to the
+// abbreviation of the same entry they standardise to (see
+// Result.DesignatorStd), for entries without an AbbrStd
+var StdVariants = map[string]string{
+	"Co.,Ltd.":   "Co. Ltd.",
+	"Sp. z.o.o.": "Sp. z o.o.",
+	"SA/NV":      "S.A./N.V.",
+	"S.À.R.L.":   "S.à r.l.",
+	"S.A.R.L.":   "S.à r.l.",
+	"SRL":        "S.à r.l.", // vs. the Spanish `S.R.L.` and Italian `S.r.l.`
+}
+
+// refStd returns the standardised form of the designator referenced by
+// ref, which is the AbbrStd of the dataset entry, if set, or otherwise
+// the dataset designator string itself or its StdVariants form
+func (p *Parser) refStd(ref desRef) string {
+	if ref.long == "" {
+		return ""
+	}
+	e := (*p.ds)[ref.long]
+	if e.AbbrStd != "" {
+		return e.AbbrStd
+	}
+	if std, ok := StdVariants[ref.des]; ok {
+		for _, a := range e.Abbr {
+			if a == std {
+				return std
+			}
+		}
+	}
+	return ref.des
+}
+
+// Lookup returns the dataset Entry for the designator des (e.g. "LLC",
+// "L.L.C.", "Limited Liability Company"), and false if des is unknown
+func (p *Parser) Lookup(des string) (*Entry, bool) {
+	ref, ok := p.idx.lookup(des)
+	if !ok {
+		return nil, false
+	}
+	return (*p.ds)[ref.long], true
+}
+
+// Expand returns the long form of the designator des e.g. "Limited
+// Liability Company" for "LLC", and false if des is unknown
+func (p *Parser) Expand(des string) (string, bool) {
+	e, ok := p.Lookup(des)
+	if !ok {
+		return "", false
+	}
+	return e.LongName, true
+}
+
+// Synonyms returns the known variants of the designator des: the long
+// form, the standard abbreviation (if any), and all abbreviations, each
+// followed by its diacritic-stripped form where that differs. Regex
+// (abbr_re) abbreviations have no literal form and are not included.
+// Returns nil if des is unknown.
+func (p *Parser) Synonyms(des string) []string {
+	e, ok := p.Lookup(des)
+	if !ok {
+		return nil
+	}
+
+	var synonyms []string
+	seen := make(map[string]bool)
+	add := func(s string) {
+		if s != "" && !seen[s] {
+			seen[s] = true
+			synonyms = append(synonyms, s)
+		}
+	}
+	for _, s := range append([]string{e.LongName, e.AbbrStd}, e.Abbr...) {
+		add(s)
+		add(norm.NFC.String(reIndexMarks.ReplaceAllString(norm.NFD.String(s), "")))
+	}
+	return synonyms
+}
+
+// DesignatorsEquivalent returns true if the designators a and b (e.g.
+// "LLC" and "L.L.C.") refer to the same dataset entry, or to entries
+// sharing a standard abbreviation (an equivalent legal form)
+func (p *Parser) DesignatorsEquivalent(a, b string) bool {
+	ea, ok := p.Lookup(a)
+	if !ok {
+		return false
+	}
+	eb, ok := p.Lookup(b)
+	if !ok {
+		return false
+	}
+	if ea == eb {
+		return true
+	}
+	return ea.AbbrStd != "" && exactKey(ea.AbbrStd) == exactKey(eb.AbbrStd)
+}
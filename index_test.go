@@ -0,0 +1,151 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexCollisions(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input string
+		std   string
+		lang  string
+	}{
+		{"Verein Fuer Jugendpflege E. V.", "e.V.", "de"},
+		{"Acme Egyesület e.v.", "e.v.", "hu"},
+		{"Société Générale SA", "SA", "fr"},
+		{"MIH Internet Africa (Pty) Ltd", "(Pty.) Ltd.", "en"},
+		{"Open Fusion Pty Ltd", "Pty. Ltd.", "en"},
+		{"Hakuyosha Co.,Ltd.", "Co. Ltd.", "en"},
+		{"ALATRON LERÉVEIL SRL", "S.à r.l.", "fr"},
+		{"Epithelix SàRL", "SàRL", "fr"},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, res.Matched, "Matched for %q", tc.input)
+		assert.Equal(t, tc.std, res.DesignatorStd, "DesignatorStd matches for %q", tc.input)
+		assert.Equal(t, tc.lang, res.Lang, "Lang matches for %q", tc.input)
+	}
+}
+
+func TestEntry(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := p.Parse("Profound Networks L.L.C.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.NotNil(t, res.Entry, "Entry set") {
+		assert.Equal(t, "Limited Liability Company", res.Entry.LongName, "Entry.LongName matches")
+		assert.Equal(t, "LLC", res.Entry.AbbrStd, "Entry.AbbrStd matches")
+		assert.Equal(t, "en", res.Entry.Lang, "Entry.Lang matches")
+		assert.True(t, res.Entry.Lead, "Entry.Lead matches")
+	}
+
+	res, err = p.Parse("Acme & Sons")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, res.Entry, "Entry unset without match")
+
+	e, ok := p.Lookup("ООО")
+	if assert.True(t, ok, "Lookup ok") {
+		assert.Equal(t, "ru", e.Lang, "Lookup Lang matches")
+		assert.Contains(t, e.Abbr, "ООО", "Lookup Abbr matches")
+	}
+	e, ok = p.Lookup("厂")
+	if assert.True(t, ok, "Lookup ok") {
+		assert.NotEmpty(t, e.Doc, "Lookup Doc set")
+	}
+	_, ok = p.Lookup("Widgets")
+	assert.False(t, ok, "Lookup of unknown designator fails")
+}
+
+func TestExpand(t *testing.T) {
+	tests := []struct {
+		des  string
+		long string
+	}{
+		{"LLC", "Limited Liability Company"},
+		{"l.l.c.", "Limited Liability Company"},
+		{"GmbH", "Gesellschaft mit beschränkter Haftung"},
+		{"Ltd", "Limited"},
+		{"Limited", "Limited"},
+	}
+
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range tests {
+		long, ok := p.Expand(tc.des)
+		assert.True(t, ok, "Expand ok for %q", tc.des)
+		assert.Equal(t, tc.long, long, "Expand matches for %q", tc.des)
+	}
+	_, ok := p.Expand("Widgets")
+	assert.False(t, ok, "Expand of unknown designator fails")
+
+	res, err := p.Parse("Acme GmbH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "Gesellschaft mit beschränkter Haftung", res.DesignatorLong, "DesignatorLong matches")
+}
+
+func TestSynonyms(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []string{
+		"Société à responsabilité limitée", "Societe a responsabilite limitee",
+		"S.à r.l.", "S.a r.l.", "S.À.R.L.", "S.A.R.L.", "SàRL", "SaRL", "SRL",
+	}, p.Synonyms("SARL"), "Synonyms match")
+	assert.Contains(t, p.Synonyms("llc"), "L.L.C.", "Synonyms of lowercase designator")
+	assert.Nil(t, p.Synonyms("Widgets"), "Synonyms of unknown designator is nil")
+}
+
+func TestDesignatorsEquivalent(t *testing.T) {
+	overlay := []byte(`
+Limited Liability Co:
+  abbr_std: LLC
+  abbr:
+    - L.L.Co.
+  lang: en
+`)
+	p, err := New(WithOverlay(overlay))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		a, b       string
+		equivalent bool
+	}{
+		{"LLC", "L.L.C.", true},
+		{"Ltd", "Limited", true},
+		{"gmbh", "GmbH", true},
+		{"L.L.Co.", "LLC", true},
+		{"LLC", "GmbH", false},
+		{"LLC", "Widgets", false},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.equivalent, p.DesignatorsEquivalent(tc.a, tc.b),
+			"DesignatorsEquivalent(%q, %q)", tc.a, tc.b)
+	}
+}
@@ -0,0 +1,46 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvisible(t *testing.T) {
+	tests := []struct {
+		input string
+		short string
+		des   string
+	}{
+		{"Acme\u00a0Corp\u00a0Ltd", "Acme Corp", "Ltd"},
+		{"\ufeffAcme GmbH", "Acme", "GmbH"},
+		{"Acme\u200b GmbH\u200b", "Acme", "GmbH"},
+		{"Acme \u200dLLC", "Acme", "LLC"},
+	}
+
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk, err := New(WithoutInvisibleCleanup())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.input, res.Input, "Input matches")
+		assert.True(t, res.Matched, "Matched")
+		assert.Equal(t, tc.short, res.ShortName, "ShortName matches")
+		assert.Equal(t, tc.des, res.Designator, "Designator matches")
+	}
+
+	res, err := pk.Parse("Acme\u200b GmbH\u200b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, res.Matched, "Matched with WithoutInvisibleCleanup")
+}
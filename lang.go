@@ -0,0 +1,66 @@
+package gocd
+
+import (
+	"context"
+	"sync"
+)
+
+// langCache holds the language-specific Parsers used by ParseLang,
+// which are compiled on first use
+type langCache struct {
+	sync.Mutex
+	parsers map[string]*Parser
+}
+
+// forLang returns a Parser restricted to the dataset entries for lang
+// (including LangOnly entries), compiling it on first use
+func (p *Parser) forLang(lang string) (*Parser, error) {
+	p.langs.Lock()
+	defer p.langs.Unlock()
+
+	if lp, exists := p.langs.parsers[lang]; exists {
+		return lp, nil
+	}
+
+	lp := *p
+	if p.cache != nil {
+		lp.cache = newResultCache(p.opts.cacheSize)
+	}
+	ds := p.ds.filter(func(e *Entry) bool { return e.Lang == lang })
+	err := lp.compile(ds, ds, nil)
+	if err != nil {
+		return nil, err
+	}
+	p.langs.parsers[lang] = &lp
+
+	return &lp, nil
+}
+
+// ParseLang is a version of Parse that takes a language hint, lang
+// (an ISO 639-1 code e.g. "en"). Matching is restricted to designators
+// for that language, including those flagged as LangOnly, which never
+// match without a hint. If lang is empty, ParseLang is equivalent to
+// Parse.
+func (p *Parser) ParseLang(input, lang string) (*Result, error) {
+	if lang == "" {
+		return p.Parse(input)
+	}
+	lp, err := p.forLang(lang)
+	if err != nil {
+		return nil, err
+	}
+	return lp.Parse(input)
+}
+
+// ParseLangContext is a version of ParseLang that abandons matching when
+// ctx is done, like ParseContext
+func (p *Parser) ParseLangContext(ctx context.Context, input, lang string) (*Result, error) {
+	if lang == "" {
+		return p.ParseContext(ctx, input)
+	}
+	lp, err := p.forLang(lang)
+	if err != nil {
+		return nil, err
+	}
+	return lp.ParseContext(ctx, input)
+}
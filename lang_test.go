@@ -0,0 +1,62 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLang(t *testing.T) {
+	overlay := []byte(`
+Widget Company:
+  abbr:
+    - WCo
+  lang: en
+  lang_only: Y
+`)
+	tests := []struct {
+		input   string
+		lang    string
+		matched bool
+		short   string
+		desStd  string
+	}{
+		{"Acme S L", "", true, "Acme", "S.L."},
+		{"Acme S L", "en", false, "Acme S L", ""},
+		{"Acme S L", "es", true, "Acme", "S.L."},
+		{"Acme Inc.", "fr", true, "Acme", "Inc."},
+		{"Acme WCo", "", false, "Acme WCo", ""},
+		{"Acme WCo", "en", true, "Acme", "WCo"},
+		{"Acme WCo", "de", false, "Acme WCo", ""},
+		{"Acme GmbH", "xx", false, "Acme GmbH", ""},
+	}
+
+	p, err := New(WithOverlay(overlay))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range tests {
+		res, err := p.ParseLang(tc.input, tc.lang)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.matched, res.Matched, "Matched matches for %q/%q", tc.input, tc.lang)
+		assert.Equal(t, tc.short, res.ShortName, "ShortName matches for %q/%q", tc.input, tc.lang)
+		assert.Equal(t, tc.desStd, res.DesignatorStd, "DesignatorStd matches for %q/%q", tc.input, tc.lang)
+		if tc.matched && tc.lang != "" {
+			assert.Equal(t, tc.lang, res.Lang, "Lang matches for %q/%q", tc.input, tc.lang)
+		}
+	}
+
+	// Lang parsers are cached
+	lp, err := p.forLang("en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lp2, err := p.forLang("en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Same(t, lp, lp2, "lang parser cached")
+}
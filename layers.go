@@ -0,0 +1,102 @@
+package gocd
+
+import (
+	"embed"
+	"fmt"
+)
+
+// Layer names an optional embedded dataset layer, merged over the
+// default dataset with WithLayer
+type Layer string
+
+const (
+	NonprofitLayer  Layer = "nonprofit"  // Nonprofit and association forms e.g. Stiftung, CIC
+	GovernmentLayer Layer = "government" // Public-sector forms e.g. Authority, AöR, ФГУП
+)
+
+// Entry and Result categories set by the embedded layers. Companies have
+// an empty category.
+const (
+	CategoryNonprofit  = "nonprofit"
+	CategoryGovernment = "government"
+)
+
+//go:embed layers/*.yml
+var layerFS embed.FS
+
+// WithLayer merges the optional embedded dataset layer l over the
+// default dataset, before any overlays (see WithOverlay). Multiple
+// layers are applied in order.
+func WithLayer(l Layer) Option {
+	return func(o *options) {
+		o.layers = append(o.layers, l)
+	}
+}
+
+// loadLayer returns the YAML data for the embedded layer l
+func loadLayer(l Layer) ([]byte, error) {
+	data, err := layerFS.ReadFile("layers/" + string(l) + ".yml")
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown layer %q", ErrDatasetOpen, l)
+	}
+	return data, nil
+}
+
+// mergeLayers merges the embedded layers into ds
+func (ds *dataset) mergeLayers(layers []Layer) error {
+	for _, l := range layers {
+		data, err := loadLayer(l)
+		if err != nil {
+			return err
+		}
+		err = ds.merge(data, string(l), ExtensionPriority)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Dataset layer sources, reported in Entry.Source and Result.Source.
+// Embedded layers (see WithLayer) are reported by their Layer name.
+const (
+	SourceCore    = "core"    // The default dataset, or one supplied to NewFromDataset
+	SourceOverlay = "overlay" // WithOverlay overlays
+)
+
+// Dataset layer priorities. Entries from a layer replace those with the
+// same long name from layers with the same or lower priority; within a
+// priority, later layers win.
+const (
+	CorePriority      = 0
+	ExtensionPriority = 100 // Embedded layers (see WithLayer)
+	OverlayPriority   = 200 // WithOverlay overlays
+)
+
+// datasetLayer is a dataset layer added with WithDatasetLayer
+type datasetLayer struct {
+	name     string
+	priority int
+	data     []byte
+}
+
+// WithDatasetLayer merges the YAML dataset data (in the upstream
+// dataset schema, as for WithOverlay) as a named layer with the given
+// priority (see CorePriority etc.), after any layers and overlays.
+// Entries matched from the layer report name as their Source.
+func WithDatasetLayer(name string, priority int, data []byte) Option {
+	return func(o *options) {
+		o.datasetLayers = append(o.datasetLayers, datasetLayer{name, priority, data})
+	}
+}
+
+// mergeDatasetLayers merges the WithDatasetLayer layers into ds
+func (ds *dataset) mergeDatasetLayers(layers []datasetLayer) error {
+	for _, l := range layers {
+		err := ds.merge(l.data, l.name, l.priority)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
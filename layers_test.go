@@ -0,0 +1,166 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNonprofitLayer(t *testing.T) {
+	p, err := New(WithLayer(NonprofitLayer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	def, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input      string
+		shortName  string
+		designator string
+	}{
+		{"Bertelsmann Stiftung", "Bertelsmann", "Stiftung"},
+		{"Stichting Acme", "Acme", "Stichting"},
+		{"Acme Community Interest Company", "Acme", "Community Interest Company"},
+		{"Acme CIC", "Acme", "CIC"},
+		{"Acme gUG (haftungsbeschränkt)", "Acme", "gUG (haftungsbeschränkt)"},
+		{"Acme Foundation", "Acme", "Foundation"},
+		// Default dataset entries are unaffected
+		{"Acme GmbH", "Acme", "GmbH"},
+		{"Acme e.V.", "Acme", "e.V."},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.shortName, res.ShortName, "ShortName matches for %q", tc.input)
+		assert.Equal(t, tc.designator, res.Designator, "Designator matches for %q", tc.input)
+	}
+
+	// Layer designators don't match by default
+	res, err := def.Parse("Bertelsmann Stiftung")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, res.Matched, "Matched matches without layer")
+
+	// Layers can be added to a clone
+	c, err := def.Clone(WithLayer(NonprofitLayer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err = c.Parse("Bertelsmann Stiftung")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, res.Matched, "Matched matches with cloned layer")
+
+	_, err = New(WithLayer("bogus"))
+	assert.ErrorIs(t, err, ErrDatasetOpen, "unknown layer errors")
+}
+
+func TestGovernmentLayer(t *testing.T) {
+	p, err := New(WithLayer(GovernmentLayer))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input      string
+		shortName  string
+		designator string
+		category   string
+	}{
+		{"Port of London Authority", "Port of London", "Authority", CategoryGovernment},
+		{"Berliner Wasserbetriebe AöR", "Berliner Wasserbetriebe", "AöR", CategoryGovernment},
+		{"Gemeinde Musterdorf", "Musterdorf", "Gemeinde", CategoryGovernment},
+		{"ФГУП Почта России", "Почта России", "ФГУП", CategoryGovernment},
+		// Default dataset entries overridden by the layer
+		{"ГУП Мосгортранс", "Мосгортранс", "ГУП", CategoryGovernment},
+		// Companies have no category
+		{"Acme GmbH", "Acme", "GmbH", ""},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.shortName, res.ShortName, "ShortName matches for %q", tc.input)
+		assert.Equal(t, tc.designator, res.Designator, "Designator matches for %q", tc.input)
+		assert.Equal(t, tc.category, res.Category, "Category matches for %q", tc.input)
+	}
+
+	// Layers combine
+	p, err = New(WithLayer(GovernmentLayer), WithLayer(NonprofitLayer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := p.Parse("Bertelsmann Stiftung")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, CategoryNonprofit, res.Category, "Category matches for nonprofit")
+}
+
+func TestLayerSource(t *testing.T) {
+	overlay := []byte(`
+Limited:
+  abbr:
+    - Ltd
+    - Lmtd
+  lang: en
+`)
+	// A lower priority layer doesn't replace the overlay entry
+	extension := []byte(`
+Limited:
+  abbr:
+    - Ltd
+  lang: en
+Widgetschaft:
+  abbr:
+    - WS
+  lang: de
+`)
+	p, err := New(WithLayer(NonprofitLayer), WithOverlay(overlay),
+		WithDatasetLayer("widgets", ExtensionPriority, extension))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input  string
+		source string
+	}{
+		{"Acme GmbH", SourceCore},
+		{"Bertelsmann Stiftung", string(NonprofitLayer)},
+		{"Acme Lmtd", SourceOverlay},
+		{"Acme WS", "widgets"},
+		{"Acme", ""},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.source, res.Source, "Source matches for %q", tc.input)
+	}
+
+	// A higher priority layer replaces the overlay entry
+	c, err := p.Clone(WithDatasetLayer("pinned", OverlayPriority+1, extension))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := c.Parse("Acme Ltd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "pinned", res.Source, "Source matches for higher priority")
+	res, err = c.Parse("Acme Lmtd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, res.Matched, "replaced overlay designator not matched")
+}
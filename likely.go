@@ -0,0 +1,148 @@
+package gocd
+
+import (
+	"strings"
+	"unicode"
+)
+
+// CompanyKeywords are lowercase words that suggest a name belongs to an
+// organisation, used by IsLikelyCompany
+var CompanyKeywords = map[string]bool{
+	"agency": true, "associates": true, "bank": true, "brothers": true,
+	"capital": true, "consulting": true, "enterprises": true, "foundation": true,
+	"global": true, "group": true, "gruppe": true, "holding": true,
+	"holdings": true, "hospital": true, "hotel": true, "industries": true,
+	"institute": true, "insurance": true, "international": true, "labs": true,
+	"laboratories": true, "logistics": true, "management": true, "media": true,
+	"network": true, "networks": true, "partners": true, "pharma": true,
+	"restaurant": true, "services": true, "software": true, "solutions": true, "sons": true,
+	"studio": true, "systems": true, "technologies": true, "technology": true,
+	"trading": true, "trust": true, "university": true, "ventures": true,
+}
+
+// addressKeywords are lowercase words that suggest a street address
+var addressKeywords = map[string]bool{
+	"avenue": true, "ave": true, "blvd": true, "boulevard": true, "box": true,
+	"floor": true, "lane": true, "ln": true, "rd": true, "road": true,
+	"st": true, "street": true, "suite": true, "ste": true,
+}
+
+// Score adjustments for IsLikelyCompany signals
+const (
+	likelyBase        = 0.3
+	likelyDesignator  = 0.6
+	likelyFallback    = 0.4
+	likelyMismatch    = 0.15
+	likelyKeyword     = 0.3
+	likelyAmpersand   = 0.15
+	likelyAcronym     = 0.1
+	likelyAddress     = -0.4
+	likelyPersonShape = -0.2
+)
+
+// IsLikelyCompany is a cheap heuristic for whether name is a company
+// name rather than e.g. a person's name or an address. It combines
+// designator presence (discounted for ambiguous designators, see
+// Result.Ambiguity, and where the designator's language conflicts with
+// the name's script, see Result.LangMismatch), corporate
+// keywords (see CompanyKeywords), and token shape into a score between
+// 0 and 1, returning true if the score is at least 0.5. Names that
+// cannot be parsed score 0.
+func (p *Parser) IsLikelyCompany(name string) (bool, float64) {
+	res, err := p.Parse(name)
+	if err != nil {
+		return false, 0
+	}
+
+	score := likelyBase
+	switch {
+	case res.LangMismatch:
+		score += likelyMismatch
+	case res.MatchKind == Fallback || res.MatchKind == Glued:
+		score += likelyFallback
+	case res.Matched:
+		// Discount designators that are often normal words
+		score += likelyDesignator * (1 - res.Ambiguity)
+	}
+
+	tokens := strings.FieldsFunc(res.ShortName, func(r rune) bool {
+		return unicode.IsSpace(r) || r == ','
+	})
+	keyword, address, acronym := false, false, false
+	for _, tok := range tokens {
+		lower := strings.ToLower(strings.TrimRight(tok, "."))
+		if CompanyKeywords[lower] {
+			keyword = true
+		}
+		if addressKeywords[lower] {
+			address = true
+		}
+		if isAcronym(tok) {
+			acronym = true
+		}
+	}
+	if keyword {
+		score += likelyKeyword
+	}
+	if acronym {
+		score += likelyAcronym
+	}
+	if strings.Contains(res.ShortName, "&") {
+		score += likelyAmpersand
+	}
+	// Addresses usually lead with a street number
+	if address || (len(tokens) > 1 && isNumeric(tokens[0])) {
+		score += likelyAddress
+	}
+	if !res.Matched && !keyword && isPersonShape(tokens) {
+		score += likelyPersonShape
+	}
+
+	if score < 0 {
+		score = 0
+	} else if score > 1 {
+		score = 1
+	}
+	return score >= 0.5, score
+}
+
+// isAcronym returns true if tok is an all-uppercase word of 2-5 letters
+func isAcronym(tok string) bool {
+	n := 0
+	for _, r := range tok {
+		if !unicode.IsUpper(r) {
+			return false
+		}
+		n++
+	}
+	return n >= 2 && n <= 5
+}
+
+// isNumeric returns true if tok starts with a digit
+func isNumeric(tok string) bool {
+	for _, r := range tok {
+		return unicode.IsDigit(r)
+	}
+	return false
+}
+
+// isPersonShape returns true if tokens look like a personal name: two or
+// three capitalised words or initials
+func isPersonShape(tokens []string) bool {
+	if len(tokens) < 2 || len(tokens) > 3 {
+		return false
+	}
+	for _, tok := range tokens {
+		first := true
+		for _, r := range strings.TrimRight(tok, ".") {
+			if (first && !unicode.IsUpper(r)) || (!first && !unicode.IsLower(r) && r != '-' && r != '\'') {
+				return false
+			}
+			first = false
+		}
+		if first {
+			return false
+		}
+	}
+	return true
+}
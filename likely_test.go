@@ -0,0 +1,37 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsLikelyCompany(t *testing.T) {
+	tests := []struct {
+		name   string
+		likely bool
+	}{
+		{"Acme Widgets Pty Ltd", true},
+		{"Profound Networks LLC", true},
+		{"Barclays Bank", true},
+		{"Smith & Sons", true},
+		{"IBM Global Services", true},
+		{"John Smith", false},
+		{"Mary J. Watson", false},
+		{"123 Main Street", false},
+		{"42 Wallaby Way, Sydney", false},
+		{"トヨタ自動車 K.K.", true},
+		{"トヨタ自動車 NV", false},
+	}
+
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range tests {
+		likely, score := p.IsLikelyCompany(tc.name)
+		assert.Equal(t, tc.likely, likely, "IsLikelyCompany for %q (score %.2f)", tc.name, score)
+		assert.True(t, score >= 0 && score <= 1, "score in range for %q", tc.name)
+	}
+}
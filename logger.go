@@ -0,0 +1,17 @@
+package gocd
+
+// Logger is the interface used for debug logging, which *slog.Logger
+// (from log/slog) satisfies
+type Logger interface {
+	Debug(msg string, args ...any)
+}
+
+// WithLogger emits structured debug events to l for dataset loading,
+// pattern compilation (with pattern sizes and timings), and each parse
+// (with the passes tried and the pass that matched). Arguments are
+// alternating key-value pairs, as for slog.
+func WithLogger(l Logger) Option {
+	return func(o *options) {
+		o.logger = l
+	}
+}
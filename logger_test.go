@@ -0,0 +1,54 @@
+package gocd
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testLogger records debug events
+type testLogger struct {
+	sync.Mutex
+	events []map[string]any
+}
+
+func (l *testLogger) Debug(msg string, args ...any) {
+	l.Lock()
+	defer l.Unlock()
+	event := map[string]any{"msg": msg}
+	for i := 0; i+1 < len(args); i += 2 {
+		event[args[i].(string)] = args[i+1]
+	}
+	l.events = append(l.events, event)
+}
+
+func (l *testLogger) find(msg string) []map[string]any {
+	var events []map[string]any
+	for _, e := range l.events {
+		if e["msg"] == msg {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+func TestLogger(t *testing.T) {
+	l := &testLogger{}
+	p, err := New(WithLogger(l))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, l.find("gocd loaded dataset"), 1, "dataset load logged")
+	assert.Len(t, l.find("gocd compiled pattern"), 5, "pattern compilation logged")
+
+	_, err = p.Parse("ООО Ромашка")
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := l.find("gocd parse")
+	if assert.Len(t, events, 1, "parse logged") {
+		assert.Equal(t, Begin, events[0]["pass"], "pass logged")
+		assert.Contains(t, events[0]["tried"], Begin, "tried passes logged")
+	}
+}
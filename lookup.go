@@ -0,0 +1,111 @@
+package gocd
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// LookupPatterns are database patterns matching a company name with or
+// without any known designator, for designator-agnostic registry
+// lookups. See Parser.LookupPatterns.
+type LookupPatterns struct {
+	// Regexp matches the name alone or with a known designator. It uses
+	// only POSIX ERE syntax, and should be used with a case-insensitive
+	// operator e.g. PostgreSQL `~*`, or MySQL `REGEXP` with a
+	// case-insensitive collation.
+	Regexp string
+	// Like holds LIKE/ILIKE patterns (using the default backslash
+	// escape) matching the name alone, followed by anything, or
+	// preceded by anything. They select candidate rows more cheaply
+	// (and index-friendly, for the first two) than Regexp, but also
+	// match names that merely share a prefix or suffix, so candidates
+	// should be confirmed e.g. with Parse.
+	Like []string
+}
+
+var reLookupSpace = regexp.MustCompile(`\pZ+`)
+
+// lookupQuote escapes s for use in a POSIX ERE, allowing any whitespace
+// between words
+func lookupQuote(s string) string {
+	words := reLookupSpace.Split(strings.TrimSpace(s), -1)
+	for i, w := range words {
+		words[i] = regexp.QuoteMeta(w)
+	}
+	return strings.Join(words, `[[:space:]]+`)
+}
+
+// lookupQuoteDes is lookupQuote for designators, also making periods
+// optional (as in designator matching)
+func lookupQuoteDes(s string) string {
+	return strings.Replace(lookupQuote(s), `\.`, `\.?`, -1)
+}
+
+// likeEscape escapes the LIKE metacharacters in s
+func likeEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// LookupPatterns returns database patterns matching the designator-
+// stripped form of name (e.g. "Acme" for "Acme Ltd") alone or combined
+// with any known designator, so registry lookups can be designator-
+// agnostic without loading the dataset into the database. If langs are
+// given, only designators for those languages are included, which keeps
+// Regexp much shorter.
+func (p *Parser) LookupPatterns(name string, langs ...string) LookupPatterns {
+	short := strings.TrimSpace(name)
+	if res, err := p.Parse(name); err == nil && res.Matched && res.ShortName != "" {
+		short = res.ShortName
+	}
+
+	langSet := make(map[string]bool)
+	for _, lang := range langs {
+		langSet[lang] = true
+	}
+	var trail, lead []string
+	seen := make(map[string]bool)
+	for long, e := range *p.ds {
+		if e.LangOnly || (len(langs) > 0 && !langSet[e.Lang]) {
+			continue
+		}
+		for _, des := range append([]string{long, e.AbbrStd}, e.Abbr...) {
+			q := lookupQuoteDes(des)
+			if q == "" || seen[q] {
+				continue
+			}
+			seen[q] = true
+			trail = append(trail, q)
+			if e.Lead {
+				lead = append(lead, q)
+			}
+		}
+	}
+	// Sort longest first, for engines with leftmost-first alternation
+	byLength := func(s []string) {
+		sort.Slice(s, func(i, j int) bool {
+			if len(s[i]) != len(s[j]) {
+				return len(s[i]) > len(s[j])
+			}
+			return s[i] < s[j]
+		})
+	}
+	byLength(trail)
+	byLength(lead)
+
+	re := `^`
+	if len(lead) > 0 {
+		re += `((` + strings.Join(lead, "|") + `)[[:space:]]+)?`
+	}
+	re += lookupQuote(short)
+	if len(trail) > 0 {
+		re += `([[:space:],]+(` + strings.Join(trail, "|") + `))?`
+	}
+	re += `$`
+
+	like := likeEscape(short)
+	return LookupPatterns{
+		Regexp: re,
+		Like:   []string{like, like + " %", "% " + like},
+	}
+}
@@ -0,0 +1,38 @@
+package gocd
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupPatterns(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lp := p.LookupPatterns("Acme Widgets Ltd")
+	assert.Equal(t, []string{"Acme Widgets", "Acme Widgets %", "% Acme Widgets"}, lp.Like, "Like matches")
+	re := regexp.MustCompile(`(?i)` + lp.Regexp)
+	for _, name := range []string{
+		"Acme Widgets", "Acme Widgets Ltd", "ACME WIDGETS LIMITED", "Acme  Widgets, LLC",
+		"Acme Widgets GmbH", "OOO Acme Widgets",
+	} {
+		assert.True(t, re.MatchString(name), "Regexp matches %q", name)
+	}
+	for _, name := range []string{"Acme Widgets Holdings Ltd", "Acme Widgetsmith Ltd", "Big Acme Widgets"} {
+		assert.False(t, re.MatchString(name), "Regexp doesn't match %q", name)
+	}
+
+	// Restricted to languages
+	lp = p.LookupPatterns("Acme", "de")
+	re = regexp.MustCompile(`(?i)` + lp.Regexp)
+	assert.True(t, re.MatchString("Acme GmbH"), "Regexp matches de designator")
+	assert.False(t, re.MatchString("Acme Ltd"), "Regexp doesn't match en designator")
+
+	// LIKE metacharacters are escaped
+	lp = p.LookupPatterns("100% Natural_Foods Ltd")
+	assert.Equal(t, `100\% Natural\_Foods`, lp.Like[0], "Like escaped")
+}
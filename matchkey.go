@@ -0,0 +1,70 @@
+package gocd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// MatchKeyVersion identifies the normalisation recipe used by MatchKey,
+// and is included in keys. It is incremented whenever the recipe
+// changes, so keys with the same version remain comparable across
+// releases.
+const MatchKeyVersion = 1
+
+// matchKeyPrefix prefixes MatchKey keys
+var matchKeyPrefix = "gocd" + strconv.Itoa(MatchKeyVersion) + ":"
+
+// normalizeName returns the MatchKey normalisation of the
+// designator-stripped name short: compatibility decomposed, diacritics
+// stripped, lowercased, "&" and "+" replaced by "and", and runs of any
+// other non-letter, non-digit characters replaced by a single space
+func normalizeName(short string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range norm.NFKD.String(short) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			continue
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			space = false
+			b.WriteRune(unicode.ToLower(r))
+		case r == '&' || r == '+':
+			if b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteString("and")
+			space = true
+		default:
+			space = true
+		}
+	}
+	return norm.NFC.String(b.String())
+}
+
+// MatchKey returns a stable key for name suitable for joining datasets,
+// derived from the normalised designator-stripped name so that e.g.
+// "Acme Widgets Ltd" and "ACME WIDGETS LIMITED" share a key. Keys are
+// prefixed with the MatchKeyVersion (e.g. "gocd1:"), and are only
+// comparable between Parsers with the same options. Returns an empty
+// string if name has no letters or digits outside its designator, or
+// cannot be parsed.
+func (p *Parser) MatchKey(name string) string {
+	res, err := p.Parse(name)
+	if err != nil {
+		return ""
+	}
+	normalized := normalizeName(res.ShortName)
+	if normalized == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(normalized))
+	return matchKeyPrefix + hex.EncodeToString(sum[:16])
+}
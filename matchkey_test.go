@@ -0,0 +1,43 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		short      string
+		normalized string
+	}{
+		{"Acme Widgets", "acme widgets"},
+		{"ACME-WIDGETS,", "acme widgets"},
+		{"Société Générale", "societe generale"},
+		{"Smith & Sons", "smith and sons"},
+		{"Smith+Sons", "smith and sons"},
+		{"  Ｆｕｌｌｗｉｄｔｈ  ", "fullwidth"},
+		{"!!!", ""},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.normalized, normalizeName(tc.short), "normalizeName for %q", tc.short)
+	}
+}
+
+func TestMatchKey(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key := p.MatchKey("Acme Widgets Ltd")
+	assert.Regexp(t, `^gocd1:[0-9a-f]{32}$`, key, "MatchKey format")
+	// Fixed so accidental recipe changes are caught
+	assert.Equal(t, "gocd1:ce068ed8ead753f256561437b64d27a4", key, "MatchKey stable")
+	for _, name := range []string{"ACME WIDGETS LIMITED", "Acme-Widgets, Ltd.", "Acme Widgets"} {
+		assert.Equal(t, key, p.MatchKey(name), "MatchKey for %q", name)
+	}
+	assert.NotEqual(t, key, p.MatchKey("Acme Gadgets Ltd"), "different names differ")
+	assert.Equal(t, "", p.MatchKey("!!!"), "punctuation only has no key")
+}
@@ -0,0 +1,64 @@
+package gocd
+
+import (
+	"fmt"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// MatchKind describes how a Designator was matched
+type MatchKind int
+
+const (
+	NoMatch      MatchKind = iota
+	LongForm               // Matched an entry long name
+	Abbreviation           // Matched a listed abbreviation
+	Stripped               // Matched a diacritic-stripped variant of a long name or abbreviation
+	RegexAbbr              // Matched a regex (abbr_re) abbreviation
+	Fallback               // Matched a fallback-pass (blacklisted) pattern
+	Glued                  // Matched a designator glued to the name (see WithGluedDesignators)
+)
+
+func (k MatchKind) String() string {
+	return [...]string{
+		"none", "long", "abbr", "stripped", "regex", "fallback", "glued",
+	}[k]
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (k MatchKind) MarshalText() ([]byte, error) {
+	if k < NoMatch || k > Glued {
+		return nil, fmt.Errorf("invalid MatchKind %d", int(k))
+	}
+	return []byte(k.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (k *MatchKind) UnmarshalText(text []byte) error {
+	for t := NoMatch; t <= Glued; t++ {
+		if t.String() == string(text) {
+			*k = t
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid MatchKind %q", text)
+}
+
+// matchKind returns the MatchKind for a match of des against ref in
+// the given pass
+func matchKind(des string, ref desRef, pass PositionType) MatchKind {
+	switch {
+	case pass == EndFallback || pass == BeginFallback:
+		return Fallback
+	case pass == EndGlued:
+		return Glued
+	case ref.regex:
+		return RegexAbbr
+	case reIndexMarks.MatchString(norm.NFD.String(ref.des)) &&
+		!reIndexMarks.MatchString(norm.NFD.String(des)):
+		return Stripped
+	case ref.des == ref.long:
+		return LongForm
+	}
+	return Abbreviation
+}
@@ -0,0 +1,55 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchKind(t *testing.T) {
+	tests := []struct {
+		input string
+		kind  MatchKind
+	}{
+		{"Acme Limited", LongForm},
+		{"Acme Ltd.", Abbreviation},
+		{"Acme Ltee", Stripped},
+		{"Acme Ltée", Abbreviation},
+		{"Acme L.L.C.", Fallback},
+		{"Acme Vennootschap", Fallback},
+		{"Acme Widgets", NoMatch},
+	}
+
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.kind.String(), res.MatchKind.String(), "MatchKind matches for %q", tc.input)
+	}
+
+	p, err = New(WithOverlay([]byte("Widget Company:\n  abbr_re: ['W\\.?C\\.?']\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := p.Parse("Acme W.C.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, RegexAbbr, res.MatchKind, "regex MatchKind")
+
+	for k := NoMatch; k <= Fallback; k++ {
+		text, err := k.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var k2 MatchKind
+		assert.NoError(t, k2.UnmarshalText(text), "MatchKind unmarshals")
+		assert.Equal(t, k, k2, "MatchKind text round trip")
+	}
+}
@@ -0,0 +1,56 @@
+package gocd
+
+import (
+	"errors"
+	"unicode/utf8"
+)
+
+// ErrInputTooLong is returned for input longer than the configured
+// maximum with the RejectLongInput policy
+var ErrInputTooLong = errors.New("input exceeds maximum length")
+
+// LongInputPolicy determines how a Parser handles input longer than the
+// configured maximum
+type LongInputPolicy int
+
+const (
+	RejectLongInput   LongInputPolicy = iota // Return an ErrInputTooLong error
+	SkipLongInput                            // Report no match without matching
+	TruncateLongInput                        // Match against the first n bytes only
+)
+
+// WithMaxInputLength caps the input length Parse will process to n bytes,
+// with policy applied to longer inputs
+func WithMaxInputLength(n int, policy LongInputPolicy) Option {
+	return func(o *options) {
+		o.maxInputLen = n
+		o.longPolicy = policy
+	}
+}
+
+// truncateUTF8 returns the longest prefix of input that is at most n
+// bytes and does not split a UTF-8 sequence
+func truncateUTF8(input []byte, n int) []byte {
+	if len(input) <= n {
+		return input
+	}
+	for n > 0 && !utf8.RuneStart(input[n]) {
+		n--
+	}
+	return input[:n]
+}
+
+// checkLength applies the maximum input length policy to input,
+// returning the input to match and whether matching should be skipped
+func (p *Parser) checkLength(input []byte) ([]byte, bool, error) {
+	if len(input) <= p.opts.maxInputLen {
+		return input, false, nil
+	}
+	switch p.opts.longPolicy {
+	case SkipLongInput:
+		return input, true, nil
+	case TruncateLongInput:
+		return truncateUTF8(input, p.opts.maxInputLen), false, nil
+	}
+	return nil, false, ErrInputTooLong
+}
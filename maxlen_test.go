@@ -0,0 +1,42 @@
+package gocd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxInputLength(t *testing.T) {
+	long := strings.Repeat("x", 100) + " GmbH"
+	tests := []struct {
+		policy  LongInputPolicy
+		input   string
+		err     error
+		matched bool
+		short   string
+	}{
+		{RejectLongInput, "Acme GmbH", nil, true, "Acme"},
+		{RejectLongInput, long, ErrInputTooLong, false, ""},
+		{SkipLongInput, long, nil, false, long},
+		{TruncateLongInput, "Acme Widgets Ltd" + long, nil, true, "Acme Widgets"},
+		{TruncateLongInput, "Acme Widgets Ltée" + long, nil, false, "Acme Widgets Lt"},
+	}
+
+	for _, tc := range tests {
+		p, err := New(WithMaxInputLength(16, tc.policy))
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := p.Parse(tc.input)
+		if tc.err != nil {
+			assert.ErrorIs(t, err, tc.err, "Parse errors for %q", tc.input)
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.matched, res.Matched, "Matched for %q", tc.input)
+		assert.Equal(t, tc.short, res.ShortName, "ShortName matches for %q", tc.input)
+	}
+}
@@ -0,0 +1,99 @@
+package gocd
+
+import (
+	"expvar"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// LatencyBuckets are the upper bounds (in seconds) of the Metrics parse
+// latency histogram buckets
+var LatencyBuckets = []float64{
+	0.00001, 0.00005, 0.0001, 0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1,
+}
+
+// Metrics collects counters describing Parser behaviour, using expvar
+// variables so they are safe for concurrent use and can be published
+// directly (see Publish) or read and re-exported to other systems. The
+// zero value is ready to use.
+type Metrics struct {
+	Parses     expvar.Int   // Total parses
+	Matches    expvar.Int   // Total parses with a Designator match
+	ByPosition expvar.Map   // Matches by Result Position
+	ByLang     expvar.Map   // Matches by matched designator language
+	ByPass     expvar.Map   // Matches by matching pass (including fallback passes)
+	Latency    expvar.Map   // Parse latency histogram, keyed by bucket upper bound (non-cumulative)
+	LatencySum expvar.Float // Total parse latency in seconds
+	vars       expvar.Map
+	buckets    []string
+	once       sync.Once
+}
+
+// NewMetrics returns a new, unpublished, Metrics
+func NewMetrics() *Metrics {
+	m := &Metrics{}
+	m.once.Do(m.init)
+	return m
+}
+
+// init registers m's variables and latency buckets
+func (m *Metrics) init() {
+	m.vars.Set("parses", &m.Parses)
+	m.vars.Set("matches", &m.Matches)
+	m.vars.Set("matches_by_position", &m.ByPosition)
+	m.vars.Set("matches_by_lang", &m.ByLang)
+	m.vars.Set("matches_by_pass", &m.ByPass)
+	m.vars.Set("latency_seconds", &m.Latency)
+	m.vars.Set("latency_seconds_sum", &m.LatencySum)
+
+	for _, b := range LatencyBuckets {
+		m.buckets = append(m.buckets, strconv.FormatFloat(b, 'g', -1, 64))
+	}
+	m.buckets = append(m.buckets, "+Inf")
+	for _, key := range m.buckets {
+		m.Latency.Add(key, 0)
+	}
+}
+
+// String implements expvar.Var, returning all metrics as a JSON object
+func (m *Metrics) String() string {
+	m.once.Do(m.init)
+	return m.vars.String()
+}
+
+// Publish publishes m via expvar under name. Like expvar.Publish, it
+// panics if name is already registered.
+func (m *Metrics) Publish(name string) {
+	expvar.Publish(name, m)
+}
+
+// record records the metrics for a single parse
+func (m *Metrics) record(res *Result, pass PositionType, elapsed time.Duration) {
+	m.once.Do(m.init)
+	m.Parses.Add(1)
+	if res.Matched {
+		m.Matches.Add(1)
+		m.ByPosition.Add(res.Position.String(), 1)
+		m.ByPass.Add(pass.String(), 1)
+		if res.Lang != "" {
+			m.ByLang.Add(res.Lang, 1)
+		}
+	}
+
+	secs := elapsed.Seconds()
+	m.LatencySum.Add(secs)
+	i := 0
+	for i < len(LatencyBuckets) && secs > LatencyBuckets[i] {
+		i++
+	}
+	m.Latency.Add(m.buckets[i], 1)
+}
+
+// WithMetrics enables recording of Parser metrics to m. A single Metrics
+// may be shared by multiple Parsers.
+func WithMetrics(m *Metrics) Option {
+	return func(o *options) {
+		o.metrics = m
+	}
+}
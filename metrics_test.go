@@ -0,0 +1,68 @@
+package gocd
+
+import (
+	"encoding/json"
+	"expvar"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	m := NewMetrics()
+	p, err := New(WithMetrics(m))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, input := range []string{
+		"Profound Networks GmbH",
+		"Acme Vennootschap",
+		"ООО Ромашка",
+		"Acme & Sons",
+	} {
+		_, err := p.Parse(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	assert.Equal(t, int64(4), m.Parses.Value(), "parses")
+	assert.Equal(t, int64(3), m.Matches.Value(), "matches")
+	assert.Equal(t, "2", m.ByPosition.Get("end").String(), "end matches")
+	assert.Equal(t, "1", m.ByPosition.Get("begin").String(), "begin matches")
+	assert.Equal(t, "1", m.ByPass.Get("end").String(), "end matches")
+	assert.Equal(t, "1", m.ByPass.Get("end_fallback").String(), "end_fallback matches")
+	assert.Equal(t, "1", m.ByLang.Get("ru").String(), "ru matches")
+
+	var total int64
+	m.Latency.Do(func(kv expvar.KeyValue) {
+		var n int64
+		assert.NoError(t, json.Unmarshal([]byte(kv.Value.String()), &n))
+		total += n
+	})
+	assert.Equal(t, int64(4), total, "latency histogram total")
+
+	var vars map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(m.String()), &vars), "String is valid JSON")
+	assert.Contains(t, vars, "matches_by_pass", "String includes matches_by_pass")
+}
+
+func TestMetricsZeroValue(t *testing.T) {
+	var m Metrics
+	p, err := New(WithMetrics(&m))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = p.Parse("Profound Networks GmbH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(1), m.Matches.Value(), "zero value Metrics records")
+
+	var vars map[string]interface{}
+	if err := json.Unmarshal([]byte(m.String()), &vars); err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, vars, "latency_seconds", "zero value Metrics String includes all metrics")
+}
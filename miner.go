@@ -0,0 +1,148 @@
+package gocd
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// DefaultMinerExamples is the default number of example names kept per
+// Suggestion
+const DefaultMinerExamples = 3
+
+// Suggestion is a candidate new designator mined from a corpus
+type Suggestion struct {
+	Designator string       // The candidate designator, in its most frequent form
+	Position   PositionType // End or Begin
+	Count      int          // The number of names the candidate occurred in
+	Examples   []string     // Example names containing the candidate
+}
+
+type minerTally struct {
+	count    int
+	forms    map[string]int
+	examples []string
+}
+
+// Miner tallies the leading and trailing tokens of company names that
+// don't match any known designator, to suggest candidate new designators
+// for the dataset. A Miner is not safe for concurrent use.
+type Miner struct {
+	p           *Parser
+	maxExamples int
+	tallies     map[PositionType]map[string]*minerTally
+}
+
+// NewMiner returns a new Miner using p to filter out names with known
+// designators, and keeping up to maxExamples example names per candidate
+// (DefaultMinerExamples if zero)
+func (p *Parser) NewMiner(maxExamples int) *Miner {
+	if maxExamples == 0 {
+		maxExamples = DefaultMinerExamples
+	}
+	return &Miner{
+		p:           p,
+		maxExamples: maxExamples,
+		tallies: map[PositionType]map[string]*minerTally{
+			End:   make(map[string]*minerTally),
+			Begin: make(map[string]*minerTally),
+		},
+	}
+}
+
+// minerToken trims surrounding punctuation from token, returning "" if
+// token is not a plausible designator (e.g. has no letters)
+func minerToken(token string) string {
+	token = strings.TrimLeft(token, `,;:("'`)
+	token = strings.TrimRight(token, `,;:)"'`)
+	for _, r := range token {
+		if unicode.IsLetter(r) {
+			return token
+		}
+	}
+	return ""
+}
+
+func (m *Miner) tally(pos PositionType, token, name string) {
+	token = minerToken(token)
+	if token == "" {
+		return
+	}
+	// Skip tokens that are already known designators
+	if _, ok := m.p.idx.lookup(token); ok {
+		return
+	}
+
+	key := looseKey(token)
+	t := m.tallies[pos][key]
+	if t == nil {
+		t = &minerTally{forms: make(map[string]int)}
+		m.tallies[pos][key] = t
+	}
+	t.count++
+	t.forms[token]++
+	if len(t.examples) < m.maxExamples {
+		t.examples = append(t.examples, name)
+	}
+}
+
+// Add parses name and, if it has no known designator, tallies its
+// leading and trailing tokens
+func (m *Miner) Add(name string) error {
+	res, err := m.p.Parse(name)
+	if err != nil {
+		return err
+	}
+	if res.Matched {
+		return nil
+	}
+
+	// Single-token names have no core name to distinguish from a designator
+	tokens := strings.Fields(res.Input)
+	if len(tokens) < 2 {
+		return nil
+	}
+	m.tally(End, tokens[len(tokens)-1], res.Input)
+	m.tally(Begin, tokens[0], res.Input)
+
+	return nil
+}
+
+// Suggestions returns candidate designators seen in at least minCount
+// names, most frequent first
+func (m *Miner) Suggestions(minCount int) []Suggestion {
+	var suggestions []Suggestion
+	for _, pos := range []PositionType{End, Begin} {
+		for _, t := range m.tallies[pos] {
+			if t.count < minCount {
+				continue
+			}
+			// Report the most frequent form, breaking ties lexically
+			best := ""
+			for form, n := range t.forms {
+				if best == "" || n > t.forms[best] || (n == t.forms[best] && form < best) {
+					best = form
+				}
+			}
+			suggestions = append(suggestions, Suggestion{
+				Designator: best,
+				Position:   pos,
+				Count:      t.count,
+				Examples:   t.examples,
+			})
+		}
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		si, sj := suggestions[i], suggestions[j]
+		if si.Count != sj.Count {
+			return si.Count > sj.Count
+		}
+		if si.Designator != sj.Designator {
+			return si.Designator < sj.Designator
+		}
+		return si.Position < sj.Position
+	})
+
+	return suggestions
+}
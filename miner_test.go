@@ -0,0 +1,47 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiner(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := p.NewMiner(2)
+	for _, name := range []string{
+		"Alpha Widgets Pvt",
+		"Beta Trading PVT",
+		"Gamma Holdings pvt.",
+		"Delta Widgets Ltd",
+		"Epsilon Holdings",
+		"Zeta Holdings",
+		"Widgets",
+		"Eta 1234",
+	} {
+		if err := m.Add(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	suggestions := m.Suggestions(2)
+	if assert.Len(t, suggestions, 2, "suggestion count") {
+		assert.Equal(t, Suggestion{
+			Designator: "PVT",
+			Position:   End,
+			Count:      3,
+			Examples:   []string{"Alpha Widgets Pvt", "Beta Trading PVT"},
+		}, suggestions[0], "first suggestion")
+		assert.Equal(t, "Holdings", suggestions[1].Designator, "second suggestion")
+		assert.Equal(t, 2, suggestions[1].Count, "second suggestion count")
+	}
+
+	// Known designators are never suggested
+	for _, s := range m.Suggestions(1) {
+		assert.NotEqual(t, "Ltd", s.Designator, "known designator not suggested")
+	}
+}
@@ -0,0 +1,85 @@
+package gocd
+
+import (
+	"strings"
+	"unicode"
+)
+
+// WithNameLang sets Result.NameLang to a heuristic guess at the
+// language of the name itself, for routing names to language-specific
+// processing. Where the matched designator is written in the name's
+// dominant script (see DetectScript), the designator's language is
+// used (e.g. "de" for "Müller GmbH"), and otherwise the language is
+// inferred from the script alone where it mostly implies one (e.g.
+// "el" for Greek, "ja" for Han with kana). Latin-script names without a
+// Latin designator, and names without a dominant script, give "".
+func WithNameLang() Option {
+	return func(o *options) {
+		o.nameLang = true
+	}
+}
+
+// nameLang returns the heuristic language of the name in res
+func nameLang(res *Result) string {
+	g := dominantScriptGroup([]byte(res.ShortName))
+	if g < 0 {
+		return ""
+	}
+	if res.Lang != "" && dominantScriptGroup([]byte(res.Designator)) == g {
+		return res.Lang
+	}
+	return scriptLang(res.ShortName, scriptGroups[g].name)
+}
+
+// langMismatch returns true if the matched designator in res is written
+// in a different script from the name, and its language differs from
+// the one the name's script implies (e.g. Dutch "NV" ending a Japanese
+// name), as such matches are disproportionately false positives.
+// Latin-script names are never mismatched, as e.g. romanised names with
+// Cyrillic designators ("ООО Acme") are common.
+func langMismatch(res *Result) bool {
+	if !res.Matched || res.Lang == "" {
+		return false
+	}
+	g := dominantScriptGroup([]byte(res.ShortName))
+	if g < 0 || dominantScriptGroup([]byte(res.Designator)) == g {
+		return false
+	}
+	lang := scriptLang(res.ShortName, scriptGroups[g].name)
+	return lang != "" && lang != res.Lang
+}
+
+// scriptLang returns the language most likely implied by the name s
+// written in script group script, or "" if it's ambiguous
+func scriptLang(s, script string) string {
+	switch script {
+	case "Cyrillic":
+		if strings.ContainsAny(s, "ґєіїҐЄІЇ") {
+			return "uk"
+		}
+		return "ru"
+	case "Greek":
+		return "el"
+	case "Arabic":
+		if strings.ContainsAny(s, "پچژگ") {
+			return "fa"
+		}
+		return "ar"
+	case "Hebrew":
+		return "he"
+	case "Thai":
+		return "th"
+	case "CJK":
+		lang := "zh"
+		for _, r := range s {
+			switch {
+			case unicode.Is(unicode.Hangul, r):
+				return "ko"
+			case unicode.In(r, unicode.Hiragana, unicode.Katakana):
+				lang = "ja"
+			}
+		}
+		return lang
+	}
+	return ""
+}
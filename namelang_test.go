@@ -0,0 +1,84 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNameLang(t *testing.T) {
+	p, err := New(WithNameLang())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input string
+		lang  string
+	}{
+		{"Müller & Söhne GmbH", "de"},
+		{"Acme Widgets Ltd", "en"},
+		{"ООО Ромашка", "ru"},
+		{"ТОВ Київські ліки", "uk"},
+		{"トヨタ自動車株式会社", "ja"},
+		{"삼성전자 주식회사", "ko"},
+		{"Αθηναϊκή Ζυθοποιία Α.Ε.", "el"},
+		{"مؤسسة النور ذ.م.م", "ar"},
+		{`אבגד בע"מ`, "he"},
+		// Designator in a different script from the name
+		{"ООО Acme", ""},
+		{"Romashka ООО", ""},
+		// Unmatched names use the script alone
+		{"Ромашка", "ru"},
+		{"中国银行", "zh"},
+		{"Acme Widgets", ""},
+		{"12345", ""},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.lang, res.NameLang, "NameLang matches for %q", tc.input)
+	}
+
+	// Not set by default
+	p, err = New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := p.Parse("ООО Ромашка")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "", res.NameLang, "NameLang unset by default")
+}
+
+func TestLangMismatch(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input    string
+		mismatch bool
+	}{
+		{"トヨタ自動車 NV", true},
+		{"三菱商事 Co., Ltd.", true},
+		{"Ромашка Ltd", true},
+		{"トヨタ自動車 K.K.", false},
+		{"Ромашка OOO", false},
+		{"ООО Acme", false},
+		{"ТОВ Київські ліки", false},
+		{"Acme GmbH", false},
+		{"トヨタ自動車", false},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.mismatch, res.LangMismatch, "LangMismatch matches for %q", tc.input)
+	}
+}
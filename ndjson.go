@@ -0,0 +1,50 @@
+package gocd
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+)
+
+// MaxLineLength is the maximum input line length supported by the
+// line-oriented batch helpers
+const MaxLineLength = 1024 * 1024
+
+// NDJSONOptions configures WriteNDJSON
+type NDJSONOptions struct {
+	OnlyMatched bool // Only write results where a Designator was found
+}
+
+// WriteNDJSON reads newline-delimited company names from r, parses each,
+// and writes the results to w as newline-delimited JSON objects, one per
+// line, in input order. Blank lines are skipped.
+func (p *Parser) WriteNDJSON(w io.Writer, r io.Reader, opts NDJSONOptions) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), MaxLineLength)
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	enc.SetEscapeHTML(false)
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		res, err := p.ParseBytes(line)
+		if err != nil {
+			return err
+		}
+		if opts.OnlyMatched && !res.Matched {
+			continue
+		}
+		// Encode appends a trailing newline
+		if err := enc.Encode(res); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
@@ -0,0 +1,66 @@
+package gocd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func decodeNDJSON(t *testing.T, data []byte) []Result {
+	var results []Result
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var res Result
+		if err := json.Unmarshal(scanner.Bytes(), &res); err != nil {
+			t.Fatal(err)
+		}
+		results = append(results, res)
+	}
+	return results
+}
+
+func TestWriteNDJSON(t *testing.T) {
+	input := "Profound Networks LLC\n\nAcme & Sons\nOpen Fusion Pty Ltd\n"
+
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	err = p.WriteNDJSON(&buf, strings.NewReader(input), NDJSONOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, buf.String(), `"short_name":"Profound Networks","designator":"LLC"`,
+		"NDJSON field names")
+	assert.Contains(t, buf.String(), `"position":"end"`, "NDJSON position string")
+
+	results := decodeNDJSON(t, buf.Bytes())
+	if assert.Len(t, results, 3, "NDJSON line count") {
+		for i, input := range []string{"Profound Networks LLC", "Acme & Sons", "Open Fusion Pty Ltd"} {
+			res, err := p.Parse(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Entry is not serialised
+			res.Entry = nil
+			assert.Equal(t, *res, results[i], "NDJSON result matches Parse")
+		}
+	}
+
+	buf.Reset()
+	err = p.WriteNDJSON(&buf, strings.NewReader(input), NDJSONOptions{OnlyMatched: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	results = decodeNDJSON(t, buf.Bytes())
+	if assert.Len(t, results, 2, "OnlyMatched line count") {
+		assert.Equal(t, "Profound Networks LLC", results[0].Input, "OnlyMatched first input")
+		assert.Equal(t, "Open Fusion Pty Ltd", results[1].Input, "OnlyMatched second input")
+	}
+}
@@ -0,0 +1,14 @@
+package gocd
+
+// WithCompatibilityNormalization applies Unicode NFKC normalisation to
+// input before matching (and before any WithPreprocessor functions), so
+// compatibility characters common in scraped data, such as ligatures
+// ("ﬁ"), Roman numerals ("Ⅱ"), circled and parenthesised forms ("①",
+// "⑴"), and fullwidth Latin ("Ｌｔｄ"), are replaced by their plain
+// equivalents and match designators and word boundaries normally. The
+// Result reports the normalised input as Input.
+func WithCompatibilityNormalization() Option {
+	return func(o *options) {
+		o.nfkc = true
+	}
+}
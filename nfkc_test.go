@@ -0,0 +1,48 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompatibilityNormalization(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pn, err := p.Clone(WithCompatibilityNormalization())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input      string
+		normInput  string
+		shortName  string
+		designator string
+	}{
+		{"Acme Ｌｔｄ", "Acme Ltd", "Acme", "Ltd"},
+		{"Acme Oﬃce Supplies Inc", "Acme Office Supplies Inc", "Acme Office Supplies", "Inc"},
+		{"Acme Ⅱ LLC", "Acme II LLC", "Acme II", "LLC"},
+		{"Acme ① GmbH", "Acme 1 GmbH", "Acme 1", "GmbH"},
+		{"Acme Widgets", "Acme Widgets", "Acme Widgets", ""},
+	}
+	for _, tc := range tests {
+		res, err := pn.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.normInput, res.Input, "Input matches for %q", tc.input)
+		assert.Equal(t, tc.shortName, res.ShortName, "ShortName matches for %q", tc.input)
+		assert.Equal(t, tc.designator, res.Designator, "Designator matches for %q", tc.input)
+	}
+
+	// Without the option, compatibility characters are left alone
+	res, err := p.Parse("Acme Ｌｔｄ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, res.Matched, "fullwidth designator unmatched by default")
+	assert.Equal(t, "Acme Ｌｔｄ", res.Input, "Input unnormalised by default")
+}
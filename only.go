@@ -0,0 +1,85 @@
+package gocd
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrUnknownDesignator is returned for designators not found in the
+// dataset
+var ErrUnknownDesignator = errors.New("unknown designator")
+
+// WithOnlyDesignators restricts matching to the given designators (long
+// names or abbreviations), ignoring the rest of the dataset, for
+// applications that may only strip a vetted set of legal forms.
+// Designators are compared ignoring case, diacritics, punctuation and
+// whitespace, so e.g. "Ltd" also allows "Ltd.". Matches are still reported against their
+// full dataset entries (e.g. DesignatorStd and DesignatorLong). New
+// returns an ErrUnknownDesignator error for designators not in the
+// dataset.
+func WithOnlyDesignators(des ...string) Option {
+	return func(o *options) {
+		o.onlyDes = des
+	}
+}
+
+// restrict returns the matching dataset for matchDs given any
+// WithOnlyDesignators whitelist. Each whitelisted designator becomes a
+// separate entry, keyed by the designator itself, so that only it (and
+// not the other designators of its entry) is matched.
+func (p *Parser) restrict(matchDs *dataset) (*dataset, error) {
+	if p.opts.onlyDes == nil {
+		return matchDs, nil
+	}
+	only := make(map[string]bool, len(p.opts.onlyDes))
+	for _, des := range p.opts.onlyDes {
+		if _, exists := p.idx.loose[looseKey(des)]; !exists {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownDesignator, des)
+		}
+		only[looseKey(des)] = true
+	}
+
+	longs := make([]string, 0, len(*matchDs))
+	for long := range *matchDs {
+		longs = append(longs, long)
+	}
+	sort.Strings(longs)
+
+	restricted := make(dataset)
+	add := func(des string, e *Entry) {
+		if !only[looseKey(des)] {
+			return
+		}
+		r, exists := restricted[des]
+		if !exists {
+			r = &Entry{LongName: des, Lang: e.Lang}
+			restricted[des] = r
+		}
+		r.Lead = r.Lead || e.Lead
+		if LangContinua[e.Lang] {
+			r.Lang = e.Lang
+		}
+	}
+	for _, long := range longs {
+		e := (*matchDs)[long]
+		add(long, e)
+		for _, a := range e.Abbr {
+			add(a, e)
+		}
+	}
+	return &restricted, nil
+}
+
+// sameStrings returns true if a and b hold the same strings in order
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) || (a == nil) != (b == nil) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,77 @@
+package gocd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOnlyDesignators(t *testing.T) {
+	p, err := New(WithOnlyDesignators("GmbH", "limited"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input      string
+		matched    bool
+		designator string
+		long       string
+	}{
+		{"Acme GmbH", true, "GmbH", "Gesellschaft mit beschränkter Haftung"},
+		{"Acme Limited", true, "Limited", "Limited"},
+		// Other designators of whitelisted entries are not matched
+		{"Acme Ltd", false, "", ""},
+		{"Acme Inc", false, "", ""},
+		{"ООО Ромашка", false, "", ""},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.matched, res.Matched, "Matched matches for %q", tc.input)
+		assert.Equal(t, tc.designator, res.Designator, "Designator matches for %q", tc.input)
+		assert.Equal(t, tc.long, res.DesignatorLong, "DesignatorLong matches for %q", tc.input)
+	}
+
+	_, err = New(WithOnlyDesignators("Bogus"))
+	assert.ErrorIs(t, err, ErrUnknownDesignator, "unknown designator errors")
+}
+
+func TestOnlyDesignatorsDerived(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := p.Clone(WithOnlyDesignators("Ltd"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := c.Parse("Acme Limited")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, res.Matched, "clone restricted")
+	res, err = p.Parse("Acme Limited")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, res.Matched, "original unrestricted")
+
+	var buf bytes.Buffer
+	err = p.WriteState(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewFromState(&buf, WithOnlyDesignators("Ltd"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err = s.Parse("Acme Limited")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, res.Matched, "state parser restricted")
+}
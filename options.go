@@ -0,0 +1,115 @@
+package gocd
+
+import "time"
+
+// Option is a functional option used to configure a Parser
+type Option func(*options)
+
+type options struct {
+	keepInvisible  bool
+	keepQuotes     bool
+	unquoteShort   bool
+	translit       bool
+	metrics        *Metrics
+	overlays       [][]byte
+	articles       map[string][]string
+	minShortRunes  int
+	minShortTokens int
+	minAbbrTokens  int
+	glued          bool
+	runesPolicy    ShortNamePolicy
+	tokensPolicy   ShortNamePolicy
+	invalidUTF8    InvalidUTF8Policy
+	maxInputLen    int
+	longPolicy     LongInputPolicy
+	cacheSize      int
+	scriptShards   bool
+	scriptDetect   bool
+	noBegin        bool
+	noCont         bool
+	logger         Logger
+	tracer         Tracer
+	noDatasetEnv   bool
+	verifier       DatasetVerifier
+	parseTimeout   time.Duration
+	onlyDes        []string
+	layers         []Layer
+	datasetLayers  []datasetLayer
+	suffixIndex    bool
+	desTransforms  []DesignatorTransform
+	nfkc           bool
+	outputForm     OutputForm
+	nameLang       bool
+	maxAmbiguity   float64
+	checkAmbiguity bool
+	shortASCII     bool
+	preprocessors  []Preprocessor
+	postprocessors []Postprocessor
+}
+
+// WithoutInvisibleCleanup disables the default preprocessing that
+// replaces non-breaking spaces with standard spaces and strips
+// zero-width characters (ZWSP, ZWNJ, ZWJ, word joiner, BOM) from input
+func WithoutInvisibleCleanup() Option {
+	return func(o *options) {
+		o.keepInvisible = true
+	}
+}
+
+// WithoutQuoteNormalization disables the default preprocessing that
+// replaces typographic apostrophes and quotes (e.g. ’ ‘ ´ ` “ ”) in
+// input with their ASCII forms (' and "), which keeps possessives and
+// quoted names from interfering with designator boundary detection
+func WithoutQuoteNormalization() Option {
+	return func(o *options) {
+		o.keepQuotes = true
+	}
+}
+
+// WithoutDatasetEnv makes New ignore the GOCD_DATASET environment
+// variable (see DatasetEnv), always using the embedded default dataset
+func WithoutDatasetEnv() Option {
+	return func(o *options) {
+		o.noDatasetEnv = true
+	}
+}
+
+// WithoutBeginPass disables matching of leading designators (the Begin
+// and BeginFallback passes), for data known to use only trailing
+// designators
+func WithoutBeginPass() Option {
+	return func(o *options) {
+		o.noBegin = true
+	}
+}
+
+// WithoutContinuousPass disables matching of designators in continuous
+// scripts without a preceding word break (the EndCont pass), for data
+// known not to include e.g. Chinese or Japanese names
+func WithoutContinuousPass() Option {
+	return func(o *options) {
+		o.noCont = true
+	}
+}
+
+// passEnabled returns true if pass t is enabled
+func (o *options) passEnabled(t PositionType) bool {
+	switch t {
+	case Begin, BeginFallback:
+		return !o.noBegin
+	case EndCont:
+		return !o.noCont
+	}
+	return true
+}
+
+// WithOverlay merges the YAML dataset data over the default dataset,
+// replacing any existing entries with the same long name. Overlay
+// entries use the upstream dataset schema, plus an optional `abbr_re`
+// list of abbreviations given as regular expressions, which are used
+// verbatim rather than escaped. Multiple overlays are applied in order.
+func WithOverlay(data []byte) Option {
+	return func(o *options) {
+		o.overlays = append(o.overlays, data)
+	}
+}
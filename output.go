@@ -0,0 +1,28 @@
+package gocd
+
+// OutputForm is the Unicode normalisation form of Result strings (Input,
+// ShortName, Qualifier, and Designator)
+type OutputForm int
+
+const (
+	// OutputNFC returns NFC-normalised strings (the default)
+	OutputNFC OutputForm = iota
+	// OutputNFD returns NFD-normalised (decomposed) strings
+	OutputNFD
+	// OutputOriginal returns strings byte-identical to the corresponding
+	// parts of the input, without normalisation
+	OutputOriginal
+)
+
+// WithOutputForm sets the Unicode normalisation form of Result strings
+// (by default OutputNFC). Matching always uses NFD internally, so
+// OutputOriginal is useful when results are compared against the
+// original input, which may be in any (or no) normalisation form.
+// With OutputOriginal, spans cleaned up before matching (e.g.
+// non-breaking spaces and zero-width characters) are also returned as
+// in the input.
+func WithOutputForm(f OutputForm) Option {
+	return func(o *options) {
+		o.outputForm = f
+	}
+}
@@ -0,0 +1,61 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutputForm(t *testing.T) {
+	const (
+		nfdName = "Socie\u0301te\u0301 Ge\u0301ne\u0301rale"
+		nfcName = "Soci\u00e9t\u00e9 G\u00e9n\u00e9rale"
+	)
+	tests := []struct {
+		input     string
+		form      OutputForm
+		shortName string
+		qualifier string
+		des       string
+	}{
+		{nfdName + " S.A.", OutputNFC, nfcName, "", "S.A."},
+		{nfdName + " S.A.", OutputNFD, nfdName, "", "S.A."},
+		{nfdName + " S.A.", OutputOriginal, nfdName, "", "S.A."},
+		{nfcName + " S.A.", OutputNFD, nfdName, "", "S.A."},
+		{nfcName + " S.A.", OutputOriginal, nfcName, "", "S.A."},
+		{nfcName + " Socie\u0301te\u0301 Anonyme", OutputOriginal,
+			nfcName, "", "Socie\u0301te\u0301 Anonyme"},
+		{"Mu\u0308ller (Deutschland) GmbH", OutputOriginal, "Mu\u0308ller", "Deutschland", "GmbH"},
+		{"M\u00fcller (Deutschland) GmbH", OutputNFD, "Mu\u0308ller", "Deutschland", "GmbH"},
+		{"A\u0301cme (Pty) Ltd", OutputNFC, "\u00c1cme", "", "(Pty) Ltd"},
+		{"A\u0301cme (Pty) Ltd", OutputOriginal, "A\u0301cme", "", "(Pty) Ltd"},
+		// Input cleaned up before matching
+		{"\u00c1cme\u00a0Widgets\u200b Ltd", OutputNFC, "\u00c1cme Widgets", "", "Ltd"},
+		{"\u00c1cme\u00a0Widgets\u200b Ltd", OutputOriginal, "\u00c1cme\u00a0Widgets\u200b", "", "Ltd"},
+		{"\u00c1cme P .J . S . C", OutputNFC, "\u00c1cme", "", "P. J. S. C"},
+		{"\u00c1cme P .J . S . C", OutputOriginal, "\u00c1cme", "", "P .J . S . C"},
+		{"Acme P .J . S . C", OutputOriginal, "Acme", "", "P .J . S . C"},
+	}
+	parsers := make(map[OutputForm]*Parser)
+	for _, tc := range tests {
+		p, exists := parsers[tc.form]
+		if !exists {
+			var err error
+			p, err = New(WithOutputForm(tc.form))
+			if err != nil {
+				t.Fatal(err)
+			}
+			parsers[tc.form] = p
+		}
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tc.form == OutputOriginal {
+			assert.Equal(t, tc.input, res.Input, "Input matches for %+q", tc.input)
+		}
+		assert.Equal(t, tc.shortName, res.ShortName, "ShortName matches for %+q (form %d)", tc.input, tc.form)
+		assert.Equal(t, tc.qualifier, res.Qualifier, "Qualifier matches for %+q (form %d)", tc.input, tc.form)
+		assert.Equal(t, tc.des, res.Designator, "Designator matches for %+q (form %d)", tc.input, tc.form)
+	}
+}
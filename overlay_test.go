@@ -0,0 +1,52 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverlayRE(t *testing.T) {
+	overlay := []byte(`
+Widget Company:
+  abbr_std: W.C.
+  abbr_re:
+    - 'W(?:idget)?[\pZ.]*C[o0]'
+  lang: en
+`)
+	tests := []struct {
+		input  string
+		short  string
+		des    string
+		desStd string
+	}{
+		{"Acme WC0", "Acme", "WC0", "W.C."},
+		{"Acme Widget Co", "Acme", "Widget Co", "W.C."},
+		{"Acme W. Co", "Acme", "W. Co", "W.C."},
+		{"Acme GmbH", "Acme", "GmbH", "GmbH"},
+	}
+
+	p, err := New(WithOverlay(overlay))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.short, res.ShortName, "ShortName matches")
+		assert.Equal(t, tc.des, res.Designator, "Designator matches")
+		assert.Equal(t, tc.desStd, res.DesignatorStd, "DesignatorStd matches")
+	}
+
+	for _, bad := range []string{
+		"X:\n  abbr_re:\n    - '(WC'\n",
+		"X:\n  abbr_re:\n    - '(W)C'\n",
+		"X: [",
+	} {
+		_, err := New(WithOverlay([]byte(bad)))
+		assert.ErrorIs(t, err, ErrDatasetParse, "invalid overlay %q errors", bad)
+	}
+}
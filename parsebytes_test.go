@@ -0,0 +1,30 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBytes(t *testing.T) {
+	tests := loadStripTests()
+
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range tests {
+		res, err := p.Parse(tc.Name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		input := []byte(tc.Name)
+		resBytes, err := p.ParseBytes(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, res, resBytes, "ParseBytes matches Parse")
+		assert.Equal(t, tc.Name, string(input), "ParseBytes input unmodified")
+	}
+}
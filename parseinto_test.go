@@ -0,0 +1,66 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInto(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := []string{"Acme (UK) Ltd", "P .J . S . C Acme", "Acme GmbH"}
+	for _, tc := range loadStripTests() {
+		inputs = append(inputs, tc.Name)
+	}
+	var res Result
+	for _, input := range inputs {
+		expected, err := p.Parse(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = p.ParseInto(input, &res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, *expected, res, "ParseInto matches Parse for %q", input)
+	}
+}
+
+func TestParseIntoAllocs(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var res Result
+	allocs := testing.AllocsPerRun(100, func() {
+		p.ParseInto("Acme Widgets", &res)
+	})
+	assert.Equal(t, float64(0), allocs, "no allocations for unmatched ASCII input")
+}
+
+func BenchmarkParseInto(b *testing.B) {
+	tests := loadStripTests()
+
+	p, err := New()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	var res Result
+	j := 0
+	for i := 0; i < b.N; i++ {
+		err := p.ParseInto(tests[j].Name, &res)
+		if err != nil {
+			b.Fatal(err)
+		}
+		j++
+		if j >= len(tests) {
+			j = 0
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package gocd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Entry returns a new dataset Entry for an accepted Suggestion, using
+// longName and lang for the new entry
+func (s Suggestion) Entry(longName, lang string) Entry {
+	pe := Entry{LongName: longName, Lang: lang, Lead: s.Position == Begin}
+	if s.Designator != longName {
+		pe.Abbr = []string{s.Designator}
+	}
+	return pe
+}
+
+// WriteDatasetPatch writes entries to w as a YAML fragment using the
+// upstream company_designator.yml schema (plus the abbr_re, lang_only,
+// category, public, and ambiguity extensions, if set), suitable for
+// contributing upstream or loading as a dataset. Entries with the same
+// LongName are merged.
+func WriteDatasetPatch(w io.Writer, entries []Entry) error {
+	// Merge entries, preserving order
+	var merged []*Entry
+	seen := make(map[string]*Entry)
+	for _, pe := range entries {
+		pe := pe
+		if pe.LongName == "" {
+			return fmt.Errorf("dataset patch entry missing LongName: %+v", pe)
+		}
+		if m, exists := seen[pe.LongName]; exists {
+			for _, a := range pe.Abbr {
+				if !containsString(m.Abbr, a) {
+					m.Abbr = append(m.Abbr, a)
+				}
+			}
+			for _, a := range pe.AbbrRE {
+				if !containsString(m.AbbrRE, a) {
+					m.AbbrRE = append(m.AbbrRE, a)
+				}
+			}
+			for a, wt := range pe.Ambiguity {
+				if _, exists := m.Ambiguity[a]; !exists {
+					if m.Ambiguity == nil {
+						m.Ambiguity = make(map[string]float64)
+					}
+					m.Ambiguity[a] = wt
+				}
+			}
+			m.Lead = m.Lead || pe.Lead
+			m.LangOnly = m.LangOnly || pe.LangOnly
+			m.Public = m.Public || pe.Public
+			if m.AbbrStd == "" {
+				m.AbbrStd = pe.AbbrStd
+			}
+			if m.Doc == "" {
+				m.Doc = pe.Doc
+			}
+			if m.Category == "" {
+				m.Category = pe.Category
+			}
+			continue
+		}
+		pe.Abbr = append([]string(nil), pe.Abbr...)
+		pe.AbbrRE = append([]string(nil), pe.AbbrRE...)
+		if pe.Ambiguity != nil {
+			ambiguity := make(map[string]float64, len(pe.Ambiguity))
+			for a, wt := range pe.Ambiguity {
+				ambiguity[a] = wt
+			}
+			pe.Ambiguity = ambiguity
+		}
+		seen[pe.LongName] = &pe
+		merged = append(merged, &pe)
+	}
+
+	doc := make(yaml.MapSlice, 0, len(merged))
+	for _, pe := range merged {
+		doc = append(doc, yaml.MapItem{Key: pe.LongName, Value: patchFields(pe)})
+	}
+	if len(doc) == 0 {
+		return nil
+	}
+	data, err := yaml.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// patchFields returns the dataset fields of pe that are set, in upstream
+// schema order
+func patchFields(pe *Entry) yaml.MapSlice {
+	var fields yaml.MapSlice
+	add := func(key string, val interface{}) {
+		fields = append(fields, yaml.MapItem{Key: key, Value: val})
+	}
+	if pe.AbbrStd != "" {
+		add("abbr_std", pe.AbbrStd)
+	}
+	if len(pe.Abbr) > 0 {
+		add("abbr", pe.Abbr)
+	}
+	if len(pe.AbbrRE) > 0 {
+		add("abbr_re", pe.AbbrRE)
+	}
+	if pe.Lang != "" {
+		add("lang", pe.Lang)
+	}
+	if pe.Lead {
+		add("lead", true)
+	}
+	if pe.Doc != "" {
+		add("doc", pe.Doc)
+	}
+	if pe.LangOnly {
+		add("lang_only", true)
+	}
+	if pe.Category != "" {
+		add("category", pe.Category)
+	}
+	if pe.Public {
+		add("public", true)
+	}
+	if len(pe.Ambiguity) > 0 {
+		abbrs := make([]string, 0, len(pe.Ambiguity))
+		for a := range pe.Ambiguity {
+			abbrs = append(abbrs, a)
+		}
+		sort.Strings(abbrs)
+		ambiguity := make(yaml.MapSlice, 0, len(abbrs))
+		for _, a := range abbrs {
+			ambiguity = append(ambiguity, yaml.MapItem{Key: a, Value: pe.Ambiguity[a]})
+		}
+		add("ambiguity", ambiguity)
+	}
+	return fields
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package gocd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestWriteDatasetPatch(t *testing.T) {
+	entries := []Entry{
+		Suggestion{Designator: "Pvt. Ltd.", Position: End}.Entry("Private Limited", "en"),
+		{LongName: "Private Limited", Abbr: []string{"PVT"}},
+		Suggestion{Designator: "TOO", Position: Begin}.Entry("Товарищество с ограниченной ответственностью", "kk"),
+		{LongName: "no", Lang: "no", Doc: "Norwegian: a test"},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteDatasetPatch(&buf, entries); err != nil {
+		t.Fatal(err)
+	}
+	expected := `Private Limited:
+  abbr:
+  - Pvt. Ltd.
+  - PVT
+  lang: en
+Товарищество с ограниченной ответственностью:
+  abbr:
+  - TOO
+  lang: kk
+  lead: true
+"no":
+  lang: "no"
+  doc: 'Norwegian: a test'
+`
+	assert.Equal(t, expected, buf.String(), "dataset patch matches")
+
+	// Patches load as datasets
+	ds := make(dataset)
+	if err := yaml.Unmarshal(buf.Bytes(), ds); err != nil {
+		t.Fatal(err)
+	}
+	ds.init(SourceCore, CorePriority)
+	assert.Equal(t, &Entry{LongName: "Товарищество с ограниченной ответственностью",
+		Abbr: []string{"TOO"}, Lang: "kk", Lead: true, Source: SourceCore},
+		ds["Товарищество с ограниченной ответственностью"], "dataset patch entry loads")
+
+	assert.Error(t, WriteDatasetPatch(&buf, []Entry{{Lang: "en"}}), "missing LongName errors")
+}
+
+func TestWriteDatasetPatchRoundTrip(t *testing.T) {
+	entries := []Entry{
+		{LongName: "Gesellschaft des bürgerlichen Rechts", Abbr: []string{"GbR"}, Lang: "de",
+			Doc: "German civil law partnership:\nnot a company, but often listed as one.\n" +
+				strings.Repeat("A long line that a YAML encoder might fold. ", 5),
+			Category: "partnership", LangOnly: true},
+		{LongName: "Public Limited Company", Abbr: []string{"PLC", "P.L.C."}, Lang: "en",
+			Public: true, Ambiguity: map[string]float64{"PLC": 0.1}},
+		{LongName: "Public Limited Company", AbbrStd: "PLC", Ambiguity: map[string]float64{"P.L.C.": 0}},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteDatasetPatch(&buf, entries); err != nil {
+		t.Fatal(err)
+	}
+	p, err := NewFromDataset(buf.Bytes())
+	if err != nil {
+		t.Fatalf("patch loads as a dataset: %s\n%s", err, buf.String())
+	}
+
+	e := (*p.ds)["Gesellschaft des bürgerlichen Rechts"]
+	if assert.NotNil(t, e, "entry loads") {
+		assert.Equal(t, entries[0].Doc, e.Doc, "multi-line doc round-trips")
+		assert.Equal(t, "partnership", e.Category, "category round-trips")
+		assert.True(t, e.LangOnly, "lang_only round-trips")
+	}
+	e = (*p.ds)["Public Limited Company"]
+	if assert.NotNil(t, e, "merged entry loads") {
+		assert.Equal(t, "PLC", e.AbbrStd, "abbr_std round-trips")
+		assert.True(t, e.Public, "public round-trips")
+		assert.Equal(t, map[string]float64{"PLC": 0.1, "P.L.C.": 0}, e.Ambiguity,
+			"ambiguity round-trips")
+	}
+}
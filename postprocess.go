@@ -0,0 +1,30 @@
+package gocd
+
+// Postprocessor is a function applied to each Result before it is
+// returned, which may adjust res (e.g. to enrich it from internal data
+// keyed by res.DesignatorStd) or veto a match by returning false
+type Postprocessor func(res *Result) bool
+
+// WithPostprocessor adds f to the Parser's result postprocessors, which
+// are run in the order added on every Result (matched or not), after
+// all built-in processing, so derived fields like ShortNameASCII are not
+// recomputed if a postprocessor changes ShortName. If a postprocessor
+// returns false, the Result is reset to an unmatched Result for the same
+// Input (with its derived fields set) and no further postprocessors are
+// run. Cached results are stored postprocessed.
+func WithPostprocessor(f Postprocessor) Option {
+	return func(o *options) {
+		o.postprocessors = append(o.postprocessors, f)
+	}
+}
+
+// postprocess applies the Parser's postprocessors to res, returning
+// false if one vetoed the match
+func (p *Parser) postprocess(res *Result) bool {
+	for _, f := range p.opts.postprocessors {
+		if !f(res) {
+			return false
+		}
+	}
+	return true
+}
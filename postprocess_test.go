@@ -0,0 +1,80 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostprocessor(t *testing.T) {
+	stoplist := map[string]bool{"Acme Widgets": true}
+	ids := map[string]string{"Ltd.": "UK-LTD"}
+	var seen []string
+	p, err := New(
+		WithPostprocessor(func(res *Result) bool {
+			seen = append(seen, res.Input)
+			return !stoplist[res.ShortName]
+		}),
+		WithPostprocessor(func(res *Result) bool {
+			if id, ok := ids[res.DesignatorStd]; ok {
+				res.DesignatorStd = id
+			}
+			return true
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input         string
+		matched       bool
+		shortName     string
+		designatorStd string
+	}{
+		{"Acme Ltd", true, "Acme", "UK-LTD"},
+		{"Acme Widgets Ltd", false, "Acme Widgets Ltd", ""},
+		{"Acme LLC", true, "Acme", "LLC"},
+		{"Bar Baz", false, "Bar Baz", ""},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.matched, res.Matched, "Matched for %q", tc.input)
+		assert.Equal(t, tc.shortName, res.ShortName, "ShortName matches for %q", tc.input)
+		assert.Equal(t, tc.designatorStd, res.DesignatorStd, "DesignatorStd matches for %q", tc.input)
+	}
+	assert.Equal(t, []string{"Acme Ltd", "Acme Widgets Ltd", "Acme LLC", "Bar Baz"}, seen,
+		"postprocessors run on every Result")
+}
+
+func TestPostprocessorDerivedFields(t *testing.T) {
+	p, err := New(
+		WithShortNameASCII(),
+		WithPostprocessor(func(res *Result) bool {
+			res.ShortName = "Rewritten"
+			return res.Designator != "AG"
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := p.Parse("Société Générale SA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "Rewritten", res.ShortName, "postprocessor ShortName kept")
+	assert.Equal(t, "Societe Generale", res.ShortNameASCII,
+		"ShortNameASCII computed before postprocessors")
+
+	res, err = p.Parse("Müller AG")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, res.Matched, "postprocessor vetoes match")
+	assert.Equal(t, "Müller AG", res.ShortName, "vetoed ShortName is the input")
+	assert.Equal(t, "Muller AG", res.ShortNameASCII, "vetoed ShortNameASCII recomputed")
+}
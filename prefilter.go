@@ -0,0 +1,160 @@
+package gocd
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// The end-match regexes are very large, and most company names don't
+// have a designator at all, so we prefilter end matching by checking
+// the final token of the input against the set of tokens that can end
+// a designator. Tokens are maximal runs of letters and digits, with
+// diacritics stripped and case folded (including sharp s to "ss").
+
+// isTokenRune returns true if r is part of a token
+func isTokenRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r)
+}
+
+// foldRune returns the canonical case folding of r (the lowercase of the
+// smallest rune in its simple fold orbit), consistent with regexp's (?i)
+// matching
+func foldRune(r rune) rune {
+	min := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < min {
+			min = f
+		}
+	}
+	return unicode.ToLower(min)
+}
+
+// foldToken returns the folded token key for the NFD token s
+func foldToken(s string) string {
+	return string(appendFold(nil, []byte(s)))
+}
+
+// appendFold appends the folded token key for the NFD token tok to dst
+func appendFold(dst, tok []byte) []byte {
+	for len(tok) > 0 {
+		r, size := utf8.DecodeRune(tok)
+		tok = tok[size:]
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		dst = appendFoldRune(dst, foldRune(r))
+	}
+	return dst
+}
+
+// lastToken returns the folded final token of the NFD input in
+func lastToken(in []byte) string {
+	return string(appendLastToken(nil, in))
+}
+
+// appendLastToken appends the folded final token of the NFD input in to dst
+func appendLastToken(dst, in []byte) []byte {
+	end := len(in)
+	for end > 0 {
+		r, size := utf8.DecodeLastRune(in[:end])
+		if isTokenRune(r) {
+			break
+		}
+		end -= size
+	}
+	start := end
+	for start > 0 {
+		r, size := utf8.DecodeLastRune(in[:start])
+		if !isTokenRune(r) {
+			break
+		}
+		start -= size
+	}
+	return appendFold(dst, in[start:end])
+}
+
+// addDesTokens adds the possible final tokens of designator des to
+// tokens. Since PeriodTransform makes periods (and any following spaces)
+// optional, tokens separated only by periods may be run together in
+// input, so we add each such concatenated suffix as well (likewise for
+// Hebrew gershayim, see GershayimTransform). Returns false if des
+// contains no tokens.
+func addDesTokens(tokens map[string]bool, des string) bool {
+	des = norm.NFD.String(des)
+	suffix := ""
+	sep := ""
+	end := len(des)
+	for end > 0 {
+		r, size := utf8.DecodeLastRuneInString(des[:end])
+		if !isTokenRune(r) {
+			sep = string(r) + sep
+			end -= size
+			continue
+		}
+		start := end
+		for start > 0 {
+			r, size := utf8.DecodeLastRuneInString(des[:start])
+			if !isTokenRune(r) {
+				break
+			}
+			start -= size
+		}
+		if suffix != "" && !isPeriodSep(sep) && !isGershayimSep(sep) {
+			break
+		}
+		suffix = foldToken(des[start:end]) + suffix
+		tokens[suffix] = true
+		sep = ""
+		end = start
+	}
+	return suffix != ""
+}
+
+// isPeriodSep returns true if sep consists of one or more periods
+// each optionally followed by whitespace (cf. PeriodTransform)
+func isPeriodSep(sep string) bool {
+	if !strings.HasPrefix(sep, ".") {
+		return false
+	}
+	for _, r := range sep {
+		if r != '.' && !unicode.Is(unicode.Z, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// compileLastTokens returns the set of possible final designator tokens
+// for the dataset, or nil if any designator has no tokens or is a regex
+// (in which case prefiltering is not possible)
+func compileLastTokens(ds *dataset) map[string]bool {
+	tokens := make(map[string]bool)
+	for long, e := range *ds {
+		if len(e.AbbrRE) > 0 {
+			return nil
+		}
+		if !addDesTokens(tokens, long) {
+			return nil
+		}
+		for _, a := range e.Abbr {
+			if !addDesTokens(tokens, a) {
+				return nil
+			}
+		}
+	}
+	return tokens
+}
+
+// endCandidate returns true if the NFD input in might end with a
+// designator, according to its final token
+func (p *Parser) endCandidate(in []byte) bool {
+	if p.lastTokens == nil {
+		return true
+	}
+	// Fold into a stack buffer, to avoid allocating for most inputs
+	var buf [64]byte
+	return p.lastTokens[string(appendLastToken(buf[:0], in))]
+}
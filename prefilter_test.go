@@ -0,0 +1,101 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestAddDesTokens(t *testing.T) {
+	tests := []struct {
+		des    string
+		tokens []string
+	}{
+		{"Ltd.", []string{"ltd"}},
+		{"S.A.", []string{"a", "sa"}},
+		{"Co. Ltd.", []string{"ltd", "coltd"}},
+		{"Pty Ltd", []string{"ltd"}},
+		{"Ges.m.b.H.", []string{"h", "bh", "mbh", "gesmbh"}},
+		{"Sp. z o.o.", []string{"o", "oo"}},
+		{"Ltée", []string{"ltee"}},
+		{"A/S", []string{"s"}},
+		{"株式会社", []string{"株式会社"}},
+	}
+
+	for _, tc := range tests {
+		tokens := make(map[string]bool)
+		assert.True(t, addDesTokens(tokens, tc.des), "addDesTokens ok for %q", tc.des)
+		expected := make(map[string]bool)
+		for _, tok := range tc.tokens {
+			expected[tok] = true
+		}
+		assert.Equal(t, expected, tokens, "addDesTokens matches for %q", tc.des)
+	}
+
+	assert.False(t, addDesTokens(make(map[string]bool), "&"), "addDesTokens fails without tokens")
+}
+
+func TestLastToken(t *testing.T) {
+	tests := []struct {
+		input string
+		token string
+	}{
+		{"Profound Networks LLC", "llc"},
+		{"ADVANSOFT (L.L.C)  ", "c"},
+		{"Acme LTÉE.", "ltee"},
+		{"...", ""},
+		{"", ""},
+	}
+
+	for _, tc := range tests {
+		input := norm.NFD.String(tc.input)
+		assert.Equal(t, tc.token, lastToken([]byte(input)), "lastToken matches for %q", tc.input)
+	}
+}
+
+// Check that the prefilter doesn't change any results
+func TestPrefilter(t *testing.T) {
+	tests := loadTests()
+
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotNil(t, p.lastTokens, "prefilter tokens compiled")
+	unfiltered := *p
+	unfiltered.lastTokens = nil
+
+	names := []string{
+		"Acme Co.Ltd.", "Acme S.A", "Acme SA.", "Acme GesmbH", "Acme (GmbH&Co.KG)",
+		"Acme Sp.zo.o.Sp.k", "Acme Ltée", "ACME LTEE", "Acme", "Acme & Sons",
+	}
+	for _, tc := range tests {
+		names = append(names, tc.Name)
+	}
+	for _, name := range names {
+		res, err := p.Parse(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res2, err := unfiltered.Parse(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, res2, res, "prefiltered result matches for %q", name)
+	}
+}
+
+func BenchmarkPrefilterMiss(b *testing.B) {
+	p, err := New()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	for i := 0; i < b.N; i++ {
+		_, err := p.Parse("Beaumont Street Dental Practice")
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
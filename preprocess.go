@@ -0,0 +1,38 @@
+package gocd
+
+import "golang.org/x/text/unicode/norm"
+
+// Preprocessor is a function applied to input before matching, such as
+// HTML unescaping or site-specific punctuation fixes. Preprocessors
+// receive and must return valid UTF-8.
+type Preprocessor func(input string) string
+
+// WithPreprocessor adds f to the Parser's input preprocessors, which
+// are run in the order added (after invalid UTF-8 handling and before
+// any WithMaxInputLength check) on each input before matching. The
+// Result reports the original input as Input, while ShortName,
+// Qualifier, and Designator are taken from the preprocessed input, so
+// are substrings of it rather than of Input (and OutputOriginal
+// reproduces the preprocessed bytes). Preprocessors are also run by
+// Explain, and cached results are keyed by the original input.
+func WithPreprocessor(f Preprocessor) Option {
+	return func(o *options) {
+		o.preprocessors = append(o.preprocessors, f)
+	}
+}
+
+// preprocess applies any NFKC normalisation and the Parser's
+// preprocessors to input, returning the result as both bytes and a
+// string, and the (normalised) input the preprocessors received. input
+// is not modified.
+func (p *Parser) preprocess(input []byte) ([]byte, string, string) {
+	s := string(input)
+	if p.opts.nfkc {
+		s = norm.NFKC.String(s)
+	}
+	orig := s
+	for _, f := range p.opts.preprocessors {
+		s = f(s)
+	}
+	return []byte(s), s, orig
+}
@@ -0,0 +1,86 @@
+package gocd
+
+import (
+	"html"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreprocessor(t *testing.T) {
+	p, err := New(
+		WithPreprocessor(html.UnescapeString),
+		WithPreprocessor(func(s string) string {
+			return strings.Replace(s, "_", " ", -1)
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input      string
+		shortName  string
+		designator string
+	}{
+		{"Smith &amp; Sons Ltd", "Smith & Sons", "Ltd"},
+		{"Acme_Widgets_Pty_Ltd", "Acme Widgets", "Pty Ltd"},
+		{"Acme GmbH &amp; Co. KG", "Acme", "GmbH & Co. KG"},
+		{"Acme Widgets", "Acme Widgets", ""},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.input, res.Input, "Input is the original for %q", tc.input)
+		assert.Equal(t, tc.shortName, res.ShortName, "ShortName matches for %q", tc.input)
+		assert.Equal(t, tc.designator, res.Designator, "Designator matches for %q", tc.input)
+	}
+
+	// Clones keep and extend the chain, in order
+	c, err := p.Clone(WithPreprocessor(strings.TrimSpace))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, p.opts.preprocessors, 2, "parent preprocessors unchanged")
+	res, err := c.Parse(" Acme_Ltd ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, " Acme_Ltd ", res.Input, "cloned Input matches")
+	assert.Equal(t, "Ltd", res.Designator, "cloned Designator matches")
+}
+
+func TestPreprocessorLengthChange(t *testing.T) {
+	// Result strings come from the preprocessed text, which is longer
+	// than the input here, while Input is unchanged
+	expand := func(s string) string {
+		return strings.Replace(s, "&", " and ", -1)
+	}
+	veto := func(res *Result) bool {
+		return res.ShortName != "Fish and Chips"
+	}
+	p, err := New(WithPreprocessor(expand), WithOutputForm(OutputOriginal), WithPostprocessor(veto))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := "Smith\u0301&Sons Ltd"
+	res, err := p.Parse(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, input, res.Input, "Input is the original")
+	assert.Equal(t, "Smith\u0301 and Sons", res.ShortName, "ShortName is from the preprocessed text")
+	assert.Equal(t, "Ltd", res.Designator, "Designator is from the preprocessed text")
+
+	res, err = p.Parse("Fish&Chips Ltd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, res.Matched, "postprocessor vetoes match")
+	assert.Equal(t, "Fish&Chips Ltd", res.Input, "vetoed Input is the original")
+	assert.Equal(t, "Fish and Chips Ltd", res.ShortName, "vetoed ShortName is the preprocessed text")
+}
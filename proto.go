@@ -0,0 +1,160 @@
+package gocd
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrProto is returned by UnmarshalProto for malformed data
+var ErrProto = errors.New("invalid protobuf Result")
+
+// Protocol Buffers wire types
+const (
+	wireVarint = 0
+	wire64Bit  = 1
+	wireBytes  = 2
+	wire32Bit  = 5
+)
+
+// appendUvarint appends the varint encoding of v to b
+func appendUvarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	return append(b, buf[:n]...)
+}
+
+// MarshalProto encodes r as a gocd.v1.Result Protocol Buffers message
+// (see proto/gocd/v1/result.proto). Entry is not encoded.
+func (r *Result) MarshalProto() ([]byte, error) {
+	var b []byte
+	appendString := func(field int, s string) {
+		if s != "" {
+			b = appendUvarint(b, uint64(field<<3|wireBytes))
+			b = appendUvarint(b, uint64(len(s)))
+			b = append(b, s...)
+		}
+	}
+	appendVarint := func(field int, v uint64) {
+		if v != 0 {
+			b = appendUvarint(b, uint64(field<<3|wireVarint))
+			b = appendUvarint(b, v)
+		}
+	}
+
+	appendString(1, r.Input)
+	if r.Matched {
+		appendVarint(2, 1)
+	}
+	appendString(3, r.ShortName)
+	appendString(4, r.Designator)
+	appendString(5, r.DesignatorStd)
+	appendString(6, r.DesignatorLong)
+	appendVarint(7, uint64(r.Position))
+	appendVarint(8, uint64(r.MatchKind))
+	appendString(9, r.Qualifier)
+	appendString(10, r.Lang)
+	appendString(11, r.Category)
+	appendString(12, r.Source)
+	if r.Public {
+		appendVarint(13, 1)
+	}
+	appendString(14, r.ShortNameASCII)
+	appendString(15, r.NameLang)
+	if r.LangMismatch {
+		appendVarint(16, 1)
+	}
+	if r.Ambiguity != 0 {
+		var buf [8]byte
+		binary.LittleEndian.PutUint64(buf[:], math.Float64bits(r.Ambiguity))
+		b = appendUvarint(b, uint64(17<<3|wire64Bit))
+		b = append(b, buf[:]...)
+	}
+	return b, nil
+}
+
+// UnmarshalProto decodes a gocd.v1.Result Protocol Buffers message into
+// r, skipping unknown fields for forward compatibility. Entry is nil
+// after decoding.
+func (r *Result) UnmarshalProto(b []byte) error {
+	*r = Result{}
+	for len(b) > 0 {
+		tag, n := binary.Uvarint(b)
+		if n <= 0 {
+			return fmt.Errorf("%w: bad tag", ErrProto)
+		}
+		b = b[n:]
+		field, wire := int(tag>>3), int(tag&7)
+
+		var v uint64
+		var s string
+		switch wire {
+		case wireVarint:
+			v, n = binary.Uvarint(b)
+			if n <= 0 {
+				return fmt.Errorf("%w: bad varint for field %d", ErrProto, field)
+			}
+			b = b[n:]
+		case wireBytes:
+			l, n := binary.Uvarint(b)
+			if n <= 0 || l > uint64(len(b)-n) {
+				return fmt.Errorf("%w: bad length for field %d", ErrProto, field)
+			}
+			s = string(b[n : n+int(l)])
+			b = b[n+int(l):]
+		case wire64Bit, wire32Bit:
+			size := 8
+			if wire == wire32Bit {
+				size = 4
+			}
+			if len(b) < size {
+				return fmt.Errorf("%w: truncated field %d", ErrProto, field)
+			}
+			if wire == wire64Bit {
+				v = binary.LittleEndian.Uint64(b)
+			}
+			b = b[size:]
+		default:
+			return fmt.Errorf("%w: unsupported wire type %d", ErrProto, wire)
+		}
+
+		switch field {
+		case 1:
+			r.Input = s
+		case 2:
+			r.Matched = v != 0
+		case 3:
+			r.ShortName = s
+		case 4:
+			r.Designator = s
+		case 5:
+			r.DesignatorStd = s
+		case 6:
+			r.DesignatorLong = s
+		case 7:
+			r.Position = PositionType(v)
+		case 8:
+			r.MatchKind = MatchKind(v)
+		case 9:
+			r.Qualifier = s
+		case 10:
+			r.Lang = s
+		case 11:
+			r.Category = s
+		case 12:
+			r.Source = s
+		case 13:
+			r.Public = v != 0
+		case 14:
+			r.ShortNameASCII = s
+		case 15:
+			r.NameLang = s
+		case 16:
+			r.LangMismatch = v != 0
+		case 17:
+			r.Ambiguity = math.Float64frombits(v)
+		}
+	}
+	return nil
+}
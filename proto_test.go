@@ -0,0 +1,46 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResultProto(t *testing.T) {
+	// Hand-encoded: input "A", matched, position end
+	b, err := (&Result{Input: "A", Matched: true, Position: End}).MarshalProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []byte{0x0a, 0x01, 'A', 0x10, 0x01, 0x38, 0x01}, b, "wire encoding matches")
+
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, input := range []string{"Acme (UK) Ltd", "ООО Ромашка", "Acme Widgets", "Acme S.A.", ""} {
+		res, err := p.Parse(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := res.MarshalProto()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded Result
+		err = decoded.UnmarshalProto(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := *res
+		expected.Entry = nil
+		assert.Equal(t, expected, decoded, "proto round trip matches for %q", input)
+	}
+
+	// Unknown fields are skipped: field 100 varint, field 101 bytes, field 102 fixed64
+	unknown := append([]byte{0xa0, 0x06, 0x05, 0xaa, 0x06, 0x02, 'x', 'y', 0xb1, 0x06, 1, 2, 3, 4, 5, 6, 7, 8}, b...)
+	var decoded Result
+	assert.NoError(t, decoded.UnmarshalProto(unknown), "unknown fields skipped")
+
+	assert.ErrorIs(t, decoded.UnmarshalProto([]byte{0x0a, 0x05, 'A'}), ErrProto, "truncated data errors")
+}
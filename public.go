@@ -0,0 +1,39 @@
+package gocd
+
+// PublicForms holds the long names of the default dataset entries for
+// forms that permit public trading of shares (e.g. PLC, AG, S.A., KK).
+// Forms used by both public and private companies (e.g. Inc., AB) are
+// not included. Entries are flagged when loaded, and overlay entries can
+// set the flag with `public: Y`.
+var PublicForms = map[string]bool{
+	"Akciju sabiedrība":                             true,
+	"Aktiengesellschaft":                            true,
+	"Aktieselskab":                                  true,
+	"Allmennaksjeselskap":                           true,
+	"Cwmni Cyfyngedig Cyhoeddus":                    true,
+	"Delniška družba":                               true,
+	"Halka Açık Anonim Ortaklık":                    true,
+	"Julkinen osakeyhtiö":                           true,
+	"Kommanditaktiengesellschaft":                   true,
+	"Kommanditgesellschaft auf Aktien":              true,
+	"Naamloze vennootschap":                         true,
+	"Nyilvánosan Működő Részvénytársaság":           true,
+	"Open Joint Stock Company":                      true,
+	"Perseroan Terbatas Terbuka":                    true,
+	"Public Joint Stock Company":                    true,
+	"Public Limited Company":                        true,
+	"Shoqeri Aksionere":                             true,
+	"Sociedad Anónima":                              true,
+	"Sociedad Anónima Bursátil de Capital Variable": true,
+	"Sociedade anônima":                             true,
+	"Società per Azioni":                            true,
+	"Société anonyme":                               true,
+	"opinbert hlutafélag":                           true,
+	"spółka akcyjna":                                true,
+	"Акционерно дружество":                          true,
+	"Открытое акционерное общество":                 true,
+	"Публичное акционерное общество":                true,
+	"股份有限公司":                                        true,
+	"株式会社":                                          true,
+	"주식회사":                                          true,
+}
@@ -0,0 +1,51 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicForms(t *testing.T) {
+	p, err := New(WithOverlay([]byte(`
+Widgetschaft:
+  abbr:
+    - WSch
+  lang: de
+  public: Y
+`)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input  string
+		public bool
+	}{
+		{"Acme PLC", true},
+		{"Acme AG", true},
+		{"Acme S.A.", true},
+		{"Acme KK", true},
+		{"ПАО Газпром", true},
+		{"Acme Ltd", false},
+		{"Acme GmbH", false},
+		{"Acme LLC", false},
+		{"Acme Inc.", false},
+		{"Acme WSch", true},
+		{"Acme", false},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, res.Matched || tc.input == "Acme", "Matched matches for %q", tc.input)
+		assert.Equal(t, tc.public, res.Public, "Public matches for %q", tc.input)
+	}
+
+	// All PublicForms are dataset entries
+	for long := range PublicForms {
+		_, exists := (*p.ds)[long]
+		assert.True(t, exists, "PublicForms entry %q exists", long)
+	}
+}
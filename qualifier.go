@@ -0,0 +1,168 @@
+package gocd
+
+import "strings"
+
+// qualifierCodes holds the country and jurisdiction codes accepted as
+// bracketed qualifiers, which must match in upper case (ignoring
+// periods e.g. "U.K.") so that e.g. "(IT)" is not mistaken for "(it)"
+var qualifierCodes = map[string]bool{
+	// ISO 3166-1 alpha-2
+	"AD": true, "AE": true, "AF": true, "AG": true, "AI": true, "AL": true,
+	"AM": true, "AO": true, "AQ": true, "AR": true, "AS": true, "AT": true,
+	"AU": true, "AW": true, "AX": true, "AZ": true, "BA": true, "BB": true,
+	"BD": true, "BE": true, "BF": true, "BG": true, "BH": true, "BI": true,
+	"BJ": true, "BL": true, "BM": true, "BN": true, "BO": true, "BQ": true,
+	"BR": true, "BS": true, "BT": true, "BV": true, "BW": true, "BY": true,
+	"BZ": true, "CA": true, "CC": true, "CD": true, "CF": true, "CG": true,
+	"CH": true, "CI": true, "CK": true, "CL": true, "CM": true, "CN": true,
+	"CO": true, "CR": true, "CU": true, "CV": true, "CW": true, "CX": true,
+	"CY": true, "CZ": true, "DE": true, "DJ": true, "DK": true, "DM": true,
+	"DO": true, "DZ": true, "EC": true, "EE": true, "EG": true, "EH": true,
+	"ER": true, "ES": true, "ET": true, "FI": true, "FJ": true, "FK": true,
+	"FM": true, "FO": true, "FR": true, "GA": true, "GB": true, "GD": true,
+	"GE": true, "GF": true, "GG": true, "GH": true, "GI": true, "GL": true,
+	"GM": true, "GN": true, "GP": true, "GQ": true, "GR": true, "GS": true,
+	"GT": true, "GU": true, "GW": true, "GY": true, "HK": true, "HM": true,
+	"HN": true, "HR": true, "HT": true, "HU": true, "ID": true, "IE": true,
+	"IL": true, "IM": true, "IN": true, "IO": true, "IQ": true, "IR": true,
+	"IS": true, "IT": true, "JE": true, "JM": true, "JO": true, "JP": true,
+	"KE": true, "KG": true, "KH": true, "KI": true, "KM": true, "KN": true,
+	"KP": true, "KR": true, "KW": true, "KY": true, "KZ": true, "LA": true,
+	"LB": true, "LC": true, "LI": true, "LK": true, "LR": true, "LS": true,
+	"LT": true, "LU": true, "LV": true, "LY": true, "MA": true, "MC": true,
+	"MD": true, "ME": true, "MF": true, "MG": true, "MH": true, "MK": true,
+	"ML": true, "MM": true, "MN": true, "MO": true, "MP": true, "MQ": true,
+	"MR": true, "MS": true, "MT": true, "MU": true, "MV": true, "MW": true,
+	"MX": true, "MY": true, "MZ": true, "NA": true, "NC": true, "NE": true,
+	"NF": true, "NG": true, "NI": true, "NL": true, "NO": true, "NP": true,
+	"NR": true, "NU": true, "NZ": true, "OM": true, "PA": true, "PE": true,
+	"PF": true, "PG": true, "PH": true, "PK": true, "PL": true, "PM": true,
+	"PN": true, "PR": true, "PS": true, "PT": true, "PW": true, "PY": true,
+	"QA": true, "RE": true, "RO": true, "RS": true, "RU": true, "RW": true,
+	"SA": true, "SB": true, "SC": true, "SD": true, "SE": true, "SG": true,
+	"SH": true, "SI": true, "SJ": true, "SK": true, "SL": true, "SM": true,
+	"SN": true, "SO": true, "SR": true, "SS": true, "ST": true, "SV": true,
+	"SX": true, "SY": true, "SZ": true, "TC": true, "TD": true, "TF": true,
+	"TG": true, "TH": true, "TJ": true, "TK": true, "TL": true, "TM": true,
+	"TN": true, "TO": true, "TR": true, "TT": true, "TV": true, "TW": true,
+	"TZ": true, "UA": true, "UG": true, "UM": true, "US": true, "UY": true,
+	"UZ": true, "VA": true, "VC": true, "VE": true, "VG": true, "VI": true,
+	"VN": true, "VU": true, "WF": true, "WS": true, "YE": true, "YT": true,
+	"ZA": true, "ZM": true, "ZW": true,
+	// Common non-ISO codes and alpha-3 forms
+	"UK": true, "USA": true, "UAE": true, "PRC": true, "ROC": true,
+	"KSA": true, "EU": true, "EMEA": true, "APAC": true, "BVI": true,
+}
+
+// qualifierNames holds the lower case country, region, and
+// jurisdiction names accepted as bracketed qualifiers, in English and
+// (for countries commonly qualifying local subsidiaries) the local
+// language
+var qualifierNames = map[string]bool{
+	// Countries and territories
+	"afghanistan": true, "albania": true, "algeria": true, "andorra": true,
+	"angola": true, "anguilla": true, "antigua": true, "argentina": true,
+	"armenia": true, "aruba": true, "australia": true, "austria": true,
+	"azerbaijan": true, "bahamas": true, "bahrain": true,
+	"bangladesh": true, "barbados": true, "belarus": true, "belgium": true,
+	"belize": true, "benin": true, "bermuda": true, "bhutan": true,
+	"bolivia": true, "bosnia": true, "botswana": true, "brazil": true,
+	"british virgin islands": true, "brunei": true, "bulgaria": true,
+	"burkina faso": true, "burundi": true, "cambodia": true,
+	"cameroon": true, "canada": true, "cape verde": true, "cayman": true,
+	"cayman islands": true, "chad": true, "chile": true, "china": true,
+	"colombia": true, "congo": true, "costa rica": true, "croatia": true,
+	"cuba": true, "curacao": true, "cyprus": true, "czech republic": true,
+	"czechia": true, "denmark": true, "djibouti": true, "dominica": true,
+	"dominican republic": true, "ecuador": true, "egypt": true,
+	"el salvador": true, "estonia": true, "eswatini": true,
+	"ethiopia": true, "fiji": true, "finland": true, "france": true,
+	"gabon": true, "gambia": true, "georgia": true, "germany": true,
+	"ghana": true, "gibraltar": true, "greece": true, "grenada": true,
+	"guam": true, "guatemala": true, "guernsey": true, "guinea": true,
+	"guyana": true, "haiti": true, "honduras": true, "hong kong": true,
+	"hungary": true, "iceland": true, "india": true, "indonesia": true,
+	"iran": true, "iraq": true, "ireland": true, "isle of man": true,
+	"israel": true, "italy": true, "ivory coast": true, "jamaica": true,
+	"japan": true, "jersey": true, "jordan": true, "kazakhstan": true,
+	"kenya": true, "korea": true, "kosovo": true, "kuwait": true,
+	"kyrgyzstan": true, "laos": true, "latvia": true, "lebanon": true,
+	"lesotho": true, "liberia": true, "libya": true, "liechtenstein": true,
+	"lithuania": true, "luxembourg": true, "macao": true, "macau": true,
+	"madagascar": true, "malawi": true, "malaysia": true, "maldives": true,
+	"mali": true, "malta": true, "mauritania": true, "mauritius": true,
+	"mexico": true, "moldova": true, "monaco": true, "mongolia": true,
+	"montenegro": true, "morocco": true, "mozambique": true,
+	"myanmar": true, "namibia": true, "nepal": true, "netherlands": true,
+	"new zealand": true, "nicaragua": true, "niger": true, "nigeria": true,
+	"north macedonia": true, "norway": true, "oman": true,
+	"pakistan": true, "palestine": true, "panama": true,
+	"papua new guinea": true, "paraguay": true, "peru": true,
+	"philippines": true, "poland": true, "portugal": true,
+	"puerto rico": true, "qatar": true, "romania": true, "russia": true,
+	"rwanda": true, "saudi arabia": true, "senegal": true, "serbia": true,
+	"seychelles": true, "sierra leone": true, "singapore": true,
+	"slovakia": true, "slovenia": true, "somalia": true,
+	"south africa": true, "south korea": true, "spain": true,
+	"sri lanka": true, "sudan": true, "suriname": true, "sweden": true,
+	"switzerland": true, "syria": true, "taiwan": true, "tajikistan": true,
+	"tanzania": true, "thailand": true, "togo": true, "trinidad": true,
+	"trinidad and tobago": true, "tunisia": true, "turkey": true,
+	"turkiye": true, "turkmenistan": true, "uganda": true, "ukraine": true,
+	"united arab emirates": true, "united kingdom": true,
+	"united states": true, "united states of america": true,
+	"uruguay": true, "uzbekistan": true, "vanuatu": true,
+	"venezuela": true, "vietnam": true, "viet nam": true, "yemen": true,
+	"zambia": true, "zimbabwe": true,
+	// Constituent countries and regions
+	"great britain": true, "britain": true, "england": true,
+	"scotland": true, "wales": true, "northern ireland": true,
+	"america": true, "europe": true, "asia": true, "asia pacific": true,
+	"africa": true, "middle east": true, "latin america": true,
+	"north america": true, "south america": true, "east africa": true,
+	"west africa": true, "southern africa": true, "scandinavia": true,
+	"nordic": true, "benelux": true, "gulf": true,
+	// Sub-national jurisdictions
+	"alabama": true, "alaska": true, "arizona": true, "arkansas": true,
+	"california": true, "colorado": true, "connecticut": true,
+	"delaware": true, "florida": true, "hawaii": true, "idaho": true,
+	"illinois": true, "indiana": true, "iowa": true, "kansas": true,
+	"kentucky": true, "louisiana": true, "maine": true, "maryland": true,
+	"massachusetts": true, "michigan": true, "minnesota": true,
+	"mississippi": true, "missouri": true, "montana": true,
+	"nebraska": true, "nevada": true, "new hampshire": true,
+	"new jersey": true, "new mexico": true, "new york": true,
+	"north carolina": true, "north dakota": true, "ohio": true,
+	"oklahoma": true, "oregon": true, "pennsylvania": true,
+	"rhode island": true, "south carolina": true, "south dakota": true,
+	"tennessee": true, "texas": true, "utah": true, "vermont": true,
+	"virginia": true, "washington": true, "west virginia": true,
+	"wisconsin": true, "wyoming": true, "alberta": true,
+	"british columbia": true, "manitoba": true, "new brunswick": true,
+	"newfoundland": true, "nova scotia": true, "ontario": true,
+	"quebec": true, "saskatchewan": true, "new south wales": true,
+	"queensland": true, "south australia": true, "tasmania": true,
+	"victoria": true, "western australia": true, "beijing": true,
+	"shanghai": true, "shenzhen": true, "guangzhou": true,
+	"guangdong": true, "tianjin": true, "chongqing": true, "suzhou": true,
+	"hangzhou": true, "jiangsu": true, "zhejiang": true, "dubai": true,
+	"abu dhabi": true,
+	// Local language names
+	"deutschland": true, "österreich": true, "schweiz": true,
+	"suisse": true, "svizzera": true, "españa": true, "espana": true,
+	"italia": true, "nederland": true, "belgië": true, "belgique": true,
+	"danmark": true, "sverige": true, "norge": true, "suomi": true,
+	"polska": true, "česko": true, "brasil": true, "méxico": true,
+	"perú": true, "türkiye": true, "россия": true, "україна": true,
+	"中国": true, "日本": true, "香港": true, "台湾": true, "上海": true, "北京": true,
+	"深圳": true,
+}
+
+// isQualifier returns true if the NFC-normalised bracketed text s is a
+// known country or jurisdiction, for splitQualifier
+func isQualifier(s string) bool {
+	if qualifierCodes[strings.ReplaceAll(s, ".", "")] {
+		return true
+	}
+	return qualifierNames[strings.ToLower(strings.Join(strings.Fields(s), " "))]
+}
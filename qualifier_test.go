@@ -0,0 +1,44 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQualifier(t *testing.T) {
+	tests := []struct {
+		input     string
+		short     string
+		des       string
+		qualifier string
+	}{
+		{"Acme (UK) Ltd", "Acme", "Ltd", "UK"},
+		{"Acme (Thailand) Co., Ltd.", "Acme", "Co., Ltd.", "Thailand"},
+		{"Acme ( Hong Kong ) Limited", "Acme", "Limited", "Hong Kong"},
+		{"MIH Internet Africa (Pty) Ltd", "MIH Internet Africa", "(Pty) Ltd", ""},
+		{"Acme (U.K.) Ltd", "Acme", "Ltd", "U.K."},
+		{"Acme (Deutschland) GmbH", "Acme", "GmbH", "Deutschland"},
+		{"Acme (Shanghai) Co., Ltd.", "Acme", "Co., Ltd.", "Shanghai"},
+		{"Acme Ltd", "Acme", "Ltd", ""},
+		// Only countries and jurisdictions are qualifiers
+		{"Acme (Holdings) Ltd", "Acme (Holdings)", "Ltd", ""},
+		{"Acme (1998) Ltd", "Acme (1998)", "Ltd", ""},
+		{"Acme (it) Ltd", "Acme (it)", "Ltd", ""},
+	}
+
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.short, res.ShortName, "ShortName matches")
+		assert.Equal(t, tc.des, res.Designator, "Designator matches")
+		assert.Equal(t, tc.qualifier, res.Qualifier, "Qualifier matches")
+	}
+}
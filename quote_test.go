@@ -0,0 +1,59 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuoteNormalization(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk, err := p.Clone(WithoutQuoteNormalization())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input      string
+		shortName  string
+		designator string
+		keepShort  string
+		keepDes    string
+	}{
+		{"O’Brien’s Ltd.", "O'Brien's", "Ltd.", "O’Brien’s", "Ltd."},
+		{"McDonald´s Corp", "McDonald's", "Corp", "McDonald´s", "Corp"},
+		{"Acme` Ltd", "Acme", "Ltd", "Acme`", "Ltd"},
+		{"Acme`Ltd", "Acme", "Ltd", "Acme`Ltd", ""},
+		{"“Acme” Ltd", `"Acme`, "Ltd", "“Acme", "Ltd"},
+		{"Acme's Widgets Ltd", "Acme's Widgets", "Ltd", "Acme's Widgets", "Ltd"},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.shortName, res.ShortName, "ShortName matches for %q", tc.input)
+		assert.Equal(t, tc.designator, res.Designator, "Designator matches for %q", tc.input)
+
+		res, err = pk.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.keepShort, res.ShortName, "unnormalised ShortName matches for %q", tc.input)
+		assert.Equal(t, tc.keepDes, res.Designator, "unnormalised Designator matches for %q", tc.input)
+	}
+
+	// Original output keeps the input's quotes
+	po, err := p.Clone(WithOutputForm(OutputOriginal))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := po.Parse("O’Brien’s Ltd.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "O’Brien’s", res.ShortName, "original ShortName keeps quotes")
+}
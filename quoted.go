@@ -0,0 +1,69 @@
+package gocd
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// quotePairs maps opening quotation marks to their closing marks
+var quotePairs = map[rune]rune{
+	'"': '"', '\'': '\'',
+	'«': '»', '»': '«', '‹': '›', '›': '‹',
+	'„': '“', '“': '”', '‚': '‘', '‘': '’',
+	'「': '」', '『': '』',
+}
+
+// WithQuoteStripping removes quotation marks wrapping the ShortName of
+// matched names, as registries in e.g. Russia and Ukraine commonly
+// write them, so that ООО "Ромашка" and АО «Вектор» have ShortNames
+// Ромашка and Вектор. Quotation marks within the name are kept.
+func WithQuoteStripping() Option {
+	return func(o *options) {
+		o.unquoteShort = true
+	}
+}
+
+// unquote returns short with any quotation marks wrapping the whole of
+// it removed. A missing closing mark is allowed, as it is usually taken
+// as the punctuation preceding an end designator (e.g. "Ромашка" ООО),
+// but short is returned unchanged if its quotes don't nest properly
+// (e.g. "Вектор" и "Ко").
+func unquote(short string) string {
+	openQ, size := utf8.DecodeRuneInString(short)
+	closeQ, ok := quotePairs[openQ]
+	if !ok {
+		return short
+	}
+	inner := strings.TrimSuffix(short[size:], string(closeQ))
+	if !balancedQuotes(inner, openQ, closeQ) {
+		return short
+	}
+	inner = strings.TrimSpace(inner)
+	if inner == "" {
+		return short
+	}
+	return inner
+}
+
+// balancedQuotes returns true if the openQ and closeQ quotation marks
+// in s nest properly. Where they are the same character, a mark at the
+// start of s or after a space or another mark opens a quotation, and
+// otherwise closes one.
+func balancedQuotes(s string, openQ, closeQ rune) bool {
+	depth := 0
+	prev := ' '
+	for _, r := range s {
+		switch {
+		case r == openQ && (openQ != closeQ || unicode.IsSpace(prev) || prev == openQ):
+			depth++
+		case r == closeQ:
+			depth--
+			if depth < 0 {
+				return false
+			}
+		}
+		prev = r
+	}
+	return depth == 0
+}
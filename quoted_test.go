@@ -0,0 +1,47 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuoteStripping(t *testing.T) {
+	p, err := New(WithQuoteStripping())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input string
+		short string
+	}{
+		{`ООО "Ромашка"`, "Ромашка"},
+		{`АО «Вектор»`, "Вектор"},
+		{`ООО «Ромашка-М»`, "Ромашка-М"},
+		{`Общество с ограниченной ответственностью «Ромашка»`, "Ромашка"},
+		{`ТОВ „Вектор“`, "Вектор"},
+		{`ООО “Ромашка”`, "Ромашка"},
+		{`"Ромашка" ООО`, "Ромашка"},
+		{`«Вектор» АО`, "Вектор"},
+		{`ООО "Торговый дом "Вектор""`, `Торговый дом "Вектор"`},
+		{`ООО "Вектор" и "Ко"`, `"Вектор" и "Ко"`},
+		{`Acme "Widgets" Ltd`, `Acme "Widgets`},
+		{`'Acme's Widgets' Ltd`, `'Acme's Widgets`},
+		{`"Acme Widgets"`, `"Acme Widgets"`},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.short, res.ShortName, "ShortName matches for %q", tc.input)
+	}
+
+	// Quotes are kept by default
+	res, err := MustNew().Parse(`АО «Вектор»`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "«Вектор»", res.ShortName, "ShortName keeps quotes by default")
+}
@@ -0,0 +1,60 @@
+package gocd
+
+import "strconv"
+
+// Fields lists the Result field names supported by Result.Field,
+// matching their JSON names
+var Fields = []string{
+	"input", "matched", "short_name", "designator", "designator_std",
+	"designator_long", "position", "match_kind", "qualifier", "lang",
+	"category", "source", "public", "short_name_ascii", "name_lang",
+	"lang_mismatch", "ambiguity",
+}
+
+// Field returns the string value of the Result field with the given
+// (JSON) name, and false if name is not a known field
+func (r *Result) Field(name string) (string, bool) {
+	switch name {
+	case "input":
+		return r.Input, true
+	case "matched":
+		return strconv.FormatBool(r.Matched), true
+	case "short_name":
+		return r.ShortName, true
+	case "designator":
+		return r.Designator, true
+	case "designator_std":
+		return r.DesignatorStd, true
+	case "designator_long":
+		return r.DesignatorLong, true
+	case "position":
+		return r.Position.String(), true
+	case "match_kind":
+		return r.MatchKind.String(), true
+	case "qualifier":
+		return r.Qualifier, true
+	case "lang":
+		return r.Lang, true
+	case "category":
+		return r.Category, true
+	case "source":
+		return r.Source, true
+	case "public":
+		return strconv.FormatBool(r.Public), true
+	case "short_name_ascii":
+		return r.ShortNameASCII, true
+	case "name_lang":
+		return r.NameLang, true
+	case "lang_mismatch":
+		return strconv.FormatBool(r.LangMismatch), true
+	case "ambiguity":
+		return strconv.FormatFloat(r.Ambiguity, 'g', -1, 64), true
+	}
+	return "", false
+}
+
+// clearMatch resets r to an unmatched Result for the same Input, with
+// ShortName set to the matched text (Input, unless preprocessed)
+func (r *Result) clearMatch(text string) {
+	*r = Result{Input: r.Input, ShortName: text}
+}
@@ -0,0 +1,46 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResultField(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := p.Parse("Acme (UK) Ltd")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"input":            "Acme (UK) Ltd",
+		"matched":          "true",
+		"short_name":       "Acme",
+		"designator":       "Ltd",
+		"designator_std":   "Ltd.",
+		"designator_long":  "Limited",
+		"position":         "end",
+		"match_kind":       "abbr",
+		"qualifier":        "UK",
+		"lang":             "en",
+		"category":         "",
+		"source":           "core",
+		"public":           "false",
+		"short_name_ascii": "",
+		"name_lang":        "",
+		"lang_mismatch":    "false",
+		"ambiguity":        "0",
+	}
+	for _, name := range Fields {
+		val, ok := res.Field(name)
+		assert.True(t, ok, "Field %q ok", name)
+		assert.Equal(t, expected[name], val, "Field %q matches", name)
+	}
+
+	_, ok := res.Field("bogus")
+	assert.False(t, ok, "unknown Field not ok")
+}
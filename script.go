@@ -0,0 +1,144 @@
+package gocd
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// dominantShare is the minimum share of an input's letters that must
+// belong to a script group for the group to be dominant
+const dominantShare = 0.75
+
+// scriptMask is a set of script groups, as a bitmask over scriptGroups
+type scriptMask uint
+
+// runeGroup returns the index of the script group containing r, or -1
+func runeGroup(r rune) int {
+	for i, g := range scriptGroups {
+		if unicode.IsOneOf(g.tables, r) {
+			return i
+		}
+	}
+	return -1
+}
+
+// contScripts returns the script groups of the letters in the
+// designators of the continuous (EndCont) pass for matchDs, or 0 if
+// any designator may match without a letter from those groups, in
+// which case the pass can't be skipped by script
+func contScripts(matchDs *dataset) scriptMask {
+	var mask scriptMask
+	for long, e := range *matchDs {
+		if !LangContinua[e.Lang] {
+			continue
+		}
+		if len(e.AbbrRE) > 0 {
+			return 0
+		}
+		// Only non-ASCII abbreviations are continuous (cf. compileREPatterns)
+		for _, des := range append([]string{long}, e.Abbr...) {
+			if des != long && isASCII([]byte(des)) {
+				continue
+			}
+			desMask := runesScripts(des)
+			if desMask == 0 {
+				return 0
+			}
+			mask |= desMask
+		}
+	}
+	return mask
+}
+
+// runesScripts returns the script groups of the letters in s, or 0 if
+// s has a letter outside every group
+func runesScripts(s string) scriptMask {
+	var mask scriptMask
+	for _, r := range s {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		g := runeGroup(r)
+		if g < 0 {
+			return 0
+		}
+		mask |= 1 << g
+	}
+	return mask
+}
+
+// contCandidate returns true if the NFD input in (with ASCII set if in
+// is entirely ASCII) might match the continuous pass, according to the
+// scripts of its letters
+func (p *Parser) contCandidate(in []byte, ascii bool) bool {
+	if p.contMask == 0 {
+		return true
+	}
+	if ascii {
+		return p.contMask&(1<<latinGroup) != 0
+	}
+	for len(in) > 0 {
+		r, size := utf8.DecodeRune(in)
+		in = in[size:]
+		if r < utf8.RuneSelf && p.contMask&(1<<latinGroup) == 0 {
+			continue
+		}
+		if g := runeGroup(r); g >= 0 && p.contMask&(1<<g) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// latinGroup is the index of the Latin script group
+const latinGroup = 0
+
+// WithScriptDetection matches input against designators in its
+// dominant script only, for precision as well as speed. The dominant
+// script group (see WithScriptShards, which this implies) is the one
+// containing at least three quarters of the input's letters, so e.g.
+// mostly Han input with a stray Latin word isn't matched against Latin
+// designators, and mostly Latin input skips the CJK continuous pass.
+// Input without a dominant script (e.g. "ООО Acme") is matched against
+// all designators, as usual.
+func WithScriptDetection() Option {
+	return func(o *options) {
+		o.scriptShards = true
+		o.scriptDetect = true
+	}
+}
+
+// DetectScript returns the name of the dominant script group of s (one
+// of "Latin", "Cyrillic", "Greek", "CJK", "Arabic", "Hebrew", or "Thai"),
+// or "" if s has no dominant script (see WithScriptDetection)
+func DetectScript(s string) string {
+	if g := dominantScriptGroup([]byte(s)); g >= 0 {
+		return scriptGroups[g].name
+	}
+	return ""
+}
+
+// dominantScriptGroup returns the index of the script group containing
+// at least dominantShare of the letters in b, or -1 if there is no such
+// group (including when b contains no letters)
+func dominantScriptGroup(b []byte) int {
+	var counts [8]int
+	letters := 0
+	for len(b) > 0 {
+		r, size := utf8.DecodeRune(b)
+		b = b[size:]
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		if g := runeGroup(r); g >= 0 {
+			counts[g]++
+		}
+	}
+	for g, n := range counts[:len(scriptGroups)] {
+		if n > 0 && float64(n) >= dominantShare*float64(letters) {
+			return g
+		}
+	}
+	return -1
+}
@@ -0,0 +1,87 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectScript(t *testing.T) {
+	tests := []struct {
+		input  string
+		script string
+	}{
+		{"Acme Widgets GmbH", "Latin"},
+		{"ООО Ромашка", "Cyrillic"},
+		{"ООО Ромашка Ltd", "Cyrillic"},
+		{"トヨタ自動車 Co", "CJK"},
+		{"ООО Acme", ""},
+		{"12345", ""},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, tc.script, DetectScript(tc.input), "script matches for %q", tc.input)
+	}
+}
+
+func TestContinuousPassSkip(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		input string
+		cont  bool
+	}{
+		{"Acme Widgets", false},
+		{"ООО Ромашка", false},
+		{"Société Générale", false},
+		{"トヨタ自動車", true},
+		{"Acme 東京", true},
+	}
+	for _, tc := range tests {
+		ex, err := p.Explain(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.cont, containsPass(ex.Tried, EndCont), "EndCont tried for %q", tc.input)
+	}
+}
+
+func TestScriptDetection(t *testing.T) {
+	p, err := New(WithScriptDetection())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		input     string
+		shortName string
+		des       string
+	}{
+		{"Acme Widgets Ltd", "Acme Widgets", "Ltd"},
+		{"三菱商事株式会社", "三菱商事", "株式会社"},
+		// Latin designators aren't matched in mostly Han input
+		{"トヨタ自動車 Co", "トヨタ自動車 Co", ""},
+		// Cyrillic input uses Cyrillic designators only
+		{"ООО Ромашка Ltd", "Ромашка Ltd", "ООО"},
+		// No dominant script
+		{"ООО Acme", "Acme", "ООО"},
+		{"Acme 株式会社", "Acme", "株式会社"},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.shortName, res.ShortName, "ShortName matches for %q", tc.input)
+		assert.Equal(t, tc.des, res.Designator, "Designator matches for %q", tc.input)
+	}
+}
+
+func containsPass(passes []PositionType, t PositionType) bool {
+	for _, pass := range passes {
+		if pass == t {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,19 @@
+//go:build go1.23
+
+package gocd
+
+import "iter"
+
+// ParseSeq returns an iterator over the Results of parsing each of
+// names, for lazy consumption in for-range loops and iterator
+// pipelines. Parse errors are yielded alongside a nil Result, and
+// iteration continues unless the consumer stops it.
+func (p *Parser) ParseSeq(names iter.Seq[string]) iter.Seq2[*Result, error] {
+	return func(yield func(*Result, error) bool) {
+		for name := range names {
+			if !yield(p.Parse(name)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,45 @@
+//go:build go1.23
+
+package gocd
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSeq(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := []string{"Acme Ltd", "Bar Baz", "OOO Romashka", "Acme LLC"}
+	var shortNames []string
+	for res, err := range p.ParseSeq(slices.Values(names)) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		shortNames = append(shortNames, res.ShortName)
+	}
+	assert.Equal(t, []string{"Acme", "Bar Baz", "Romashka", "Acme"}, shortNames,
+		"ParseSeq ShortNames match")
+
+	// Stopping early stops consuming names
+	consumed := 0
+	counted := func(yield func(string) bool) {
+		for _, name := range names {
+			consumed++
+			if !yield(name) {
+				return
+			}
+		}
+	}
+	for res := range p.ParseSeq(counted) {
+		if res.Matched {
+			break
+		}
+	}
+	assert.Equal(t, 1, consumed, "ParseSeq stops early")
+}
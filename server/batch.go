@@ -0,0 +1,126 @@
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/ProfoundNetworks/gocd"
+)
+
+const (
+	// DefaultMaxBatchSize is the default maximum number of names in a
+	// batch request
+	DefaultMaxBatchSize = 10000
+	// DefaultMaxBodyBytes is the default maximum batch request body size
+	DefaultMaxBodyBytes = 16 << 20
+)
+
+// errBatchTooLarge is returned for batches exceeding the maximum size
+var errBatchTooLarge = errors.New("batch too large")
+
+// isNDJSON returns true if contentType is an NDJSON media type
+func isNDJSON(contentType string) bool {
+	mt, _, _ := mime.ParseMediaType(contentType)
+	return mt == "application/x-ndjson" || mt == "application/ndjson"
+}
+
+// readBatch reads up to max names from r, as a JSON array of strings or,
+// if ndjson is true, one JSON string per line
+func readBatch(r io.Reader, ndjson bool, max int) ([]string, error) {
+	var names []string
+	if !ndjson {
+		if err := json.NewDecoder(r).Decode(&names); err != nil {
+			return nil, fmt.Errorf("invalid JSON array of names: %v", err)
+		}
+		if len(names) > max {
+			return nil, errBatchTooLarge
+		}
+		return names, nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), gocd.MaxLineLength)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		if len(names) == max {
+			return nil, errBatchTooLarge
+		}
+		var name string
+		if err := json.Unmarshal(scanner.Bytes(), &name); err != nil {
+			return nil, fmt.Errorf("invalid JSON string on line %d: %v", line, err)
+		}
+		names = append(names, name)
+	}
+	return names, scanner.Err()
+}
+
+// handleBatch parses a batch of names POSTed as a JSON array or, if
+// ndjson is true, NDJSON, returning results in order in the same format
+func (s *Server) handleBatch(w http.ResponseWriter, r *http.Request, ndjson bool) {
+	names, err := readBatch(http.MaxBytesReader(w, r.Body, s.maxBodyBytes()), ndjson, s.maxBatchSize())
+	if err != nil {
+		// http.MaxBytesReader errors have no exported type before Go 1.19
+		if errors.Is(err, errBatchTooLarge) ||
+			strings.Contains(err.Error(), "request body too large") {
+			writeError(w, http.StatusRequestEntityTooLarge,
+				fmt.Sprintf("batch exceeds %d names or %d bytes", s.maxBatchSize(), s.maxBodyBytes()))
+			return
+		}
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	p := s.currentParser(w)
+	if p == nil {
+		return
+	}
+
+	lang := r.URL.Query().Get("lang")
+	results := make([]*gocd.Result, len(names))
+	for i, name := range names {
+		if r.Context().Err() != nil {
+			writeTimeout(w)
+			return
+		}
+		results[i], err = p.ParseLang(name, lang)
+		if err != nil {
+			writeError(w, http.StatusUnprocessableEntity, fmt.Sprintf("name %d: %v", i, err))
+			return
+		}
+	}
+
+	if !ndjson {
+		writeJSON(w, http.StatusOK, results)
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	for _, res := range results {
+		enc.Encode(res)
+	}
+	bw.Flush()
+}
+
+// maxBatchSize returns the maximum number of names in a batch request
+func (s *Server) maxBatchSize() int {
+	if s.opts.MaxBatchSize > 0 {
+		return s.opts.MaxBatchSize
+	}
+	return DefaultMaxBatchSize
+}
+
+// maxBodyBytes returns the maximum batch request body size
+func (s *Server) maxBodyBytes() int64 {
+	if s.opts.MaxBodyBytes > 0 {
+		return s.opts.MaxBodyBytes
+	}
+	return DefaultMaxBodyBytes
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ProfoundNetworks/gocd"
+	"github.com/stretchr/testify/assert"
+)
+
+// post returns the response to a POST of body with contentType to s
+func post(s http.Handler, target, contentType, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestBatch(t *testing.T) {
+	p, err := gocd.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := New(func() *gocd.Parser { return p }, Options{MaxBatchSize: 3, MaxBodyBytes: 200})
+	shortNames := []string{"Profound Networks", "Acme Widgets", "Romashka"}
+
+	rec := post(s, "/v1/parse", "application/json",
+		`["Profound Networks LLC", "Acme Widgets", "OOO Romashka"]`)
+	if assert.Equal(t, http.StatusOK, rec.Code, "JSON batch status") {
+		var results []gocd.Result
+		if err := json.Unmarshal(rec.Body.Bytes(), &results); err != nil {
+			t.Fatal(err)
+		}
+		if assert.Len(t, results, 3, "JSON batch results") {
+			for i, res := range results {
+				assert.Equal(t, shortNames[i], res.ShortName, "JSON batch ShortName %d", i)
+			}
+		}
+	}
+
+	rec = post(s, "/v1/parse", "application/x-ndjson",
+		"\"Profound Networks LLC\"\n\"Acme Widgets\"\n\n\"OOO Romashka\"\n")
+	if assert.Equal(t, http.StatusOK, rec.Code, "NDJSON batch status") {
+		assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"), "NDJSON Content-Type")
+		scanner := bufio.NewScanner(rec.Body)
+		i := 0
+		for ; scanner.Scan(); i++ {
+			var res gocd.Result
+			if err := json.Unmarshal(scanner.Bytes(), &res); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, shortNames[i], res.ShortName, "NDJSON batch ShortName %d", i)
+		}
+		assert.Equal(t, 3, i, "NDJSON batch results")
+	}
+
+	tests := []struct {
+		contentType string
+		body        string
+		status      int
+	}{
+		{"application/json", `["a", "b", "c", "d"]`, http.StatusRequestEntityTooLarge},
+		{"application/x-ndjson", "\"a\"\n\"b\"\n\"c\"\n\"d\"\n", http.StatusRequestEntityTooLarge},
+		{"application/json", `["` + strings.Repeat("a", 300) + `"]`, http.StatusRequestEntityTooLarge},
+		{"application/json", `{"name": "Acme Ltd"}`, http.StatusBadRequest},
+		{"application/x-ndjson", "Acme Ltd\n", http.StatusBadRequest},
+		{"application/json", `[]`, http.StatusOK},
+	}
+	for _, tc := range tests {
+		rec := post(s, "/v1/parse", tc.contentType, tc.body)
+		assert.Equal(t, tc.status, rec.Code, "status for %s %q", tc.contentType, tc.body)
+	}
+}
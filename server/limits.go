@@ -0,0 +1,162 @@
+package server
+
+import (
+	"container/list"
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Error codes reported in errorResponse.Code when limits are exceeded
+const (
+	CodeOverloaded  = "overloaded"   // Options.MaxInFlight requests are already being served
+	CodeRateLimited = "rate_limited" // The client exceeded Options.RateLimit
+	CodeTimeout     = "timeout"      // The request exceeded Options.RequestTimeout
+)
+
+// maxBuckets is the maximum number of rate limit buckets, beyond which
+// the least recently used bucket is evicted
+const maxBuckets = 10000
+
+// bucket is a token bucket for a single client
+type bucket struct {
+	key    string
+	tokens float64
+	last   time.Time
+}
+
+// limiter is a per-client token bucket rate limiter. Buckets are kept in
+// least recently used order, so refilled buckets (which are equivalent
+// to new buckets) are pruned from the back in amortised constant time,
+// and the number of buckets is capped at max by evicting the least
+// recently used, which resets that client's limit.
+type limiter struct {
+	rate    float64 // Tokens added per second
+	burst   float64 // Bucket capacity
+	max     int     // Maximum number of buckets
+	now     func() time.Time
+	mu      sync.Mutex
+	buckets map[string]*list.Element
+	lru     *list.List // *bucket, most recently used first
+}
+
+func newLimiter(rate float64, burst int) *limiter {
+	if burst < 1 {
+		burst = int(math.Max(1, math.Ceil(rate)))
+	}
+	return &limiter{rate: rate, burst: float64(burst), max: maxBuckets, now: time.Now,
+		buckets: make(map[string]*list.Element), lru: list.New()}
+}
+
+// allow takes a token from key's bucket, returning true if one was
+// available, and otherwise the time until one will be
+func (l *limiter) allow(key string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	l.prune(now)
+	var b *bucket
+	if el := l.buckets[key]; el != nil {
+		l.lru.MoveToFront(el)
+		b = el.Value.(*bucket)
+	} else {
+		if l.lru.Len() >= l.max {
+			l.remove(l.lru.Back())
+		}
+		b = &bucket{key: key, tokens: l.burst, last: now}
+		l.buckets[key] = l.lru.PushFront(b)
+	}
+	b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+	b.last = now
+	if b.tokens < 1 {
+		return false, time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+	}
+	b.tokens--
+	return true, 0
+}
+
+// prune removes the least recently used buckets that have refilled,
+// stopping at the first that hasn't
+func (l *limiter) prune(now time.Time) {
+	for el := l.lru.Back(); el != nil; el = l.lru.Back() {
+		b := el.Value.(*bucket)
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate < l.burst {
+			return
+		}
+		l.remove(el)
+	}
+}
+
+// remove removes the bucket el
+func (l *limiter) remove(el *list.Element) {
+	delete(l.buckets, el.Value.(*bucket).key)
+	l.lru.Remove(el)
+}
+
+// remoteHost returns the host part of r's RemoteAddr
+func remoteHost(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// clientKey returns the rate limit key for r
+func (s *Server) clientKey(r *http.Request) string {
+	if s.opts.ClientKey != nil {
+		return s.opts.ClientKey(r)
+	}
+	return remoteHost(r)
+}
+
+// limit applies the Server's concurrency, rate, and timeout limits to
+// API requests, returning the request to serve, or nil if a limit error
+// response was written. release must be called when the request is done.
+func (s *Server) limit(w http.ResponseWriter, r *http.Request) (req *http.Request, release func()) {
+	release = func() {}
+	if !strings.HasPrefix(r.URL.Path, "/v1/") {
+		return r, release
+	}
+
+	if s.limiter != nil {
+		if ok, wait := s.limiter.allow(s.clientKey(r)); !ok {
+			secs := int(math.Ceil(wait.Seconds()))
+			w.Header().Set("Retry-After", strconv.Itoa(secs))
+			writeErrorCode(w, http.StatusTooManyRequests, CodeRateLimited, "rate limit exceeded")
+			return nil, release
+		}
+	}
+
+	if s.inFlight != nil {
+		select {
+		case s.inFlight <- struct{}{}:
+			release = func() { <-s.inFlight }
+		default:
+			w.Header().Set("Retry-After", "1")
+			writeErrorCode(w, http.StatusServiceUnavailable, CodeOverloaded, "too many requests in flight")
+			return nil, release
+		}
+	}
+
+	if s.opts.RequestTimeout > 0 {
+		ctx, cancel := context.WithTimeout(r.Context(), s.opts.RequestTimeout)
+		r = r.WithContext(ctx)
+		prev := release
+		release = func() {
+			cancel()
+			prev()
+		}
+	}
+	return r, release
+}
+
+// writeTimeout writes a timeout error response to w
+func writeTimeout(w http.ResponseWriter) {
+	writeErrorCode(w, http.StatusServiceUnavailable, CodeTimeout, "request timed out")
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ProfoundNetworks/gocd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiter(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := newLimiter(2, 3)
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		ok, _ := l.allow("a")
+		assert.True(t, ok, "burst request %d allowed", i)
+	}
+	ok, wait := l.allow("a")
+	assert.False(t, ok, "request beyond burst limited")
+	assert.Equal(t, 500*time.Millisecond, wait, "wait for next token")
+	ok, _ = l.allow("b")
+	assert.True(t, ok, "other client allowed")
+
+	now = now.Add(500 * time.Millisecond)
+	ok, _ = l.allow("a")
+	assert.True(t, ok, "request allowed after refill")
+
+	// Refilled buckets are pruned
+	now = now.Add(time.Hour)
+	l.prune(now)
+	assert.Empty(t, l.buckets, "idle buckets pruned")
+	assert.Zero(t, l.lru.Len(), "idle buckets pruned from LRU list")
+
+	// Pruning stops at the least recently used bucket still refilling
+	l.allow("a")
+	now = now.Add(time.Second)
+	l.allow("b")
+	now = now.Add(100 * time.Millisecond)
+	ok, _ = l.allow("c")
+	assert.True(t, ok, "new client allowed")
+	assert.Len(t, l.buckets, 2, "refilled bucket pruned on allow")
+	assert.NotContains(t, l.buckets, "a", "least recently used refilled bucket pruned")
+
+	// The number of buckets is capped, evicting the least recently used
+	l.max = 2
+	l.allow("b")
+	l.allow("d")
+	assert.Len(t, l.buckets, 2, "bucket count capped")
+	assert.Contains(t, l.buckets, "b", "recently used bucket kept")
+	assert.NotContains(t, l.buckets, "c", "least recently used bucket evicted")
+}
+
+// errorCode returns the error code in the body of rec
+func errorCode(rec *httptest.ResponseRecorder) string {
+	var e errorResponse
+	json.Unmarshal(rec.Body.Bytes(), &e)
+	return e.Code
+}
+
+func TestLimits(t *testing.T) {
+	p, err := gocd.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := New(func() *gocd.Parser { return p }, Options{RateLimit: 1, RateBurst: 2})
+	assert.Equal(t, http.StatusOK, get(s, "/v1/parse?name=Acme+Ltd").Code, "first request")
+	assert.Equal(t, http.StatusOK, get(s, "/v1/parse?name=Acme+Ltd").Code, "burst request")
+	rec := get(s, "/v1/parse?name=Acme+Ltd")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code, "rate limited request")
+	assert.Equal(t, CodeRateLimited, errorCode(rec), "rate limited code")
+	assert.Equal(t, "1", rec.Header().Get("Retry-After"), "rate limited Retry-After")
+	assert.Equal(t, http.StatusOK, get(s, "/healthz").Code, "healthz not rate limited")
+
+	// Requests beyond MaxInFlight are rejected
+	s = New(func() *gocd.Parser { return p }, Options{MaxInFlight: 1})
+	s.inFlight <- struct{}{}
+	rec = get(s, "/v1/parse?name=Acme+Ltd")
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code, "overloaded request")
+	assert.Equal(t, CodeOverloaded, errorCode(rec), "overloaded code")
+	<-s.inFlight
+	assert.Equal(t, http.StatusOK, get(s, "/v1/parse?name=Acme+Ltd").Code, "request after release")
+	assert.Empty(t, s.inFlight, "in-flight slot released")
+
+	// Batches exceeding RequestTimeout are abandoned
+	s = New(func() *gocd.Parser { return p }, Options{RequestTimeout: time.Nanosecond})
+	rec = post(s, "/v1/parse", "application/json", `["Acme Ltd", "Acme LLC"]`)
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code, "timed out batch")
+	assert.Equal(t, CodeTimeout, errorCode(rec), "timed out code")
+}
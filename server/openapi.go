@@ -0,0 +1,226 @@
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/ProfoundNetworks/gocd"
+)
+
+// object is a JSON object in the OpenAPI document
+type object = map[string]interface{}
+
+// ref returns a reference to the component schema name
+func ref(name string) object {
+	return object{"$ref": "#/components/schemas/" + name}
+}
+
+// jsonContent returns a content object for schema with media type
+// application/json, and for the ndjson schema with media type
+// application/x-ndjson if non-nil
+func jsonContent(schema, ndjson object) object {
+	content := object{"application/json": object{"schema": schema}}
+	if ndjson != nil {
+		content["application/x-ndjson"] = object{"schema": ndjson}
+	}
+	return content
+}
+
+// response returns an OpenAPI response object
+func response(desc string, content object) object {
+	r := object{"description": desc}
+	if content != nil {
+		r["content"] = content
+	}
+	return r
+}
+
+// errorResponseSpec returns an OpenAPI error response object
+func errorResponseSpec(desc string) object {
+	return response(desc, jsonContent(ref("Error"), nil))
+}
+
+// resultSchema returns the schema for gocd.Result, generated from its
+// JSON field tags, with omitempty fields optional
+func resultSchema() object {
+	props := object{}
+	var required []string
+	rt := reflect.TypeOf(gocd.Result{})
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		tag := strings.Split(f.Tag.Get("json"), ",")
+		name := tag[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		switch f.Type {
+		case reflect.TypeOf(gocd.None):
+			props[name] = ref("Position")
+		case reflect.TypeOf(gocd.NoMatch):
+			props[name] = ref("MatchKind")
+		default:
+			switch f.Type.Kind() {
+			case reflect.Bool:
+				props[name] = object{"type": "boolean"}
+			case reflect.Float64:
+				props[name] = object{"type": "number"}
+			default:
+				props[name] = object{"type": "string"}
+			}
+		}
+		if len(tag) == 1 || tag[1] != "omitempty" {
+			required = append(required, name)
+		}
+	}
+	return object{"type": "object", "properties": props, "required": required}
+}
+
+// OpenAPI returns the OpenAPI 3 document describing the HTTP API
+func OpenAPI() interface{} {
+	var positions []string
+	for t := gocd.None; t <= gocd.EndGlued; t++ {
+		positions = append(positions, t.String())
+	}
+	var kinds []string
+	for k := gocd.NoMatch; k <= gocd.Glued; k++ {
+		kinds = append(kinds, k.String())
+	}
+
+	nameParam := object{"name": "name", "in": "query", "required": true,
+		"description": "The company name to parse", "schema": object{"type": "string"}}
+	langParam := object{"name": "lang", "in": "query",
+		"description": "ISO 639-1 language hint, restricting matching to designators for that language",
+		"schema":      object{"type": "string"}}
+	ndjsonString := object{"type": "string", "description": "One JSON string per line"}
+	ndjsonResult := object{"type": "string", "description": "One JSON Result object per line"}
+
+	return object{
+		"openapi": "3.0.3",
+		"info": object{
+			"title":       "gocd",
+			"description": "Company designator parsing",
+			"version":     "1",
+		},
+		"paths": object{
+			"/v1/parse": object{
+				"get": object{
+					"operationId": "parse",
+					"summary":     "Parse a company name",
+					"parameters":  []interface{}{nameParam, langParam},
+					"responses": object{
+						"200": response("The parse result", jsonContent(ref("Result"), nil)),
+						"400": errorResponseSpec("Missing name"),
+						"422": errorResponseSpec("Unparseable name"),
+						"429": errorResponseSpec("Rate limit exceeded"),
+						"503": errorResponseSpec("Parser not ready, server overloaded, or request timed out"),
+					},
+				},
+				"post": object{
+					"operationId": "parseBatch",
+					"summary":     "Parse a batch of company names, returning results in order",
+					"parameters":  []interface{}{langParam},
+					"requestBody": object{
+						"required": true,
+						"content": jsonContent(object{"type": "array", "items": object{"type": "string"}},
+							ndjsonString),
+					},
+					"responses": object{
+						"200": response("The parse results, in the request format",
+							jsonContent(object{"type": "array", "items": ref("Result")}, ndjsonResult)),
+						"400": errorResponseSpec("Invalid request body"),
+						"413": errorResponseSpec("Batch too large"),
+						"422": errorResponseSpec("Unparseable name"),
+						"429": errorResponseSpec("Rate limit exceeded"),
+						"503": errorResponseSpec("Parser not ready, server overloaded, or request timed out"),
+					},
+				},
+			},
+			"/v1/stream": object{
+				"post": object{
+					"operationId": "parseStream",
+					"summary": "Parse a stream of company names, returning results in order as they are parsed " +
+						"(concurrently with the request over HTTP/2)",
+					"parameters": []interface{}{langParam},
+					"requestBody": object{
+						"required": true,
+						"content":  object{"application/x-ndjson": object{"schema": ndjsonString}},
+					},
+					"responses": object{
+						"200": response("The parse results, ending with an Error object on failure",
+							object{"application/x-ndjson": object{"schema": ndjsonResult}}),
+						"429": errorResponseSpec("Rate limit exceeded"),
+						"503": errorResponseSpec("Parser not ready, server overloaded, or request timed out"),
+					},
+				},
+			},
+			"/healthz": object{
+				"get": object{
+					"operationId": "health",
+					"summary":     "Liveness check",
+					"responses": object{
+						"200": response("Alive", jsonContent(object{"type": "object",
+							"properties": object{"status": object{"type": "string"}}}, nil)),
+					},
+				},
+			},
+			"/readyz": object{
+				"get": object{
+					"operationId": "ready",
+					"summary":     "Readiness check",
+					"responses": object{
+						"200": response("Ready", jsonContent(ref("Readiness"), nil)),
+						"503": response("Not ready", jsonContent(ref("Readiness"), nil)),
+					},
+				},
+			},
+			"/metrics": object{
+				"get": object{
+					"operationId": "metrics",
+					"summary":     "Prometheus metrics, if enabled",
+					"responses": object{
+						"200": response("Metrics in the Prometheus text format",
+							object{"text/plain": object{"schema": object{"type": "string"}}}),
+					},
+				},
+			},
+		},
+		"components": object{
+			"schemas": object{
+				"Result":    resultSchema(),
+				"Position":  object{"type": "string", "enum": positions},
+				"MatchKind": object{"type": "string", "enum": kinds},
+				"Readiness": object{"type": "object", "required": []string{"ready"},
+					"properties": object{
+						"ready":            object{"type": "boolean"},
+						"dataset_checksum": object{"type": "string", "description": "SHA-256 of the loaded dataset entries"},
+						"designators":      object{"type": "integer", "description": "Number of loaded dataset entries"},
+					}},
+				"Error": object{"type": "object", "required": []string{"error"},
+					"properties": object{
+						"error": object{"type": "string"},
+						"code": object{"type": "string", "description": "Set for limit errors",
+							"enum": []string{CodeOverloaded, CodeRateLimited, CodeTimeout}},
+					}},
+			},
+		},
+	}
+}
+
+// WriteOpenAPI writes the OpenAPI 3 document describing the HTTP API to
+// w as indented JSON
+func WriteOpenAPI(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(OpenAPI())
+}
+
+func (s *Server) handleOpenAPI(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet) {
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	WriteOpenAPI(w)
+}
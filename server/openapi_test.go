@@ -0,0 +1,69 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/ProfoundNetworks/gocd"
+	"github.com/stretchr/testify/assert"
+)
+
+var updateGolden = flag.Bool("update-golden", false,
+	"update the golden OpenAPI document")
+
+const openAPIGolden = "openapi.json"
+
+// TestOpenAPIGolden checks the checked-in OpenAPI document is up to
+// date. Run with -update-golden after intended changes.
+func TestOpenAPIGolden(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteOpenAPI(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if *updateGolden {
+		if err := ioutil.WriteFile(openAPIGolden, buf.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := ioutil.ReadFile(openAPIGolden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, string(want), buf.String(), "OpenAPI document matches golden file")
+}
+
+func TestOpenAPI(t *testing.T) {
+	s := New(func() *gocd.Parser { return nil }, Options{})
+	rec := get(s, "/openapi.json")
+	assert.Equal(t, http.StatusOK, rec.Code, "openapi.json status")
+
+	var doc struct {
+		OpenAPI    string                 `json:"openapi"`
+		Paths      map[string]interface{} `json:"paths"`
+		Components struct {
+			Schemas struct {
+				Result struct {
+					Properties map[string]interface{} `json:"properties"`
+				} `json:"Result"`
+				Position struct {
+					Enum []string `json:"enum"`
+				} `json:"Position"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "3.0.3", doc.OpenAPI, "OpenAPI version")
+	assert.Contains(t, doc.Paths, "/v1/parse", "parse path")
+	for _, field := range gocd.Fields {
+		assert.Contains(t, doc.Components.Schemas.Result.Properties, field,
+			"Result schema has %q", field)
+	}
+	assert.Equal(t, []string{"none", "end", "end_fallback", "end_cont", "begin",
+		"begin_fallback", "whole", "end_glued"}, doc.Components.Schemas.Position.Enum, "Position enum")
+}
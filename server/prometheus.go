@@ -0,0 +1,55 @@
+package server
+
+import (
+	"expvar"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/ProfoundNetworks/gocd"
+)
+
+// writePrometheus writes m to w in the Prometheus text exposition format
+func writePrometheus(w io.Writer, m *gocd.Metrics) {
+	fmt.Fprintln(w, "# HELP gocd_parses_total Total parses.")
+	fmt.Fprintln(w, "# TYPE gocd_parses_total counter")
+	fmt.Fprintf(w, "gocd_parses_total %d\n", m.Parses.Value())
+	fmt.Fprintln(w, "# HELP gocd_matches_total Total parses with a designator match.")
+	fmt.Fprintln(w, "# TYPE gocd_matches_total counter")
+	fmt.Fprintf(w, "gocd_matches_total %d\n", m.Matches.Value())
+
+	writeLabelled(w, "gocd_matches_by_position_total", "position",
+		"Matches by designator position.", &m.ByPosition)
+	writeLabelled(w, "gocd_matches_by_lang_total", "lang",
+		"Matches by designator language.", &m.ByLang)
+	writeLabelled(w, "gocd_matches_by_pass_total", "pass",
+		"Matches by matching pass.", &m.ByPass)
+
+	// Metrics.Latency buckets are non-cumulative, while Prometheus'
+	// are cumulative
+	fmt.Fprintln(w, "# HELP gocd_parse_latency_seconds Parse latency.")
+	fmt.Fprintln(w, "# TYPE gocd_parse_latency_seconds histogram")
+	var count int64
+	bucket := func(le string) {
+		if v, ok := m.Latency.Get(le).(*expvar.Int); ok {
+			count += v.Value()
+		}
+		fmt.Fprintf(w, "gocd_parse_latency_seconds_bucket{le=%q} %d\n", le, count)
+	}
+	for _, b := range gocd.LatencyBuckets {
+		bucket(strconv.FormatFloat(b, 'g', -1, 64))
+	}
+	bucket("+Inf")
+	fmt.Fprintf(w, "gocd_parse_latency_seconds_sum %g\n", m.LatencySum.Value())
+	fmt.Fprintf(w, "gocd_parse_latency_seconds_count %d\n", count)
+}
+
+// writeLabelled writes the counters in vars as the metric name, with
+// each key as the value of label
+func writeLabelled(w io.Writer, name, label, help string, vars *expvar.Map) {
+	fmt.Fprintf(w, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(w, "# TYPE %s counter\n", name)
+	vars.Do(func(kv expvar.KeyValue) {
+		fmt.Fprintf(w, "%s{%s=%q} %s\n", name, label, kv.Key, kv.Value.String())
+	})
+}
@@ -0,0 +1,222 @@
+/*
+server provides an HTTP API for gocd, with health, readiness, and
+Prometheus metrics endpoints, for running designator parsing as a
+shared service (see cmd/gocd-server).
+
+Endpoints:
+
+	GET /v1/parse?name=...[&lang=xx]  parse a single name, returning a Result
+	POST /v1/parse[?lang=xx]          parse a batch of names (see below)
+	POST /v1/stream[?lang=xx]         parse a stream of names (see below)
+	GET /healthz                      liveness check
+	GET /readyz                       readiness, with the dataset checksum
+	GET /metrics                      Prometheus metrics (if Options.Metrics is set)
+	GET /openapi.json                 the OpenAPI 3 document describing the API
+
+Batches are POSTed as a JSON array of name strings, or with an NDJSON
+Content-Type (application/x-ndjson) as one JSON string per line, and
+results are returned in order in the same format. Batches exceeding
+Options.MaxBatchSize names or Options.MaxBodyBytes are rejected with a
+413 status.
+
+Streams are POSTed as NDJSON like batches, but over HTTP/2 each result
+is written (in order) as soon as its name is read, so clients can send
+and receive concurrently, with flow control providing backpressure, and
+there is no size limit. Over HTTP/1.x, where the request body may not
+be read after the response starts, streams are handled as batches.
+
+API requests (under /v1/) can be limited by concurrency, per-client
+rate, and processing time (see Options), with limit errors reported
+with a 429 or 503 status and an error code (e.g. CodeRateLimited), and a
+Retry-After header where applicable.
+
+A copy of the OpenAPI document is kept in openapi.json, for generating
+typed clients.
+*/
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ProfoundNetworks/gocd"
+)
+
+// Options configures a Server
+type Options struct {
+	Metrics      *gocd.Metrics // Metrics to expose at /metrics, as recorded by the Parser (see gocd.WithMetrics)
+	MaxBatchSize int           // Maximum names per batch request, defaults to DefaultMaxBatchSize
+	MaxBodyBytes int64         // Maximum batch request body size, defaults to DefaultMaxBodyBytes
+
+	MaxInFlight    int                          // Maximum concurrent API requests, beyond which requests get a 503, if non-zero
+	RateLimit      float64                      // Maximum API requests per second per client, beyond which requests get a 429, if non-zero
+	RateBurst      int                          // Maximum burst of API requests per client, defaults to RateLimit (rounded up)
+	ClientKey      func(r *http.Request) string // Returns the client identifier for rate limiting, defaults to the remote IP address
+	RequestTimeout time.Duration                // Maximum batch or stream processing time, beyond which requests get a 503, if non-zero
+}
+
+// Server is an http.Handler serving the gocd HTTP API
+type Server struct {
+	parser func() *gocd.Parser
+	opts   Options
+	mux    *http.ServeMux
+
+	limiter  *limiter
+	inFlight chan struct{}
+
+	mu       sync.Mutex
+	sumFor   *gocd.Parser
+	checksum string
+	entries  int
+}
+
+// New returns a Server parsing with the Parser returned by parser, which
+// is called for each request so the Parser may be swapped (e.g. by
+// gocd.Updater.Parser). The Server is not ready while parser returns nil.
+func New(parser func() *gocd.Parser, opts Options) *Server {
+	s := &Server{parser: parser, opts: opts, mux: http.NewServeMux()}
+	if opts.RateLimit > 0 {
+		s.limiter = newLimiter(opts.RateLimit, opts.RateBurst)
+	}
+	if opts.MaxInFlight > 0 {
+		s.inFlight = make(chan struct{}, opts.MaxInFlight)
+	}
+	s.mux.HandleFunc("/v1/parse", s.handleParse)
+	s.mux.HandleFunc("/v1/stream", s.handleStream)
+	s.mux.HandleFunc("/healthz", s.handleHealth)
+	s.mux.HandleFunc("/readyz", s.handleReady)
+	s.mux.HandleFunc("/openapi.json", s.handleOpenAPI)
+	if opts.Metrics != nil {
+		s.mux.HandleFunc("/metrics", s.handleMetrics)
+	}
+	return s
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r, release := s.limit(w, r)
+	defer release()
+	if r == nil {
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+// errorResponse is the JSON body of error responses
+type errorResponse struct {
+	Error string `json:"error"`
+	Code  string `json:"code,omitempty"` // Set for limit errors e.g. CodeRateLimited
+}
+
+// writeJSON writes v to w as JSON with the given status code
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an errorResponse for msg to w
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, errorResponse{Error: msg})
+}
+
+// writeErrorCode writes an errorResponse for code and msg to w
+func writeErrorCode(w http.ResponseWriter, status int, code, msg string) {
+	writeJSON(w, status, errorResponse{Error: msg, Code: code})
+}
+
+// allowMethods returns true if r uses one of methods, and otherwise
+// writes a 405 response
+func allowMethods(w http.ResponseWriter, r *http.Request, methods ...string) bool {
+	for _, m := range methods {
+		if r.Method == m {
+			return true
+		}
+	}
+	w.Header().Set("Allow", strings.Join(methods, ", "))
+	writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	return false
+}
+
+// currentParser returns the current Parser, writing a 503 response if
+// there is none
+func (s *Server) currentParser(w http.ResponseWriter) *gocd.Parser {
+	p := s.parser()
+	if p == nil {
+		writeError(w, http.StatusServiceUnavailable, "parser not ready")
+	}
+	return p
+}
+
+func (s *Server) handleParse(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet, http.MethodPost) {
+		return
+	}
+	if r.Method == http.MethodPost {
+		s.handleBatch(w, r, isNDJSON(r.Header.Get("Content-Type")))
+		return
+	}
+	name := r.URL.Query().Get("name")
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "missing name parameter")
+		return
+	}
+	p := s.currentParser(w)
+	if p == nil {
+		return
+	}
+	res, err := p.ParseLang(name, r.URL.Query().Get("lang"))
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// readiness is the JSON body of /readyz responses
+type readiness struct {
+	Ready           bool   `json:"ready"`
+	DatasetChecksum string `json:"dataset_checksum,omitempty"` // SHA-256 of the loaded dataset entries
+	Designators     int    `json:"designators,omitempty"`      // Number of loaded dataset entries
+}
+
+func (s *Server) handleReady(w http.ResponseWriter, r *http.Request) {
+	p := s.parser()
+	if p == nil {
+		writeJSON(w, http.StatusServiceUnavailable, readiness{})
+		return
+	}
+	sum, n := s.datasetChecksum(p)
+	writeJSON(w, http.StatusOK, readiness{Ready: true, DatasetChecksum: sum, Designators: n})
+}
+
+// datasetChecksum returns the SHA-256 checksum of p's dataset entries
+// (including any layers and overlays) and their number. Both are
+// computed once per Parser.
+func (s *Server) datasetChecksum(p *gocd.Parser) (string, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.sumFor != p {
+		entries := p.Designators()
+		data, _ := json.Marshal(entries)
+		sum := sha256.Sum256(data)
+		s.sumFor = p
+		s.checksum = hex.EncodeToString(sum[:])
+		s.entries = len(entries)
+	}
+	return s.checksum, s.entries
+}
+
+func (s *Server) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	writePrometheus(w, s.opts.Metrics)
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/ProfoundNetworks/gocd"
+	"github.com/stretchr/testify/assert"
+)
+
+// get returns the response to a GET request for target from s
+func get(s http.Handler, target string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	return rec
+}
+
+func TestServer(t *testing.T) {
+	metrics := gocd.NewMetrics()
+	p, err := gocd.New(gocd.WithMetrics(metrics))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := New(func() *gocd.Parser { return p }, Options{Metrics: metrics})
+
+	tests := []struct {
+		name       string
+		lang       string
+		shortName  string
+		designator string
+	}{
+		{"Profound Networks LLC", "", "Profound Networks", "LLC"},
+		{"Acme & Sons", "", "Acme & Sons", ""},
+		{"Romashka OOO", "ru", "Romashka", "OOO"},
+	}
+	for _, tc := range tests {
+		q := url.Values{"name": {tc.name}, "lang": {tc.lang}}
+		rec := get(s, "/v1/parse?"+q.Encode())
+		if !assert.Equal(t, http.StatusOK, rec.Code, "status for %q", tc.name) {
+			continue
+		}
+		var res gocd.Result
+		if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.shortName, res.ShortName, "ShortName matches for %q", tc.name)
+		assert.Equal(t, tc.designator, res.Designator, "Designator matches for %q", tc.name)
+	}
+
+	assert.Equal(t, http.StatusBadRequest, get(s, "/v1/parse").Code, "missing name status")
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/v1/parse?name=x", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code, "bad method status")
+
+	assert.Equal(t, http.StatusOK, get(s, "/healthz").Code, "healthz status")
+
+	rec = get(s, "/readyz")
+	assert.Equal(t, http.StatusOK, rec.Code, "readyz status")
+	var ready readiness
+	if err := json.Unmarshal(rec.Body.Bytes(), &ready); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, ready.Ready, "ready")
+	assert.Len(t, ready.DatasetChecksum, 64, "dataset checksum")
+	assert.Equal(t, len(p.Designators()), ready.Designators, "designator count")
+
+	// Checksums reflect the dataset
+	p2, err := p.Clone(gocd.WithLayer(gocd.NonprofitLayer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ready2 readiness
+	json.Unmarshal(get(New(func() *gocd.Parser { return p2 }, Options{}), "/readyz").Body.Bytes(), &ready2)
+	assert.NotEqual(t, ready.DatasetChecksum, ready2.DatasetChecksum, "layer changes checksum")
+
+	rec = get(s, "/metrics")
+	assert.Equal(t, http.StatusOK, rec.Code, "metrics status")
+	body := rec.Body.String()
+	assert.Contains(t, body, "gocd_parses_total 3\n", "parses metric")
+	assert.Contains(t, body, "gocd_matches_total 2\n", "matches metric")
+	assert.Contains(t, body, `gocd_matches_by_position_total{position="end"} 2`+"\n", "position metric")
+	assert.Contains(t, body, `gocd_parse_latency_seconds_bucket{le="+Inf"} 3`+"\n", "latency +Inf bucket")
+	assert.Contains(t, body, "gocd_parse_latency_seconds_count 3\n", "latency count")
+}
+
+func TestServerNotReady(t *testing.T) {
+	s := New(func() *gocd.Parser { return nil }, Options{})
+	assert.Equal(t, http.StatusOK, get(s, "/healthz").Code, "healthz status")
+	assert.Equal(t, http.StatusServiceUnavailable, get(s, "/readyz").Code, "readyz status")
+	assert.Equal(t, http.StatusServiceUnavailable, get(s, "/v1/parse?name=Acme+Ltd").Code, "parse status")
+	assert.Equal(t, http.StatusNotFound, get(s, "/metrics").Code, "metrics disabled")
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ProfoundNetworks/gocd"
+)
+
+// handleStream parses a stream of names POSTed as NDJSON, writing each
+// result as NDJSON as soon as it is parsed. Requires HTTP/2 for
+// concurrent reading and writing, and otherwise falls back to
+// handleBatch. Errors after the response has started are reported as a
+// final errorResponse line.
+func (s *Server) handleStream(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodPost) {
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if r.ProtoMajor < 2 || !ok {
+		s.handleBatch(w, r, true)
+		return
+	}
+	p := s.currentParser(w)
+	if p == nil {
+		return
+	}
+
+	lang := r.URL.Query().Get("lang")
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	enc := json.NewEncoder(w)
+	scanner := bufio.NewScanner(r.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), gocd.MaxLineLength)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		if r.Context().Err() != nil {
+			enc.Encode(errorResponse{Error: "request timed out", Code: CodeTimeout})
+			return
+		}
+		var name string
+		if err := json.Unmarshal(scanner.Bytes(), &name); err != nil {
+			enc.Encode(errorResponse{Error: fmt.Sprintf("invalid JSON string on line %d: %v", line, err)})
+			return
+		}
+		res, err := p.ParseLang(name, lang)
+		if err != nil {
+			enc.Encode(errorResponse{Error: fmt.Sprintf("line %d: %v", line, err)})
+			return
+		}
+		if err := enc.Encode(res); err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+	if err := scanner.Err(); err != nil {
+		enc.Encode(errorResponse{Error: err.Error()})
+	}
+}
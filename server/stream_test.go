@@ -0,0 +1,80 @@
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ProfoundNetworks/gocd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStream(t *testing.T) {
+	p, err := gocd.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := httptest.NewUnstartedServer(New(func() *gocd.Parser { return p }, Options{}))
+	ts.EnableHTTP2 = true
+	ts.StartTLS()
+	defer ts.Close()
+
+	// Each result is received before the next name is sent
+	pr, pw := io.Pipe()
+	req, err := http.NewRequest(http.MethodPost, ts.URL+"/v1/stream", pr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	resp, err := ts.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	assert.Equal(t, 2, resp.ProtoMajor, "HTTP/2 response")
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "stream status")
+
+	names := []string{"Profound Networks LLC", "Acme Widgets", "OOO Romashka"}
+	shortNames := []string{"Profound Networks", "Acme Widgets", "Romashka"}
+	results := bufio.NewScanner(resp.Body)
+	for i, name := range names {
+		fmt.Fprintf(pw, "%q\n", name)
+		if !results.Scan() {
+			t.Fatalf("no result for %q: %v", name, results.Err())
+		}
+		var res gocd.Result
+		if err := json.Unmarshal(results.Bytes(), &res); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, shortNames[i], res.ShortName, "streamed ShortName for %q", name)
+	}
+
+	// Invalid lines end the stream with an error
+	fmt.Fprintln(pw, "Acme Ltd")
+	if assert.True(t, results.Scan(), "error line") {
+		var e errorResponse
+		json.Unmarshal(results.Bytes(), &e)
+		assert.Contains(t, e.Error, "line 4", "error line reports line number")
+	}
+	pw.Close()
+	assert.False(t, results.Scan(), "stream ends")
+}
+
+func TestStreamHTTP1(t *testing.T) {
+	p, err := gocd.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := New(func() *gocd.Parser { return p }, Options{})
+	rec := post(s, "/v1/stream", "application/x-ndjson", "\"Acme Ltd\"\n\"Acme Widgets\"\n")
+	assert.Equal(t, http.StatusOK, rec.Code, "HTTP/1 stream status")
+	lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+	if assert.Len(t, lines, 2, "HTTP/1 stream results") {
+		assert.Contains(t, lines[0], `"short_name":"Acme"`, "HTTP/1 stream result")
+	}
+}
@@ -0,0 +1,112 @@
+/*
+serverless provides a ready-made gocd handler for AWS Lambda and other
+cloud function platforms, initialising a single Parser per container on
+first use rather than at startup.
+
+With github.com/aws/aws-lambda-go, Handle can be passed directly to
+lambda.Start:
+
+	h := &serverless.Handler{StatePath: "gocd.state"}
+	lambda.Start(h.Handle)
+
+Platforms using the generic func(ctx, []string) ([]gocd.Result, error)
+shape can use ParseNames instead.
+*/
+package serverless
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/ProfoundNetworks/gocd"
+)
+
+// Request is the event payload accepted by Handle
+type Request struct {
+	Names []string `json:"names"`
+}
+
+// Response is the payload returned by Handle, with one Result per
+// Request name, in order
+type Response struct {
+	Results []gocd.Result `json:"results"`
+}
+
+// Handler parses batches of company names with a lazily initialised
+// Parser, shared by all invocations within a container. The zero
+// Handler uses a default Parser. Handler fields must not be modified
+// after first use.
+type Handler struct {
+	Options   []gocd.Option // Options passed to gocd.New (or gocd.NewFromState)
+	StatePath string        // Path to a file written by Parser.WriteState, to skip pattern compilation
+
+	mu     sync.Mutex
+	parser *gocd.Parser
+}
+
+// Parser returns the Handler's Parser, initialising it on first use.
+// Initialisation errors are returned, and initialisation is retried on
+// the next call.
+func (h *Handler) Parser() (*gocd.Parser, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.parser != nil {
+		return h.parser, nil
+	}
+
+	var p *gocd.Parser
+	var err error
+	if h.StatePath != "" {
+		p, err = newFromStateFile(h.StatePath, h.Options)
+	} else {
+		p, err = gocd.New(h.Options...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	h.parser = p
+	return p, nil
+}
+
+// newFromStateFile returns a new Parser loaded from the state file path
+func newFromStateFile(path string, opts []gocd.Option) (*gocd.Parser, error) {
+	fh, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening state: %w", err)
+	}
+	defer fh.Close()
+	return gocd.NewFromState(fh, opts...)
+}
+
+// ParseNames parses names, returning a Result for each, in order. It
+// stops with an error if ctx is done (e.g. the invocation deadline is
+// reached) before all names are parsed.
+func (h *Handler) ParseNames(ctx context.Context, names []string) ([]gocd.Result, error) {
+	p, err := h.Parser()
+	if err != nil {
+		return nil, err
+	}
+	results := make([]gocd.Result, len(names))
+	for i, name := range names {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		res, err := p.ParseContext(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q: %w", name, err)
+		}
+		results[i] = *res
+	}
+	return results, nil
+}
+
+// Handle is a Lambda-compatible handler parsing the names in req
+func (h *Handler) Handle(ctx context.Context, req Request) (Response, error) {
+	results, err := h.ParseNames(ctx, req.Names)
+	if err != nil {
+		return Response{}, err
+	}
+	return Response{Results: results}, nil
+}
@@ -0,0 +1,63 @@
+package serverless
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ProfoundNetworks/gocd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandle(t *testing.T) {
+	p, err := gocd.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "gocd.state")
+	fh, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.WriteState(fh)
+	fh.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, h := range []*Handler{{}, {StatePath: path}} {
+		resp, err := h.Handle(context.Background(),
+			Request{Names: []string{"Profound Networks LLC", "Acme Widgets"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if assert.Len(t, resp.Results, 2, "Results length matches") {
+			assert.Equal(t, "Profound Networks", resp.Results[0].ShortName, "ShortName matches")
+			assert.Equal(t, "LLC", resp.Results[0].Designator, "Designator matches")
+			assert.False(t, resp.Results[1].Matched, "unmatched name")
+		}
+
+		// The Parser is initialised once
+		p1, err := h.Parser()
+		if err != nil {
+			t.Fatal(err)
+		}
+		p2, err := h.Parser()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Same(t, p1, p2, "Parser is reused")
+	}
+
+	// Initialisation errors are returned, and retried
+	h := &Handler{StatePath: filepath.Join(t.TempDir(), "missing")}
+	_, err = h.ParseNames(context.Background(), []string{"Acme Ltd"})
+	assert.Error(t, err, "missing state errors")
+
+	// Cancelled contexts stop parsing
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = (&Handler{}).ParseNames(ctx, []string{"Acme Ltd"})
+	assert.ErrorIs(t, err, context.Canceled, "cancelled context errors")
+}
@@ -0,0 +1,148 @@
+package gocd
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// scriptGroup is a set of scripts whose designators are compiled into
+// a shared regex shard
+type scriptGroup struct {
+	name   string
+	tables []*unicode.RangeTable
+}
+
+// scriptGroups are the script groups we build shards for. Japanese and
+// Chinese names routinely mix Han and kana, so these share a group.
+var scriptGroups = []scriptGroup{
+	{"Latin", []*unicode.RangeTable{unicode.Latin}},
+	{"Cyrillic", []*unicode.RangeTable{unicode.Cyrillic}},
+	{"Greek", []*unicode.RangeTable{unicode.Greek}},
+	{"CJK", []*unicode.RangeTable{unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul}},
+	{"Arabic", []*unicode.RangeTable{unicode.Arabic}},
+	{"Hebrew", []*unicode.RangeTable{unicode.Hebrew}},
+	{"Thai", []*unicode.RangeTable{unicode.Thai}},
+}
+
+// WithScriptShards additionally compiles each pass into per-script
+// regex shards containing only the designators that can match input
+// written in that script. Input whose letters all belong to a single
+// script group (Latin, Cyrillic, Greek, CJK, Arabic, Hebrew, or Thai)
+// is matched against that group's smaller shard, and other input
+// against the full regexes. Results are unchanged, at the cost of
+// additional compilation time and memory in New.
+func WithScriptShards() Option {
+	return func(o *options) {
+		o.scriptShards = true
+	}
+}
+
+// scriptGroupOf returns the index of the script group containing all
+// the letters in b, or -1 if there is no such group (including when b
+// contains no letters)
+func scriptGroupOf(b []byte) int {
+	group := -1
+	for len(b) > 0 {
+		r, size := utf8.DecodeRune(b)
+		b = b[size:]
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		if group >= 0 && unicode.IsOneOf(scriptGroups[group].tables, r) {
+			continue
+		}
+		if group >= 0 {
+			return -1
+		}
+		for i, g := range scriptGroups {
+			if unicode.IsOneOf(g.tables, r) {
+				group = i
+				break
+			}
+		}
+		if group < 0 {
+			return -1
+		}
+	}
+	return group
+}
+
+// entryInGroup returns true if any of e's designators can match input
+// written entirely in script group g
+func entryInGroup(e *Entry, g int) bool {
+	// Regex abbreviations are opaque, so include them everywhere
+	if len(e.AbbrRE) > 0 {
+		return true
+	}
+	if desInGroup(e.LongName, g) {
+		return true
+	}
+	for _, a := range e.Abbr {
+		if desInGroup(a, g) {
+			return true
+		}
+	}
+	return false
+}
+
+// desInGroup returns true if all the letters in des belong to script group g
+func desInGroup(des string, g int) bool {
+	for _, r := range des {
+		if unicode.IsLetter(r) && !unicode.IsOneOf(scriptGroups[g].tables, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// compileShards compiles per-script-group shards of the Parser from the
+// entries in matchDs
+func (p *Parser) compileShards(matchDs *dataset) error {
+	p.shards = make([]*Parser, len(scriptGroups))
+	for g := range scriptGroups {
+		g := g
+		sub := matchDs.filter(func(e *Entry) bool { return entryInGroup(e, g) })
+		sp := *p
+		sp.opts.scriptShards = false
+		err := sp.compile(p.ds, sub, nil)
+		if err != nil {
+			return err
+		}
+		sp.shards = nil
+		p.shards[g] = &sp
+	}
+	return nil
+}
+
+// reshard replaces p's shards with copies using p's options, for a
+// Clone whose options don't require recompiling the shards
+func (p *Parser) reshard() {
+	if p.shards == nil {
+		return
+	}
+	shards := make([]*Parser, len(p.shards))
+	for g, s := range p.shards {
+		sp := *s
+		sp.opts = p.opts
+		sp.opts.scriptShards = false
+		shards[g] = &sp
+	}
+	p.shards = shards
+}
+
+// shard returns the Parser shard to use for matching input
+func (p *Parser) shard(input []byte) *Parser {
+	if p.shards == nil {
+		return p
+	}
+	var g int
+	if p.opts.scriptDetect {
+		g = dominantScriptGroup(input)
+	} else {
+		g = scriptGroupOf(input)
+	}
+	if g >= 0 {
+		return p.shards[g]
+	}
+	return p
+}
@@ -0,0 +1,77 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScriptGroupOf(t *testing.T) {
+	tests := []struct {
+		input string
+		group string
+	}{
+		{"Acme Widgets GmbH", "Latin"},
+		{"Société Générale S.A.", "Latin"},
+		{"ООО Ромашка", "Cyrillic"},
+		{"トヨタ自動車株式会社", "CJK"},
+		{"ООО Acme", ""},
+		{"12345", ""},
+	}
+
+	for _, tc := range tests {
+		group := ""
+		if g := scriptGroupOf([]byte(tc.input)); g >= 0 {
+			group = scriptGroups[g].name
+		}
+		assert.Equal(t, tc.group, group, "script group matches for %q", tc.input)
+	}
+}
+
+func TestScriptShards(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps, err := New(WithScriptShards())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := []string{"ООО Acme", "Acme GmbH", "ООО Ромашка", "12345 Ltd"}
+	for _, tc := range loadStripTests() {
+		inputs = append(inputs, tc.Name)
+	}
+	for _, input := range inputs {
+		res, err := p.Parse(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resShards, err := ps.Parse(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, res, resShards, "WithScriptShards Parse matches for %q", input)
+	}
+}
+
+func BenchmarkScriptShards(b *testing.B) {
+	tests := loadStripTests()
+
+	p, err := New(WithScriptShards())
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	j := 0
+	for i := 0; i < b.N; i++ {
+		_, err := p.Parse(tests[j].Name)
+		if err != nil {
+			b.Fatal(err)
+		}
+		j++
+		if j >= len(tests) {
+			j = 0
+		}
+	}
+}
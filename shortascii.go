@@ -0,0 +1,43 @@
+package gocd
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// asciiReplacer replaces Latin letters without a decomposition (letters
+// with strokes, and ligatures) by their ASCII equivalents
+var asciiReplacer = strings.NewReplacer(
+	"Æ", "AE", "æ", "ae", "Œ", "OE", "œ", "oe", "ß", "ss", "ẞ", "SS",
+	"Ø", "O", "ø", "o", "Ł", "L", "ł", "l", "Đ", "D", "đ", "d",
+	"Ħ", "H", "ħ", "h", "ı", "i",
+)
+
+// WithShortNameASCII sets Result.ShortNameASCII to the ShortName with
+// diacritics stripped, and letters with strokes and ligatures (e.g. "ø",
+// "ł", "æ", "ß") replaced by their ASCII equivalents, for systems whose
+// join keys are ASCII-folded. Letters in other scripts are unchanged,
+// so ShortNameASCII is only pure ASCII for Latin-script names.
+func WithShortNameASCII() Option {
+	return func(o *options) {
+		o.shortASCII = true
+	}
+}
+
+// asciiFold returns s with diacritics stripped and Latin letters
+// replaced by their ASCII equivalents where possible
+func asciiFold(s string) string {
+	if isASCII([]byte(s)) {
+		return s
+	}
+	s = norm.NFD.String(s)
+	s = strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Mn, r) {
+			return -1
+		}
+		return r
+	}, s)
+	return norm.NFC.String(asciiReplacer.Replace(s))
+}
@@ -0,0 +1,52 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShortNameASCII(t *testing.T) {
+	p, err := New(WithShortNameASCII())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input string
+		short string
+		ascii string
+	}{
+		{"Société Générale S.A.", "Société Générale", "Societe Generale"},
+		{"Müller & Söhne GmbH", "Müller & Söhne", "Muller & Sohne"},
+		{"Łódź Økonomi Straße AG", "Łódź Økonomi Straße", "Lodz Okonomi Strasse"},
+		{"Acme Widgets Ltd", "Acme Widgets", "Acme Widgets"},
+		{"ООО Ромашка", "Ромашка", "Ромашка"},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.short, res.ShortName, "ShortName matches for %q", tc.input)
+		assert.Equal(t, tc.ascii, res.ShortNameASCII, "ShortNameASCII matches for %q", tc.input)
+
+		// ShortNameASCII round trips through proto
+		b, err := res.MarshalProto()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded Result
+		if err := decoded.UnmarshalProto(b); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.ascii, decoded.ShortNameASCII, "proto ShortNameASCII matches for %q", tc.input)
+	}
+
+	// Not set by default
+	res, err := MustNew().Parse("Société Générale S.A.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "", res.ShortNameASCII, "ShortNameASCII unset by default")
+}
@@ -0,0 +1,112 @@
+package gocd
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ShortNamePolicy determines how a Parser handles matches that would
+// leave a ShortName shorter than the configured minimum
+type ShortNamePolicy int
+
+const (
+	KeepInput     ShortNamePolicy = iota // Report the match, but use Input as ShortName
+	SuppressMatch                        // Report no match
+)
+
+// WithMinShortNameRunes sets a minimum ShortName length of n letters
+// and digits, with policy applied to matches that would violate it. It
+// may be combined with WithMinShortNameTokens, in which case
+// SuppressMatch applies if either minimum with that policy is violated.
+func WithMinShortNameRunes(n int, policy ShortNamePolicy) Option {
+	return func(o *options) {
+		o.minShortRunes = n
+		o.runesPolicy = policy
+	}
+}
+
+// WithMinShortNameTokens sets a minimum ShortName length of n tokens
+// (whitespace-separated words containing letters or digits), with
+// policy applied to matches that would violate it (see also
+// WithMinShortNameRunes)
+func WithMinShortNameTokens(n int, policy ShortNamePolicy) Option {
+	return func(o *options) {
+		o.minShortTokens = n
+		o.tokensPolicy = policy
+	}
+}
+
+// WithMinShortAbbrTokens suppresses matches of designators with only
+// one or two letters or digits (e.g. "AS", "S.A.") unless the name has
+// at least n tokens that aren't themselves designators, so that e.g.
+// state codes or initials ending short inputs aren't taken as
+// designators
+func WithMinShortAbbrTokens(n int) Option {
+	return func(o *options) {
+		o.minAbbrTokens = n
+	}
+}
+
+// shortNameRunes returns the number of letters and digits in s
+func shortNameRunes(s string) int {
+	n := 0
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			n++
+		}
+	}
+	return n
+}
+
+// shortNameTokens returns the number of tokens in s
+func shortNameTokens(s string) int {
+	n := 0
+	for _, f := range strings.Fields(s) {
+		if shortNameRunes(f) > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// nameTokens returns the number of tokens in s that aren't designators
+func (p *Parser) nameTokens(s string) int {
+	n := 0
+	for _, f := range strings.Fields(s) {
+		if shortNameRunes(f) == 0 {
+			continue
+		}
+		if _, ok := p.idx.lookup(f); !ok {
+			n++
+		}
+	}
+	return n
+}
+
+// checkShortAbbr applies the minimum name token requirement for short
+// designators to res
+func (p *Parser) checkShortAbbr(res *Result) {
+	if res.Matched && shortNameRunes(res.Designator) <= 2 &&
+		p.nameTokens(res.ShortName) < p.opts.minAbbrTokens {
+		res.clearMatch(res.Input)
+	}
+}
+
+// checkShortName applies the minimum ShortName length policies to res,
+// with SuppressMatch taking precedence over KeepInput
+func (p *Parser) checkShortName(res *Result) {
+	if !res.Matched {
+		return
+	}
+	shortRunes := shortNameRunes(res.ShortName) < p.opts.minShortRunes
+	shortTokens := shortNameTokens(res.ShortName) < p.opts.minShortTokens
+	switch {
+	case !shortRunes && !shortTokens:
+		return
+	case shortRunes && p.opts.runesPolicy == SuppressMatch,
+		shortTokens && p.opts.tokensPolicy == SuppressMatch:
+		res.clearMatch(res.Input)
+	default:
+		res.ShortName = res.Input
+	}
+}
@@ -0,0 +1,101 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinShortName(t *testing.T) {
+	tests := []struct {
+		opt     Option
+		input   string
+		matched bool
+		short   string
+		des     string
+	}{
+		{WithMinShortNameRunes(2, KeepInput), "A Ltd", true, "A Ltd", "Ltd"},
+		{WithMinShortNameRunes(2, KeepInput), "AB Ltd", true, "AB", "Ltd"},
+		{WithMinShortNameRunes(2, SuppressMatch), "A. Ltd", false, "A. Ltd", ""},
+		{WithMinShortNameTokens(2, KeepInput), "Acme Ltd", true, "Acme Ltd", "Ltd"},
+		{WithMinShortNameTokens(2, SuppressMatch), "Acme - Ltd", false, "Acme - Ltd", ""},
+		{WithMinShortNameTokens(2, SuppressMatch), "Acme Widgets Ltd", true, "Acme Widgets", "Ltd"},
+	}
+
+	for _, tc := range tests {
+		p, err := New(tc.opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.matched, res.Matched, "Matched matches for %q", tc.input)
+		assert.Equal(t, tc.short, res.ShortName, "ShortName matches for %q", tc.input)
+		assert.Equal(t, tc.des, res.Designator, "Designator matches for %q", tc.input)
+		if !tc.matched {
+			assert.Equal(t, None, res.Position, "Position none for %q", tc.input)
+		}
+	}
+
+	// Rune and token minimums combine, whatever the option order
+	for _, opts := range [][]Option{
+		{WithMinShortNameRunes(3, SuppressMatch), WithMinShortNameTokens(2, KeepInput)},
+		{WithMinShortNameTokens(2, KeepInput), WithMinShortNameRunes(3, SuppressMatch)},
+	} {
+		p, err := New(opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := p.Parse("AB Ltd")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.False(t, res.Matched, "rune minimum suppresses match")
+		res, err = p.Parse("Acme Ltd")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, res.Matched, "token minimum keeps match")
+		assert.Equal(t, "Acme Ltd", res.ShortName, "token minimum keeps Input as ShortName")
+		res, err = p.Parse("Acme Widgets Ltd")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "Acme Widgets", res.ShortName, "both minimums met")
+	}
+}
+
+func TestMinShortAbbrTokens(t *testing.T) {
+	p, err := New(WithMinShortAbbrTokens(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input   string
+		matched bool
+		des     string
+	}{
+		{"Tampa AS", false, ""},
+		{"Acme Widgets AS", true, "AS"},
+		{"Acme Widgets S.A.", true, "S.A."},
+		{"AG Acme", false, ""},
+		// Designator tokens don't count
+		{"Acme Co SA", false, ""},
+		// Longer designators are unaffected
+		{"Acme Ltd", true, "Ltd"},
+		{"Acme GmbH", true, "GmbH"},
+		// Whole-input designators are unaffected
+		{"AS", true, "AS"},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.matched, res.Matched, "Matched matches for %q", tc.input)
+		assert.Equal(t, tc.des, res.Designator, "Designator matches for %q", tc.input)
+	}
+}
@@ -0,0 +1,109 @@
+package gocd
+
+import (
+	"sort"
+	"strings"
+)
+
+// Similarity returns a similarity score between 0 and 1 for the company
+// names a and b, ignoring their designators. Names are normalised as for
+// MatchKey, their tokens sorted (so word order doesn't matter), and the
+// results compared with Jaro-Winkler similarity. Names that cannot be
+// parsed, or have no letters or digits outside their designators,
+// score 0.
+func (p *Parser) Similarity(a, b string) float64 {
+	na, ok := p.similarityForm(a)
+	if !ok {
+		return 0
+	}
+	nb, ok := p.similarityForm(b)
+	if !ok {
+		return 0
+	}
+	return jaroWinkler(na, nb)
+}
+
+// similarityForm returns the normalised designator-stripped form of name
+// with its tokens sorted, and false if there is none
+func (p *Parser) similarityForm(name string) ([]rune, bool) {
+	res, err := p.Parse(name)
+	if err != nil {
+		return nil, false
+	}
+	tokens := strings.Fields(normalizeName(res.ShortName))
+	if len(tokens) == 0 {
+		return nil, false
+	}
+	sort.Strings(tokens)
+	return []rune(strings.Join(tokens, " ")), true
+}
+
+// jaroWinkler returns the Jaro-Winkler similarity of a and b
+func jaroWinkler(a, b []rune) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	window := len(a)
+	if len(b) > window {
+		window = len(b)
+	}
+	window = window/2 - 1
+	if window < 0 {
+		window = 0
+	}
+
+	// Count matching runes within the window
+	matchedA := make([]bool, len(a))
+	matchedB := make([]bool, len(b))
+	matches := 0
+	for i := range a {
+		lo, hi := i-window, i+window+1
+		if lo < 0 {
+			lo = 0
+		}
+		if hi > len(b) {
+			hi = len(b)
+		}
+		for j := lo; j < hi; j++ {
+			if !matchedB[j] && a[i] == b[j] {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	// Count transpositions between the matched runes
+	transpositions := 0
+	j := 0
+	for i := range a {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if a[i] != b[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(a)) + m/float64(len(b)) + (m-float64(transpositions/2))/m) / 3
+
+	// Boost for a common prefix of up to 4 runes, only for strings that
+	// are already similar (the standard threshold)
+	if jaro <= 0.7 {
+		return jaro
+	}
+	prefix := 0
+	for prefix < 4 && prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
@@ -0,0 +1,50 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJaroWinkler(t *testing.T) {
+	tests := []struct {
+		a, b string
+		sim  float64
+	}{
+		{"martha", "marhta", 0.961},
+		{"dwayne", "duane", 0.84},
+		{"dixon", "dicksonx", 0.813},
+		{"abc", "abc", 1},
+		{"abc", "xyz", 0},
+		{"abxy", "abzw", 0.667}, // No prefix boost below the threshold
+	}
+
+	for _, tc := range tests {
+		assert.InDelta(t, tc.sim, jaroWinkler([]rune(tc.a), []rune(tc.b)), 0.001,
+			"jaroWinkler(%q, %q)", tc.a, tc.b)
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		a, b     string
+		min, max float64
+	}{
+		{"Acme Widgets Ltd", "Acme Widgets Limited", 1, 1},
+		{"Acme Widgets Ltd", "Widgets Acme GmbH", 1, 1},
+		{"Acme Widgets Pty Ltd", "ACME WIDGET", 0.95, 0.99},
+		{"Acme Widgets Ltd", "Zenith Holdings Ltd", 0, 0.6},
+		{"Acme Ltd", "!!!", 0, 0},
+	}
+
+	for _, tc := range tests {
+		sim := p.Similarity(tc.a, tc.b)
+		assert.True(t, sim >= tc.min && sim <= tc.max,
+			"Similarity(%q, %q) = %.3f, expected %.2f-%.2f", tc.a, tc.b, sim, tc.min, tc.max)
+	}
+}
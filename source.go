@@ -0,0 +1,204 @@
+package gocd
+
+import (
+	"regexp"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// source holds the input to a single match, allowing result strings to
+// be sliced from the input string rather than allocated where possible
+type source struct {
+	b     []byte
+	s     string // b as a string, if ASCII and available
+	ascii bool
+	form  OutputForm
+	views []view // Working copies of b, for OutputOriginal
+}
+
+// view is a normalised working copy of a source's input, with offs
+// mapping each byte offset in b to the corresponding offset in the
+// input, or -1 for offsets inside a replaced or decomposed segment
+type view struct {
+	b    []byte
+	offs []int
+}
+
+// newSource returns a source for input b, which str holds as a string
+// if non-empty, producing result strings in the given form
+func newSource(b []byte, str string, form OutputForm) source {
+	src := source{b: b, ascii: isASCII(b), form: form}
+	// ASCII is unchanged by normalisation, so str can be sliced
+	if src.ascii {
+		src.s = str
+	}
+	return src
+}
+
+// isASCII returns true if b contains only ASCII characters
+func isASCII(b []byte) bool {
+	for _, c := range b {
+		if c >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// subOffset returns the offset of the non-empty sub within b, or -1 if
+// sub is not a subslice of b
+func subOffset(b, sub []byte) int {
+	if len(sub) > len(b) {
+		return -1
+	}
+	off := cap(b) - cap(sub)
+	if off >= 0 && off+len(sub) <= len(b) && &b[off] == &sub[0] {
+		return off
+	}
+	// regexp caps the capacity of submatches, so check each offset
+	for off := 0; off+len(sub) <= len(b); off++ {
+		if &b[off] == &sub[0] {
+			return off
+		}
+	}
+	return -1
+}
+
+// str returns sub as a string in the source's output form, slicing it
+// from the input string without allocating if sub is an unmodified part
+// of the input
+func (src source) str(sub []byte) string {
+	if len(sub) == 0 {
+		return ""
+	}
+	if src.s != "" {
+		if off := subOffset(src.b, sub); off >= 0 {
+			return src.s[off : off+len(sub)]
+		}
+	}
+	switch src.form {
+	case OutputNFD:
+		return string(norm.NFD.Bytes(sub))
+	case OutputOriginal:
+		if s, ok := src.original(sub); ok {
+			return s
+		}
+	}
+	return nfc(sub)
+}
+
+// original returns the part of the input corresponding to sub, which
+// may be part of the input or of one of its working views
+func (src source) original(sub []byte) (string, bool) {
+	if subOffset(src.b, sub) >= 0 {
+		return string(sub), true
+	}
+	for _, v := range src.views {
+		off := subOffset(v.b, sub)
+		if off < 0 {
+			continue
+		}
+		start, end := off, off+len(sub)
+		for start > 0 && v.offs[start] < 0 {
+			start--
+		}
+		for end < len(v.b) && v.offs[end] < 0 {
+			end++
+		}
+		return string(src.b[v.offs[start]:v.offs[end]]), true
+	}
+	return "", false
+}
+
+// nfd returns the source input in NFD form, as the initial working copy
+func (src *source) nfd() []byte {
+	if src.ascii {
+		return src.b
+	}
+	if src.form != OutputOriginal {
+		return norm.NFD.Bytes(src.b)
+	}
+	v := view{
+		b:    make([]byte, 0, len(src.b)),
+		offs: make([]int, 0, len(src.b)+1),
+	}
+	var it norm.Iter
+	it.Init(norm.NFD, src.b)
+	for !it.Done() {
+		v.offs = append(v.offs, it.Pos())
+		seg := it.Next()
+		for i := 1; i < len(seg); i++ {
+			v.offs = append(v.offs, -1)
+		}
+		v.b = append(v.b, seg...)
+	}
+	v.offs = append(v.offs, len(src.b))
+	src.views = append(src.views, v)
+	return v.b
+}
+
+// offsets returns the input offsets of the working copy in, or nil if
+// in is not a working copy
+func (src *source) offsets(in []byte) []int {
+	if len(in) == 0 {
+		return nil
+	}
+	if len(in) == len(src.b) && &in[0] == &src.b[0] {
+		offs := make([]int, len(in)+1)
+		for i := range offs {
+			offs[i] = i
+		}
+		return offs
+	}
+	for _, v := range src.views {
+		if len(in) == len(v.b) && &in[0] == &v.b[0] {
+			return v.offs
+		}
+	}
+	return nil
+}
+
+// replaceAll returns a copy of the working copy in with matches of re
+// replaced by repl, recording the copy as a view for OutputOriginal
+func (src *source) replaceAll(re *regexp.Regexp, in, repl []byte) []byte {
+	if src.form != OutputOriginal {
+		return re.ReplaceAll(in, repl)
+	}
+	return src.replaceAllFunc(re, in, func([]byte) []byte { return repl })
+}
+
+// replaceAllFunc returns a copy of the working copy in with matches of
+// re replaced by the return value of repl applied to them, recording the
+// copy as a view for OutputOriginal
+func (src *source) replaceAllFunc(re *regexp.Regexp, in []byte, repl func([]byte) []byte) []byte {
+	if src.form != OutputOriginal {
+		return re.ReplaceAllFunc(in, repl)
+	}
+	offs := src.offsets(in)
+	if offs == nil {
+		return re.ReplaceAllFunc(in, repl)
+	}
+	v := view{
+		b:    make([]byte, 0, len(in)),
+		offs: make([]int, 0, len(in)+1),
+	}
+	last := 0
+	for _, m := range re.FindAllIndex(in, -1) {
+		r := repl(in[m[0]:m[1]])
+		v.b = append(append(v.b, in[last:m[0]]...), r...)
+		v.offs = append(v.offs, offs[last:m[0]]...)
+		for i := range r {
+			if i == 0 {
+				v.offs = append(v.offs, offs[m[0]])
+			} else {
+				v.offs = append(v.offs, -1)
+			}
+		}
+		last = m[1]
+	}
+	v.b = append(v.b, in[last:]...)
+	v.offs = append(v.offs, offs[last:]...)
+	src.views = append(src.views, v)
+	return v.b
+}
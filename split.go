@@ -0,0 +1,76 @@
+package gocd
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// reCompanySep matches candidate separators between companies in
+// multi-company strings: conjunctions (en, de, fr, es, it, pt, nl, da,
+// no, sv) and slashes, semicolons, and pipes
+var reCompanySep = regexp.MustCompile(
+	`(?i)\pZ+(?:and|und|et|y|e|en|og|och)\pZ+|\pZ*[/;|]\pZ*`)
+
+// hasLetter returns true if s contains a letter
+func hasLetter(s string) bool {
+	for _, r := range s {
+		if unicode.IsLetter(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// Split splits an input string containing multiple companies, like
+// "Acme Ltd and Beta GmbH" or "Acme Ltd / Beta Inc.", into separate
+// company names. Strings are only split at a conjunction or slash that
+// directly follows a (final) designator, so "Smith and Jones Ltd" is
+// not split. Returns a single element slice if no boundaries are found.
+func (p *Parser) Split(input string) ([]string, error) {
+	var names []string
+	start := 0
+	for _, loc := range reCompanySep.FindAllStringIndex(input, -1) {
+		left := strings.TrimSpace(input[start:loc[0]])
+		right := strings.TrimSpace(input[loc[1]:])
+		if !hasLetter(right) {
+			break
+		}
+		// Don't split compound designators like "SA/NV"
+		if _, ok := p.Lookup(right); ok {
+			continue
+		}
+		res, err := p.Parse(left)
+		if err != nil {
+			return nil, err
+		}
+		if !res.Matched || res.Position != End || !hasLetter(res.ShortName) {
+			continue
+		}
+		names = append(names, left)
+		start = loc[1]
+	}
+	names = append(names, strings.TrimSpace(input[start:]))
+
+	return names, nil
+}
+
+// ParseMulti splits input into separate companies using Split, and
+// returns a Result for each
+func (p *Parser) ParseMulti(input string) ([]*Result, error) {
+	names, err := p.Split(input)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*Result, 0, len(names))
+	for _, name := range names {
+		res, err := p.Parse(name)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,47 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		input string
+		names []string
+	}{
+		{"Acme Ltd and Beta GmbH", []string{"Acme Ltd", "Beta GmbH"}},
+		{"Acme Ltd / Beta Inc.", []string{"Acme Ltd", "Beta Inc."}},
+		{"Acme Ltd/Beta Inc.; Gamma SA", []string{"Acme Ltd", "Beta Inc.", "Gamma SA"}},
+		{"Acme GmbH und Beta AG", []string{"Acme GmbH", "Beta AG"}},
+		{"Smith and Jones Ltd", []string{"Smith and Jones Ltd"}},
+		{"AXA Group Operations Belgium SA/NV", []string{"AXA Group Operations Belgium SA/NV"}},
+		{"Acme Ltd /", []string{"Acme Ltd /"}},
+		{"Acme Widgets", []string{"Acme Widgets"}},
+	}
+
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range tests {
+		names, err := p.Split(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.names, names, "Split matches for %q", tc.input)
+	}
+
+	results, err := p.ParseMulti("Acme Ltd and Beta GmbH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, results, 2, "ParseMulti result count") {
+		assert.Equal(t, "Acme", results[0].ShortName, "first ShortName")
+		assert.Equal(t, "Ltd", results[0].Designator, "first Designator")
+		assert.Equal(t, "Beta", results[1].ShortName, "second ShortName")
+		assert.Equal(t, "GmbH", results[1].Designator, "second Designator")
+	}
+}
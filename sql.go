@@ -0,0 +1,30 @@
+package gocd
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Value implements driver.Valuer, encoding r as JSON for storage in
+// e.g. JSON or JSONB columns
+func (r Result) Value() (driver.Value, error) {
+	return json.Marshal(r)
+}
+
+// Scan implements sql.Scanner, decoding a JSON-encoded Result stored
+// with Value. Entry is not stored, so is nil after Scan; use
+// Parser.Lookup with the Designator to recover it. A NULL value scans
+// as an empty Result.
+func (r *Result) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*r = Result{}
+		return nil
+	case []byte:
+		return json.Unmarshal(v, r)
+	case string:
+		return json.Unmarshal([]byte(v), r)
+	}
+	return fmt.Errorf("cannot scan %T into Result", src)
+}
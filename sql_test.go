@@ -0,0 +1,46 @@
+package gocd
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ driver.Valuer = Result{}
+	_ sql.Scanner   = &Result{}
+)
+
+func TestResultSQL(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := p.Parse("Acme (UK) Ltd")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := res.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := *res
+	expected.Entry = nil
+
+	for _, src := range []interface{}{v, string(v.([]byte))} {
+		var scanned Result
+		err = scanned.Scan(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, expected, scanned, "Scan round trip matches for %T", src)
+	}
+
+	scanned := *res
+	assert.NoError(t, scanned.Scan(nil), "Scan NULL ok")
+	assert.Equal(t, Result{}, scanned, "Scan NULL gives empty Result")
+	assert.Error(t, scanned.Scan(42), "Scan of unsupported type errors")
+}
@@ -0,0 +1,96 @@
+package gocd
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrInvalidState is returned by NewFromState for unreadable or
+// incompatible state data
+var ErrInvalidState = errors.New("invalid parser state")
+
+// stateVersion identifies the state format and pattern construction
+// rules, and must be bumped whenever either changes
+const stateVersion = 5
+
+// state is the serialised form of a Parser's processed dataset and
+// pass patterns
+type state struct {
+	Version    int
+	Dataset    dataset
+	Priorities map[string]int // Entry layer priorities, by long name
+	Patterns   []string
+}
+
+// WriteState writes the Parser's processed dataset (including any
+// overlays) and built pass patterns to w, for loading with NewFromState.
+// State should be regenerated when upgrading gocd, as state written by a
+// different version is rejected.
+func (p *Parser) WriteState(w io.Writer) error {
+	priorities := make(map[string]int, len(*p.ds))
+	for long, e := range *p.ds {
+		priorities[long] = e.priority
+	}
+	return gob.NewEncoder(w).Encode(state{
+		Version:    stateVersion,
+		Dataset:    *p.ds,
+		Priorities: priorities,
+		Patterns:   p.patterns,
+	})
+}
+
+// NewFromState returns a new Parser using the state written by
+// WriteState, skipping the dataset parsing and pattern building done by
+// New, configured with any Options supplied. Layers and overlays are
+// already applied in state, so WithLayer and WithOverlay options are
+// ignored, while WithOnlyDesignators, WithDesignatorTransforms, and
+// WithTransliteration require patterns to be rebuilt. Patterns for
+// passes disabled when state was written are built as needed.
+// Errors wrap ErrInvalidState for bad state data, and ErrPatternCompile
+// for pattern compilation failures.
+func NewFromState(r io.Reader, opts ...Option) (*Parser, error) {
+	p := Parser{}
+	for _, opt := range opts {
+		opt(&p.opts)
+	}
+	p.re = newRemap()
+
+	var st state
+	err := gob.NewDecoder(r).Decode(&st)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidState, err)
+	}
+	if st.Version != stateVersion {
+		return nil, fmt.Errorf("%w: version %d, expected %d", ErrInvalidState,
+			st.Version, stateVersion)
+	}
+	if len(st.Patterns) != int(BeginFallback)+1 {
+		return nil, fmt.Errorf("%w: %d patterns, expected %d", ErrInvalidState,
+			len(st.Patterns), BeginFallback+1)
+	}
+
+	ds := &st.Dataset
+	for long, e := range *ds {
+		if e == nil {
+			return nil, fmt.Errorf("%w: missing entry %q", ErrInvalidState, long)
+		}
+		e.LongName = long
+		e.priority = st.Priorities[long]
+	}
+	if err := ds.validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidState, err)
+	}
+	patterns := st.Patterns
+	if p.opts.onlyDes != nil || p.opts.desTransforms != nil || p.opts.translit {
+		patterns = nil
+	}
+	err = p.compile(ds, ds.filter(func(e *Entry) bool { return !e.LangOnly }), patterns)
+	if err != nil {
+		return nil, err
+	}
+	p.init()
+
+	return &p, nil
+}
@@ -0,0 +1,111 @@
+package gocd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestState(t *testing.T) {
+	overlay := []byte(`
+Widgetschaft:
+  abbr:
+    - WSch
+  lang: de
+`)
+	p, err := New(WithOverlay(overlay))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	err = p.WriteState(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps, err := NewFromState(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, p.patterns, ps.patterns, "patterns match")
+
+	inputs := []string{"Acme WSch", "Acme Widgets Pty Ltd", "Bar Baz"}
+	for _, tc := range loadStripTests() {
+		inputs = append(inputs, tc.Name)
+	}
+	for _, input := range inputs {
+		res, err := p.Parse(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resState, err := ps.Parse(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, res, resState, "NewFromState Parse matches New for %q", input)
+	}
+
+	// Layer priorities are preserved
+	c, err := ps.Clone(WithDatasetLayer("low", CorePriority, []byte("Widgetschaft:\n  lang: de\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := c.Parse("Acme WSch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, SourceOverlay, res.Source, "Source matches after NewFromState")
+}
+
+func TestStateInvalid(t *testing.T) {
+	_, err := NewFromState(bytes.NewReader([]byte("garbage")))
+	assert.ErrorIs(t, err, ErrInvalidState, "garbage state errors")
+
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.patterns = p.patterns[:2]
+	var buf bytes.Buffer
+	err = p.WriteState(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = NewFromState(&buf)
+	assert.ErrorIs(t, err, ErrInvalidState, "truncated patterns errors")
+
+	// Corrupt regex designators error rather than panic
+	p, err = New(WithOverlay([]byte("Widgetschaft:\n  abbr_re:\n    - 'W\\.?Sch'\n  lang: de\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	(*p.ds)["Widgetschaft"].AbbrRE = []string{"W(Sch"}
+	buf.Reset()
+	err = p.WriteState(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = NewFromState(&buf)
+	assert.ErrorIs(t, err, ErrInvalidState, "corrupt abbr_re errors")
+}
+
+func TestStateDisabledPass(t *testing.T) {
+	p, err := New(WithoutBeginPass())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	err = p.WriteState(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps, err := NewFromState(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := ps.Parse("OOO Romashka")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, Begin, res.Position, "pass disabled when writing state is enabled when reading")
+}
@@ -0,0 +1,597 @@
+package gocd
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// The suffix index is an alternative to the regex passes for ASCII
+// input (see WithSuffixIndex). Designators are indexed by canonical keys:
+// folded to lowercase, with each run of separators (whitespace, periods,
+// and ",()-") reduced to a single space, or dropped if the designator
+// regexes treat the run as optional (see PeriodTransform, SpaceTransform
+// and AmpersandTransform). Input is matched by probing the keys of its
+// suffixes (or prefixes, for lead designators) in the order the regex
+// passes would try them, confirming hits against the designator's own
+// (anchored) pattern, so results are the same as the regex passes.
+
+// Suffix index entry flags, by pass
+const (
+	suffixEnd uint8 = 1 << iota
+	suffixEndFallback
+	suffixBegin
+	suffixBeginFallback
+)
+
+// Suffix key separator run kinds
+const (
+	runPeriod uint8 = 1 << iota
+	runSpace
+	runPunct
+)
+
+// suffixIndex maps canonical designator keys to their patterns
+type suffixIndex struct {
+	keys   map[string][]suffixAlt
+	maxKey int
+}
+
+// suffixAlt is a designator pattern indexed under a key, with its pass
+// flags and its order in the pass alternations
+type suffixAlt struct {
+	re    *suffixRE
+	flags uint8
+	order int
+}
+
+// suffixRE holds the anchored regexes confirming a designator pattern
+// matches at the end or start of input, compiled on first use
+type suffixRE struct {
+	pattern            string
+	endOnce, beginOnce sync.Once
+	end, begin         *regexp.Regexp
+}
+
+// endRE returns the regex matching the designator at the start of the
+// remaining input, through to the end of input
+func (sr *suffixRE) endRE() *regexp.Regexp {
+	sr.endOnce.Do(func() {
+		sr.end = regexp.MustCompile(`(?i)^((?:` + sr.pattern + `)\)?)` + StrEndAfter)
+	})
+	return sr.end
+}
+
+// beginRE returns the regex matching the designator at the start of
+// input, followed by a name
+func (sr *suffixRE) beginRE() *regexp.Regexp {
+	sr.beginOnce.Do(func() {
+		sr.begin = regexp.MustCompile(`(?i)^((?:` + sr.pattern + `)\)?)` + StrBeginAfter)
+	})
+	return sr.begin
+}
+
+// WithSuffixIndex matches ASCII input by probing a hash table of
+// designator keys with the input's suffixes and prefixes instead of
+// running the regex passes, which is several times faster. Non-ASCII
+// input is still matched with the regex passes, as are all inputs if
+// the dataset includes regex (abbr_re) abbreviations, or with
+// WithDesignatorTransforms (as the index keys assume the default
+// transforms). Results are the same as regex matching.
+func WithSuffixIndex() Option {
+	return func(o *options) {
+		o.suffixIndex = true
+	}
+}
+
+// isSpaceByte returns true if the ASCII byte c is whitespace (\s)
+func isSpaceByte(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+// isBreakByte returns true if the ASCII byte c can precede or follow a
+// designator, being a space or punctuation (\pZ or \pP)
+func isBreakByte(c byte) bool {
+	switch c {
+	case ' ', '!', '"', '#', '%', '&', '\'', '(', ')', '*', ',', '-', '.', '/',
+		':', ';', '?', '@', '[', '\\', ']', '_', '{', '}':
+		return true
+	}
+	return false
+}
+
+// runKind returns the separator run kind of the ASCII byte c, or 0 if c
+// is not a separator
+func runKind(c byte) uint8 {
+	switch {
+	case c == '.':
+		return runPeriod
+	case isSpaceByte(c):
+		return runSpace
+	case c == ',' || c == '(' || c == ')' || c == '-':
+		return runPunct
+	}
+	return 0
+}
+
+// runKey returns true if a separator run of kinds is kept in keys (as a
+// space), false if the designator regexes can omit it: runs of periods,
+// and runs of whitespace around an ampersand (amp)
+func runKey(kinds uint8, amp bool) bool {
+	return kinds&^runPeriod != 0 && !(amp && kinds == runSpace)
+}
+
+// keyByte returns the canonical key byte for the ASCII non-separator c
+func keyByte(c byte) byte {
+	switch {
+	case c >= 'A' && c <= 'Z':
+		return c + 'a' - 'A'
+	case c == '+':
+		return '&'
+	}
+	return c
+}
+
+// keyScanner builds the canonical key of ASCII input incrementally, one
+// non-separator byte at a time
+type keyScanner struct {
+	in   []byte
+	pos  int
+	key  [64]byte
+	n    int
+	max  int
+	full bool // true if the key exceeded max
+}
+
+// next appends the next non-separator byte of input to the key, preceded
+// by a space if the separators before it are kept, returning false at
+// the end of input or if the key would exceed the maximum length
+func (ks *keyScanner) next() bool {
+	start := ks.pos
+	var kinds uint8
+	for ks.pos < len(ks.in) {
+		k := runKind(ks.in[ks.pos])
+		if k == 0 {
+			break
+		}
+		kinds |= k
+		ks.pos++
+	}
+	if ks.pos == len(ks.in) {
+		return false
+	}
+	c := keyByte(ks.in[ks.pos])
+	ks.pos++
+	// Leading separators are dropped, as are trailing ones (by stopping
+	// before them)
+	if ks.pos-1 > start && ks.n > 0 && runKey(kinds, c == '&' || ks.key[ks.n-1] == '&') {
+		if ks.n == ks.max {
+			ks.full = true
+			return false
+		}
+		ks.key[ks.n] = ' '
+		ks.n++
+	}
+	if ks.n == ks.max {
+		ks.full = true
+		return false
+	}
+	ks.key[ks.n] = c
+	ks.n++
+	return true
+}
+
+// Designator token kinds for key generation, by the transform that
+// produces them
+const (
+	tokLit    = iota // a literal byte
+	tokPeriod        // PeriodTransform: \.*[\pZ,()-]*
+	tokSpace         // SpaceTransform: [\pZ,()-]+
+	tokPunct         // a literal separator e.g. ParenTransform: \(
+	tokAmpWS         // AmpersandTransform: \s* either side of [&+]
+)
+
+// desToken is a designator token
+type desToken struct {
+	kind int
+	c    byte
+}
+
+// tokenKinds lists the separator run kinds each token kind can match,
+// as alternatives
+var tokenKinds = map[int][]uint8{
+	tokPeriod: {0, runPeriod, runSpace, runPunct},
+	tokSpace:  {runSpace, runPunct},
+	tokPunct:  {runPunct},
+	tokAmpWS:  {0, runSpace},
+}
+
+// desTokens splits the ASCII designator des into tokens as the default
+// designator transforms treat it, returning false if des includes regex
+// metacharacters the transforms don't escape
+func desTokens(des string) ([]desToken, bool) {
+	var toks []desToken
+	for i := 0; i < len(des); i++ {
+		c := des[i]
+		switch {
+		case c == ' ':
+			j := i
+			for j < len(des) && des[j] == ' ' {
+				j++
+			}
+			if j < len(des) && des[j] == '&' {
+				continue
+			}
+			toks = append(toks, desToken{kind: tokSpace})
+			i = j - 1
+		case c == '&':
+			toks = append(toks, desToken{kind: tokAmpWS}, desToken{kind: tokLit, c: '&'},
+				desToken{kind: tokAmpWS})
+			for i+1 < len(des) && des[i+1] == ' ' {
+				i++
+			}
+		case c == '.':
+			toks = append(toks, desToken{kind: tokPeriod})
+			for i+1 < len(des) && des[i+1] == ' ' {
+				i++
+			}
+		case c == ',' || c == '(' || c == ')' || c == '-':
+			toks = append(toks, desToken{kind: tokPunct})
+		case strings.IndexByte(`\+*?[]{}|^$`, c) >= 0:
+			return nil, false
+		default:
+			toks = append(toks, desToken{kind: tokLit, c: keyByte(c)})
+		}
+	}
+	return toks, true
+}
+
+// runKeys returns the possible key forms ("" or " ") of the separator
+// tokens toks, given whether they adjoin an ampersand
+func runKeys(toks []desToken, amp bool) []string {
+	seen := make(map[string]bool)
+	var forms []string
+	var visit func(i int, kinds uint8)
+	visit = func(i int, kinds uint8) {
+		if i == len(toks) {
+			form := ""
+			if runKey(kinds, amp) {
+				form = " "
+			}
+			if !seen[form] {
+				seen[form] = true
+				forms = append(forms, form)
+			}
+			return
+		}
+		for _, k := range tokenKinds[toks[i].kind] {
+			visit(i+1, kinds|k)
+		}
+	}
+	visit(0, 0)
+	return forms
+}
+
+// desKeys returns the canonical keys of all input the ASCII designator
+// des can match, and false if des can't be indexed
+func desKeys(des string) ([]string, bool) {
+	toks, ok := desTokens(des)
+	if !ok {
+		return nil, false
+	}
+	// Drop leading and trailing separators, as keys do
+	for len(toks) > 0 && toks[0].kind != tokLit {
+		toks = toks[1:]
+	}
+	for len(toks) > 0 && toks[len(toks)-1].kind != tokLit {
+		toks = toks[:len(toks)-1]
+	}
+	keys := []string{""}
+	for i := 0; i < len(toks); {
+		if toks[i].kind == tokLit {
+			for k := range keys {
+				keys[k] += string(toks[i].c)
+			}
+			i++
+			continue
+		}
+		j := i
+		for j < len(toks) && toks[j].kind != tokLit {
+			j++
+		}
+		amp := toks[i-1].c == '&' || toks[j].c == '&'
+		var next []string
+		for _, key := range keys {
+			for _, form := range runKeys(toks[i:j], amp) {
+				next = append(next, key+form)
+			}
+		}
+		keys = next
+		i = j
+	}
+	return keys, true
+}
+
+// isASCIIString returns true if s is entirely ASCII
+func isASCIIString(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
+
+// newSuffixIndex builds a suffixIndex from the entries in ds for the
+// passes enabled in o, returning nil if ds includes designators that
+// can't be indexed (regex abbr_re abbreviations, or regex syntax)
+func newSuffixIndex(ds *dataset, o *options) *suffixIndex {
+	idx := suffixIndex{keys: make(map[string][]suffixAlt)}
+	res := make(map[string]*suffixRE)
+	xf := o.transforms()
+	order := 0
+	add := func(s string, e *Entry) bool {
+		var flags uint8
+		if EndDesignatorBlacklist[s] {
+			flags = suffixEndFallback
+			if e.Lead && o.passEnabled(BeginFallback) {
+				flags |= suffixBeginFallback
+			}
+		} else {
+			flags = suffixEnd
+			if e.Lead && o.passEnabled(Begin) {
+				flags |= suffixBegin
+			}
+		}
+		// Index both s and its diacritic-stripped form, where ASCII, as
+		// the regex passes do
+		nfd := norm.NFD.String(s)
+		for _, v := range []string{nfd, reIndexMarks.ReplaceAllString(nfd, "")} {
+			if !isASCIIString(v) {
+				continue
+			}
+			keys, ok := desKeys(v)
+			if !ok {
+				return false
+			}
+			pattern := escapeDes(v, xf)
+			sr := res[pattern]
+			if sr == nil {
+				sr = &suffixRE{pattern: pattern}
+				res[pattern] = sr
+			}
+			order++
+			for _, key := range keys {
+				if key == "" || len(key) > len(keyScanner{}.key) || idx.covered(key, sr, flags) {
+					continue
+				}
+				idx.keys[key] = append(idx.keys[key], suffixAlt{re: sr, flags: flags, order: order})
+				if len(key) > idx.maxKey {
+					idx.maxKey = len(key)
+				}
+			}
+		}
+		return true
+	}
+	// Visit entries in sorted order, as the regex passes do, so alternates
+	// sharing a key are tried in the same order
+	longs := make([]string, 0, len(*ds))
+	for long := range *ds {
+		longs = append(longs, long)
+	}
+	sort.Strings(longs)
+	for _, long := range longs {
+		e := (*ds)[long]
+		if len(e.AbbrRE) > 0 || !add(long, e) {
+			return nil
+		}
+		for _, a := range e.Abbr {
+			if !add(a, e) {
+				return nil
+			}
+		}
+	}
+	return &idx
+}
+
+// covered returns true if key already has the pattern sr for all the
+// passes in flags
+func (idx *suffixIndex) covered(key string, sr *suffixRE, flags uint8) bool {
+	for _, alt := range idx.keys[key] {
+		if alt.re == sr && alt.flags&flags == flags {
+			return true
+		}
+	}
+	return false
+}
+
+// groupEnd returns the end of the first designator for pass flag
+// matching from s (after an optional opening parenthesis) through to the
+// end of the ASCII input in, or -1 if there is none
+func (idx *suffixIndex) groupEnd(in []byte, s int, flag uint8) int {
+	ks := keyScanner{in: in[s:], max: idx.maxKey}
+	for ks.next() {
+	}
+	if ks.full {
+		return -1
+	}
+	alts := idx.keys[string(ks.key[:ks.n])]
+	if len(alts) == 0 {
+		return -1
+	}
+	starts := [2]int{s, s}
+	if in[s] == '(' {
+		starts[0] = s + 1
+	}
+	for i, start := range starts {
+		if i == 1 && start == starts[0] {
+			break
+		}
+		for _, alt := range alts {
+			if alt.flags&flag == 0 {
+				continue
+			}
+			if m := alt.re.endRE().FindSubmatchIndex(in[start:]); m != nil {
+				return start + m[3]
+			}
+		}
+	}
+	return -1
+}
+
+// findEnd returns the indices of the (short name, break, designator)
+// groups of the first designator for pass flag at the end of the ASCII
+// input in, as the End regex pass would match it, or nil if there is
+// none. The short name is the shortest possible.
+func (idx *suffixIndex) findEnd(in []byte, flag uint8) []int {
+	lead := 0
+	for lead < len(in) && in[lead] == ' ' {
+		lead++
+	}
+	for ; lead >= 0; lead-- {
+		for end := lead + 1; end < len(in) && in[end-1] != '\n'; end++ {
+			sp1 := end
+			for sp1 < len(in) && in[sp1] == ' ' {
+				sp1++
+			}
+			for b := sp1; b >= end; b-- {
+				if b >= len(in)-1 || !isBreakByte(in[b]) {
+					continue
+				}
+				sp2 := b + 1
+				for sp2 < len(in)-1 && in[sp2] == ' ' {
+					sp2++
+				}
+				for s := sp2; s > b; s-- {
+					if e := idx.groupEnd(in, s, flag); e >= 0 {
+						return []int{lead, end, b, b + 1, s, e}
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// findBegin returns the indices of the (designator, short name) groups
+// of the first designator for pass flag at the start of the ASCII input
+// in, as the Begin regex pass would match it, or nil if there is none
+func (idx *suffixIndex) findBegin(in []byte, flag uint8) []int {
+	lead := 0
+	for lead < len(in) && in[lead] == ' ' {
+		lead++
+	}
+	var cands [16]suffixAlt
+	for ; lead >= 0; lead-- {
+		starts := [2]int{lead, lead}
+		if lead < len(in) && in[lead] == '(' {
+			starts[0] = lead + 1
+		}
+		for i, start := range starts {
+			if i == 1 && start == starts[0] {
+				break
+			}
+			// Collect the designators keyed by each prefix, and try them
+			// in alternation order
+			alts := cands[:0]
+			ks := keyScanner{in: in[start:], max: idx.maxKey}
+			for ks.next() {
+				for _, alt := range idx.keys[string(ks.key[:ks.n])] {
+					if alt.flags&flag != 0 {
+						alts = append(alts, alt)
+					}
+				}
+			}
+			for k := 1; k < len(alts); k++ {
+				for j := k; j > 0 && alts[j].order < alts[j-1].order; j-- {
+					alts[j], alts[j-1] = alts[j-1], alts[j]
+				}
+			}
+			for _, alt := range alts {
+				if m := alt.re.beginRE().FindSubmatchIndex(in[start:]); m != nil {
+					return []int{lead, start + m[3], start + m[4], start + m[5]}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// matchSuffix does designator matching for match using the suffix index,
+// for ASCII input in (from src). End designators are only matched if
+// endCandidate is true, as with the regex passes. Like the regex passes,
+// each pass is recorded in ex, traced, and checked against budget b.
+func (p *Parser) matchSuffix(src source, in []byte, res *Result, endCandidate bool,
+	ex *Explanation, b budget) (PositionType, error) {
+	for _, t := range []PositionType{End, EndFallback} {
+		if !endCandidate {
+			break
+		}
+		flag := suffixEnd
+		if t == EndFallback {
+			flag = suffixEndFallback
+		}
+		m, err := p.runSuffixPass(t, in, ex, b, func() []int { return p.suffix.findEnd(in, flag) },
+			func(m []int) [][]byte { return [][]byte{in, in[m[0]:m[1]], in[m[2]:m[3]], in[m[4]:m[5]]} })
+		if err != nil {
+			return None, err
+		}
+		if m == nil {
+			continue
+		}
+		res.Matched = true
+		res.ShortName, res.Qualifier = p.splitQualifier(src, in[m[0]:m[1]], in[m[2]:m[3]])
+		res.Designator = p.checkDesPunct(src, in[m[2]:m[3]], in[m[4]:m[5]])
+		res.Position = End
+		return t, nil
+	}
+
+	for _, t := range []PositionType{Begin, BeginFallback} {
+		flag := suffixBegin
+		if t == BeginFallback {
+			flag = suffixBeginFallback
+		}
+		m, err := p.runSuffixPass(t, in, ex, b, func() []int { return p.suffix.findBegin(in, flag) },
+			func(m []int) [][]byte { return [][]byte{in, in[m[0]:m[1]], in[m[2]:m[3]]} })
+		if err != nil {
+			return None, err
+		}
+		if m == nil {
+			continue
+		}
+		res.Matched = true
+		res.ShortName = src.str(in[m[2]:m[3]])
+		res.Designator = src.str(in[m[0]:m[1]])
+		res.Position = Begin
+		return t, nil
+	}
+
+	return None, nil
+}
+
+// runSuffixPass is runPass for the suffix index, returning the match
+// indexes from find, with groups giving the equivalent regex submatches
+func (p *Parser) runSuffixPass(t PositionType, in []byte, ex *Explanation, b budget,
+	find func() []int, groups func(m []int) [][]byte) ([]int, error) {
+	if err := b.check(); err != nil {
+		return nil, err
+	}
+	var start time.Time
+	if p.opts.tracer != nil {
+		start = time.Now()
+	}
+	m := find()
+	var matches [][]byte
+	if m != nil {
+		matches = groups(m)
+	}
+	ex.tried(t, matches)
+	if p.opts.tracer != nil {
+		p.opts.tracer.TracePass(t, start, time.Since(start), m != nil)
+	}
+	return m, nil
+}
@@ -0,0 +1,170 @@
+package gocd
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuffixIndex(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps, err := New(WithSuffixIndex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ps.suffix == nil {
+		t.Fatal("suffix index not built")
+	}
+
+	// Suffix index results match regex results
+	inputs := []string{"Acme (UK) Ltd", "Acme, L.L.C.", "Acme & Co.", "OOO Romashka",
+		"Acme Widgets", "Acme Pty. Ltd.", "Acme GmbH & Co. KG", "Acme Ltd,", "Acme - Ltd",
+		"Acme-Ltd", " Ltd", "(Acme) Inc", "Acme (Ltd)", "S.A. Acme", "Acme, Inc."}
+	for _, tc := range loadStripTests() {
+		inputs = append(inputs, tc.Name)
+	}
+	for _, input := range inputs {
+		res, err := p.Parse(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resSuffix, err := ps.Parse(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, res, resSuffix, "suffix index Parse matches regex Parse for %q", input)
+	}
+
+	// As do inputs with spaces and punctuation mutated
+	seps := []string{"", " ", "  ", ".", ". ", " .", ",", ", ", "-", " - ", "(", ")", " (",
+		") ", "\t", " & ", "+", "'", "/", ":"}
+	rng := rand.New(rand.NewSource(1))
+	mutate := func(s string) string {
+		b := []byte(s)
+		for n := 1 + rng.Intn(3); n > 0; n-- {
+			i := rng.Intn(len(b) + 1)
+			sep := seps[rng.Intn(len(seps))]
+			switch {
+			case i < len(b) && strings.IndexByte(" .,()-\t", b[i]) >= 0:
+				// Replace a separator
+				b = append(b[:i:i], append([]byte(sep), b[i+1:]...)...)
+			case rng.Intn(2) == 0:
+				// Split a word, or pad the ends
+				b = append(b[:i:i], append([]byte(sep), b[i:]...)...)
+			}
+		}
+		return string(b)
+	}
+	for _, input := range inputs {
+		if !isASCIIString(input) {
+			continue
+		}
+		for n := 0; n < 20; n++ {
+			mutated := mutate(input)
+			res, err := p.Parse(mutated)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resSuffix, err := ps.Parse(mutated)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, res, resSuffix, "suffix index Parse matches regex Parse for %q", mutated)
+		}
+	}
+}
+
+func BenchmarkSuffixIndex(b *testing.B) {
+	for _, bc := range []struct {
+		name string
+		opts []Option
+	}{
+		{"RE", nil},
+		{"Suffix", []Option{WithSuffixIndex()}},
+	} {
+		p, err := New(bc.opts...)
+		if err != nil {
+			b.Fatal(err)
+		}
+		var inputs []string
+		for _, tc := range loadStripTests() {
+			if isASCIIString(tc.Name) {
+				inputs = append(inputs, tc.Name)
+			}
+		}
+		b.Run(bc.name, func(b *testing.B) {
+			var res Result
+			for i := 0; i < b.N; i++ {
+				_ = p.ParseInto(inputs[i%len(inputs)], &res)
+			}
+		})
+	}
+}
+
+func TestSuffixIndexExplain(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps, err := New(WithSuffixIndex())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Suffix index passes are recorded like the regex passes
+	for _, input := range []string{"Acme Ltd", "Acme (UK) Ltd", "Acme & Co.", "OOO Romashka",
+		"Acme Widgets", "Acme, Inc.", "S.A. Acme"} {
+		ex, err := p.Explain(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		exSuffix, err := ps.Explain(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.NotEmpty(t, exSuffix.Tried, "passes tried for %q", input)
+		assert.Equal(t, ex.Tried, exSuffix.Tried, "Tried matches for %q", input)
+		assert.Equal(t, ex.Groups, exSuffix.Groups, "Groups matches for %q", input)
+	}
+
+	// And traced, and checked against the parse timeout
+	ps, err = New(WithSuffixIndex(), WithParseTimeout(time.Millisecond),
+		WithTracer(slowTracer{5 * time.Millisecond}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ps.Parse("Acme Widgets")
+	assert.ErrorIs(t, err, ErrParseTimeout, "later pass times out")
+}
+
+func TestSuffixIndexTransforms(t *testing.T) {
+	// The index keys assume the default transforms, so the index isn't
+	// used with custom transforms
+	xf := []DesignatorTransform{AmpersandTransform, ParenTransform, SpaceTransform}
+	p, err := New(WithDesignatorTransforms(xf...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps, err := New(WithSuffixIndex(), WithDesignatorTransforms(xf...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, ps.suffix, "suffix index not built")
+	for _, input := range []string{"Acme Ltd", "Acme Ltd.", "Acme LLC", "Acme L.L.C", "Acme LLC."} {
+		res, err := p.Parse(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resSuffix, err := ps.Parse(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, res, resSuffix, "Parse matches for %q", input)
+	}
+}
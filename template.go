@@ -0,0 +1,26 @@
+package gocd
+
+import "text/template"
+
+// FuncMap returns template functions backed by p, for rendering company
+// data through text/template (or html/template, via conversion):
+//
+//	shortname     - the ShortName of a company name
+//	designator    - the Designator of a company name, if any
+//	designatorStd - the DesignatorStd of a company name, if any
+func (p *Parser) FuncMap() template.FuncMap {
+	field := func(get func(*Result) string) func(string) (string, error) {
+		return func(name string) (string, error) {
+			res, err := p.Parse(name)
+			if err != nil {
+				return "", err
+			}
+			return get(res), nil
+		}
+	}
+	return template.FuncMap{
+		"shortname":     field(func(r *Result) string { return r.ShortName }),
+		"designator":    field(func(r *Result) string { return r.Designator }),
+		"designatorStd": field(func(r *Result) string { return r.DesignatorStd }),
+	}
+}
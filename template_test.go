@@ -0,0 +1,42 @@
+package gocd
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuncMap(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl, err := template.New("t").Funcs(p.FuncMap()).Parse(
+		`{{range .}}{{shortname .}}|{{designator .}}|{{designatorStd .}}
+{{end}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	err = tmpl.Execute(&b, []string{"Acme Widgets L.L.C.", "Acme Widgets"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "Acme Widgets|L.L.C.|LLC\nAcme Widgets||\n", b.String(), "template output matches")
+
+	htmpl, err := htmltemplate.New("t").Funcs(htmltemplate.FuncMap(p.FuncMap())).Parse(
+		`<b>{{shortname .}}</b>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Reset()
+	err = htmpl.Execute(&b, "Smith & Sons Ltd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "<b>Smith &amp; Sons</b>", b.String(), "html/template output matches")
+}
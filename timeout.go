@@ -0,0 +1,61 @@
+package gocd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrParseTimeout is returned when matching is abandoned because the
+// parse timeout (see WithParseTimeout) or context deadline was exceeded.
+// It is returned together with the partial Result, which is unmatched,
+// with ShortName equal to Input.
+var ErrParseTimeout = errors.New("parse timeout exceeded")
+
+// WithParseTimeout bounds the matching work done per parse to d. The
+// deadline is checked between matching passes, so a single pass is not
+// interrupted; use WithMaxInputLength to also bound the work done by
+// each pass. A parse exceeding d returns an unmatched Result together
+// with an ErrParseTimeout error.
+func WithParseTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.parseTimeout = d
+	}
+}
+
+// budget bounds the work done by a single parse. The zero budget is
+// unbounded.
+type budget struct {
+	ctx      context.Context
+	deadline time.Time
+}
+
+// newBudget returns the budget for a parse under ctx (which may be nil)
+func (p *Parser) newBudget(ctx context.Context) budget {
+	b := budget{ctx: ctx}
+	if p.opts.parseTimeout > 0 {
+		b.deadline = time.Now().Add(p.opts.parseTimeout)
+	}
+	return b
+}
+
+// check returns an ErrParseTimeout error if b is exhausted
+func (b budget) check() error {
+	if b.ctx != nil {
+		if err := b.ctx.Err(); err != nil {
+			return fmt.Errorf("%w: %v", ErrParseTimeout, err)
+		}
+	}
+	if !b.deadline.IsZero() && time.Now().After(b.deadline) {
+		return ErrParseTimeout
+	}
+	return nil
+}
+
+// ParseContext is a version of Parse that abandons matching when ctx is
+// done (checked between matching passes), returning an unmatched Result
+// together with an ErrParseTimeout error
+func (p *Parser) ParseContext(ctx context.Context, input string) (*Result, error) {
+	return p.parseResult(ctx, []byte(input), "")
+}
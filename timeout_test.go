@@ -0,0 +1,72 @@
+package gocd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// slowTracer sleeps after each pass, simulating slow matching
+type slowTracer struct {
+	delay time.Duration
+}
+
+func (tr slowTracer) TracePass(pass PositionType, start time.Time, elapsed time.Duration, matched bool) {
+	time.Sleep(tr.delay)
+}
+
+func TestParseTimeout(t *testing.T) {
+	p, err := New(WithParseTimeout(time.Millisecond),
+		WithTracer(slowTracer{5 * time.Millisecond}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Matches on the first pass, so completes
+	res, err := p.Parse("Acme Ltd")
+	if assert.NoError(t, err, "first pass match completes") {
+		assert.Equal(t, "Ltd", res.Designator, "Designator matches")
+	}
+
+	// Begin designator is only tried after the deadline has passed (the
+	// continuous pass runs first, as the input contains Han)
+	res, err = p.Parse("ООО Ромашка 東京")
+	assert.ErrorIs(t, err, ErrParseTimeout, "later pass times out")
+	if assert.NotNil(t, res, "partial Result returned") {
+		assert.False(t, res.Matched, "Matched matches")
+		assert.Equal(t, "ООО Ромашка 東京", res.ShortName, "ShortName matches")
+	}
+}
+
+func TestParseContext(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := p.ParseContext(context.Background(), "ООО Ромашка")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Ромашка", res.ShortName, "ShortName matches")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	res, err = p.ParseContext(ctx, "Acme Ltd")
+	assert.ErrorIs(t, err, ErrParseTimeout, "cancelled context times out")
+	if assert.NotNil(t, res, "partial Result returned") {
+		assert.False(t, res.Matched, "Matched matches")
+		assert.Equal(t, "Acme Ltd", res.ShortName, "ShortName matches")
+	}
+
+	res, err = p.ParseLangContext(context.Background(), "Acme GmbH", "de")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "GmbH", res.Designator, "ParseLangContext Designator matches")
+	}
+	res, err = p.ParseLangContext(ctx, "Acme GmbH", "de")
+	assert.ErrorIs(t, err, ErrParseTimeout, "cancelled ParseLangContext times out")
+	if assert.NotNil(t, res, "partial Result returned") {
+		assert.False(t, res.Matched, "ParseLangContext Matched matches")
+	}
+}
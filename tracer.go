@@ -0,0 +1,19 @@
+package gocd
+
+import "time"
+
+// Tracer is an instrumentation hook invoked after each matching pass is
+// run against an input, e.g. for recording OpenTelemetry spans.
+// TracePass is given the pass, its start time and duration, and whether
+// it matched. Passes skipped by prefiltering are not traced.
+// Implementations must be safe for concurrent use.
+type Tracer interface {
+	TracePass(pass PositionType, start time.Time, elapsed time.Duration, matched bool)
+}
+
+// WithTracer invokes t with the timing and outcome of each matching pass
+func WithTracer(t Tracer) Option {
+	return func(o *options) {
+		o.tracer = t
+	}
+}
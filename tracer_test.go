@@ -0,0 +1,54 @@
+package gocd
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// passTrace is a pass traced by testTracer
+type passTrace struct {
+	pass    PositionType
+	matched bool
+}
+
+// testTracer records traced passes
+type testTracer struct {
+	sync.Mutex
+	passes []passTrace
+}
+
+func (tr *testTracer) TracePass(pass PositionType, start time.Time, elapsed time.Duration, matched bool) {
+	tr.Lock()
+	defer tr.Unlock()
+	tr.passes = append(tr.passes, passTrace{pass, matched})
+}
+
+func TestTracer(t *testing.T) {
+	tr := &testTracer{}
+	p, err := New(WithTracer(tr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = p.Parse("ООО Ромашка")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.NotEmpty(t, tr.passes, "passes traced") {
+		last := tr.passes[len(tr.passes)-1]
+		assert.Equal(t, passTrace{Begin, true}, last, "matching pass traced last")
+		for _, pt := range tr.passes[:len(tr.passes)-1] {
+			assert.False(t, pt.matched, "earlier pass %s not matched", pt.pass)
+		}
+	}
+
+	tr.passes = nil
+	_, err = p.Parse("Acme GmbH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []passTrace{{End, true}}, tr.passes, "End pass traced")
+}
@@ -0,0 +1,85 @@
+package gocd
+
+import "regexp"
+
+// DesignatorTransform is a step in converting a dataset designator
+// (in NFD form) into a regex pattern. The default transforms (see
+// DefaultDesignatorTransforms) are applied in order, each receiving
+// the output of the previous one.
+type DesignatorTransform func(des string) string
+
+var (
+	reDesAmpersand   = regexp.MustCompile(`\pZ*&\pZ*`)
+	reDesParen       = regexp.MustCompile("([()（）])")
+	reDesPeriodSpace = regexp.MustCompile(`\.\pZ*`)
+	reDesSpace       = regexp.MustCompile(`\pZ+`)
+)
+
+// AmpersandTransform allows ampersands to match more broadly, including
+// plus signs and surrounding whitespace
+func AmpersandTransform(des string) string {
+	return reDesAmpersand.ReplaceAllString(des, `\s*[&+]\s*`)
+}
+
+// ParenTransform escapes parentheses in the designator itself
+func ParenTransform(des string) string {
+	return reDesParen.ReplaceAllString(des, `\$1`)
+}
+
+// PeriodTransform treats periods as optional literals, optionally
+// followed by whitespace or separator punctuation
+func PeriodTransform(des string) string {
+	return reDesPeriodSpace.ReplaceAllString(des, `\.*[\pZ,()-]*`)
+}
+
+// SpaceTransform interprets embedded spaces in designators liberally,
+// also matching separator punctuation
+func SpaceTransform(des string) string {
+	return reDesSpace.ReplaceAllString(des, `[\pZ,()-]+`)
+}
+
+// DefaultDesignatorTransforms returns the default designator transforms,
+// in order, for customisation with WithDesignatorTransforms
+func DefaultDesignatorTransforms() []DesignatorTransform {
+	return []DesignatorTransform{
+		AmpersandTransform,
+		ParenTransform,
+		PeriodTransform,
+		SpaceTransform,
+		SharpSTransform,
+		GershayimTransform,
+	}
+}
+
+// WithDesignatorTransforms replaces the default designator transforms
+// (see DefaultDesignatorTransforms) used to build the regex passes, to
+// customise how liberally designators are matched. For example, to
+// require periods to match exactly:
+//
+//	xf := gocd.DefaultDesignatorTransforms()
+//	xf[2] = func(des string) string {
+//		return strings.Replace(des, ".", `\.`, -1)
+//	}
+//	p, err := gocd.New(gocd.WithDesignatorTransforms(xf...))
+//
+// Transforms must produce valid regex syntax without capturing groups.
+// They apply to the regex passes only (not e.g. WithSuffixIndex), and
+// disable the final-token prefilter, which assumes the defaults.
+func WithDesignatorTransforms(xf ...DesignatorTransform) Option {
+	return func(o *options) {
+		o.desTransforms = xf
+	}
+}
+
+// transforms returns the designator transforms to use
+func (o *options) transforms() []DesignatorTransform {
+	if o.desTransforms != nil {
+		return o.desTransforms
+	}
+	return DefaultDesignatorTransforms()
+}
+
+// sameTransforms returns true if a and b are the same slice
+func sameTransforms(a, b []DesignatorTransform) bool {
+	return len(a) == len(b) && (len(a) == 0 || &a[0] == &b[0])
+}
@@ -0,0 +1,55 @@
+package gocd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDesignatorTransforms(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Explicit defaults build identical patterns
+	pd, err := New(WithDesignatorTransforms(DefaultDesignatorTransforms()...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, p.patterns, pd.patterns, "default transforms patterns match")
+
+	// Strict periods
+	xf := DefaultDesignatorTransforms()
+	xf[2] = func(des string) string {
+		return strings.Replace(des, ".", `\.`, -1)
+	}
+	ps, err := p.Clone(WithDesignatorTransforms(xf...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		input   string
+		matched bool
+		strict  bool
+	}{
+		{"Acme L.L.C.", true, true},
+		{"Acme Pty. Ltd.", true, true},
+		{"Acme Pty Ltd", true, false},
+		{"Acme L. L. C.", true, false},
+		{"Acme Widgets", false, false},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.matched, res.Matched, "default Matched for %q", tc.input)
+		res, err = ps.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.strict, res.Matched, "strict Matched for %q", tc.input)
+	}
+}
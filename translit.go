@@ -0,0 +1,158 @@
+package gocd
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// cyrillicLatin maps lowercase Cyrillic letters to their Latin
+// transliterations, following the BGN/PCGN conventions common in
+// registry data (e.g. "ООО" as "OOO", "ЗАО" as "ZAO")
+var cyrillicLatin = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+	'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+	// Ukrainian and Belarusian
+	'і': "i", 'ї': "yi", 'є': "ye", 'ґ': "g", 'ў': "w",
+}
+
+// latinCyrillic maps Latin letters to the Cyrillic letters they are
+// commonly substituted for in mixed-script text
+var latinCyrillic = map[rune]rune{
+	'A': 'А', 'B': 'В', 'C': 'С', 'E': 'Е', 'H': 'Н', 'K': 'К', 'M': 'М',
+	'O': 'О', 'P': 'Р', 'T': 'Т', 'X': 'Х', 'Y': 'У',
+	'a': 'а', 'c': 'с', 'e': 'е', 'k': 'к', 'o': 'о', 'p': 'р', 'x': 'х',
+	'y': 'у',
+}
+
+// reMixedWord matches words of Cyrillic and/or Latin letters
+var reMixedWord = regexp.MustCompile(`[\p{Cyrillic}\p{Latin}\pM]+`)
+
+// WithTransliteration matches Cyrillic designators across
+// transliteration and mixed scripts, as is common in e.g. Russian
+// company data. Latin transliterations of Cyrillic designators (e.g.
+// "PAO" for "ПАО", "Obshchestvo s ogranichennoy otvetstvennostyu") are
+// matched, reporting the Cyrillic designator as the canonical
+// DesignatorStd, and Latin letters within otherwise Cyrillic words
+// (e.g. the Latin "O" in "ОOО") are replaced by the Cyrillic letters they
+// resemble before matching. Transliterations follow BGN/PCGN
+// conventions, so other romanisations of long forms may not match.
+func WithTransliteration() Option {
+	return func(o *options) {
+		o.translit = true
+	}
+}
+
+// hasCyrillic returns true if s contains any Cyrillic letters
+func hasCyrillic(s string) bool {
+	for _, r := range s {
+		if unicode.Is(unicode.Cyrillic, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// transliterate returns the Latin transliteration of the Cyrillic
+// letters in s, preserving case
+func transliterate(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFC.String(s) {
+		lat, ok := cyrillicLatin[unicode.ToLower(r)]
+		if !ok {
+			b.WriteRune(r)
+			continue
+		}
+		if unicode.IsUpper(r) && lat != "" {
+			lat = strings.ToUpper(lat[:1]) + lat[1:]
+		}
+		b.WriteString(lat)
+	}
+	return b.String()
+}
+
+// transliterations returns the Latin transliterations of the Cyrillic
+// designators of the entry e with long name long
+func transliterations(long string, e *Entry) []string {
+	var lats []string
+	for _, des := range append([]string{long}, e.Abbr...) {
+		if hasCyrillic(des) {
+			lats = append(lats, transliterate(des))
+		}
+	}
+	return lats
+}
+
+// transliterated returns a copy of ds with the Latin transliterations
+// of each entry's Cyrillic designators added to its abbreviations
+func (ds *dataset) transliterated() *dataset {
+	tds := make(dataset, len(*ds))
+	for long, e := range *ds {
+		tds[long] = e
+		lats := transliterations(long, e)
+		if len(lats) == 0 {
+			continue
+		}
+		te := *e
+		te.Abbr = append([]string(nil), e.Abbr...)
+		known := make(map[string]bool, len(te.Abbr)+1)
+		for _, des := range append([]string{long}, te.Abbr...) {
+			known[exactKey(des)] = true
+		}
+		for _, lat := range lats {
+			if !known[exactKey(lat)] {
+				known[exactKey(lat)] = true
+				te.Abbr = append(te.Abbr, lat)
+			}
+		}
+		tds[long] = &te
+	}
+	return &tds
+}
+
+// addTransliterations indexes the Latin transliterations of the
+// Cyrillic designators in ds, referencing the Cyrillic designators
+// (which take precedence over any Latin designators with the same key)
+func (idx *desIndex) addTransliterations(ds *dataset) {
+	longs := make([]string, 0, len(*ds))
+	for long := range *ds {
+		longs = append(longs, long)
+	}
+	sort.Strings(longs)
+
+	for _, long := range longs {
+		for _, des := range append([]string{long}, (*ds)[long].Abbr...) {
+			if !hasCyrillic(des) {
+				continue
+			}
+			ref := desRef{long: long, des: des}
+			lat := transliterate(des)
+			if k := looseKey(lat); k != "" {
+				idx.loose[k] = []desRef{ref}
+			}
+		}
+	}
+}
+
+// foldHomoglyphs replaces Latin letters in word with the Cyrillic
+// letters they resemble, if word also contains Cyrillic letters
+func foldHomoglyphs(word []byte) []byte {
+	s := string(word)
+	if !hasCyrillic(s) || strings.IndexFunc(s, func(r rune) bool {
+		return unicode.Is(unicode.Latin, r)
+	}) < 0 {
+		return word
+	}
+	return []byte(strings.Map(func(r rune) rune {
+		if c, ok := latinCyrillic[r]; ok {
+			return c
+		}
+		return r
+	}, s))
+}
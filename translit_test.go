@@ -0,0 +1,71 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransliteration(t *testing.T) {
+	p, err := New(WithTransliteration())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		input     string
+		shortName string
+		des       string
+		std       string
+	}{
+		{"PAO Gazprom", "Gazprom", "PAO", "ПАО"},
+		{"Gazprom PAO", "Gazprom", "PAO", "ПАО"},
+		{"OOO Romashka", "Romashka", "OOO", "ООО"},
+		{"Romashka Obshchestvo s ogranichennoy otvetstvennostyu", "Romashka",
+			"Obshchestvo s ogranichennoy otvetstvennostyu",
+			"Общество с ограниченной ответственностью"},
+		// Latin "O" in a Cyrillic designator
+		{"ОOО Ромашка",
+			"Ромашка", "ООО", "ООО"},
+		{"УКРАИНСКИЙ ДОМ OOО",
+			"УКРАИНСКИЙ ДОМ",
+			"ООО", "ООО"},
+		// Latin designators are unaffected
+		{"Acme Widgets Ltd", "Acme Widgets", "Ltd", "Ltd."},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, res.Matched, "Matched for %q", tc.input)
+		assert.Equal(t, tc.shortName, res.ShortName, "ShortName matches for %q", tc.input)
+		assert.Equal(t, tc.des, res.Designator, "Designator matches for %q", tc.input)
+		assert.Equal(t, tc.std, res.DesignatorStd, "DesignatorStd matches for %q", tc.input)
+	}
+
+	// Not matched by default
+	def, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, input := range []string{"PAO Gazprom", "ОOО Ромашка"} {
+		res, err := def.Parse(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.False(t, res.Matched, "Matched by default for %q", input)
+	}
+
+	// Original output keeps the input's Latin letters
+	orig, err := p.Clone(WithOutputForm(OutputOriginal))
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := "ОOО Ромашка"
+	res, err := orig.Parse(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "ОOО", res.Designator, "Designator matches for %q", input)
+	assert.Equal(t, "ООО", res.DesignatorStd, "DesignatorStd matches for %q", input)
+}
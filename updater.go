@@ -0,0 +1,148 @@
+package gocd
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DatasetSource fetches YAML dataset data for an Updater
+type DatasetSource func() ([]byte, error)
+
+// FileSource returns a DatasetSource reading the dataset file path
+func FileSource(path string) DatasetSource {
+	return func() ([]byte, error) {
+		return ioutil.ReadFile(path)
+	}
+}
+
+// URLSource returns a DatasetSource fetching the dataset from url, with
+// the given request timeout
+func URLSource(url string, timeout time.Duration) DatasetSource {
+	client := &http.Client{Timeout: timeout}
+	return func() ([]byte, error) {
+		resp, err := client.Get(url)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("fetching %s: %s", url, resp.Status)
+		}
+		return ioutil.ReadAll(resp.Body)
+	}
+}
+
+// UpdaterOptions configure an Updater
+type UpdaterOptions struct {
+	Interval time.Duration              // How often to re-fetch the dataset
+	Options  []Option                   // Options for each Parser created
+	OnUpdate func(p *Parser, err error) // Called after each changed fetch, with the new Parser or an error
+}
+
+// Updater keeps a Parser up to date with a dataset source, periodically
+// re-fetching the dataset and, if it has changed and is valid, atomically
+// swapping in a new Parser built from it. In-flight parses complete
+// against the Parser they started with.
+type Updater struct {
+	src     DatasetSource
+	opts    UpdaterOptions
+	parser  atomic.Value // *Parser
+	mu      sync.Mutex   // Serialises updates
+	sum     [sha256.Size]byte
+	stop    chan struct{}
+	stopped sync.WaitGroup
+}
+
+// NewUpdater returns an Updater for src, doing the initial fetch and
+// Parser build synchronously. Call Start to begin periodic updates.
+func NewUpdater(src DatasetSource, opts UpdaterOptions) (*Updater, error) {
+	u := &Updater{src: src, opts: opts}
+	data, err := src()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatasetOpen, err)
+	}
+	p, err := NewFromDataset(data, opts.Options...)
+	if err != nil {
+		return nil, err
+	}
+	u.parser.Store(p)
+	u.sum = sha256.Sum256(data)
+	return u, nil
+}
+
+// Parser returns the current Parser
+func (u *Updater) Parser() *Parser {
+	return u.parser.Load().(*Parser)
+}
+
+// Parse parses input using the current Parser
+func (u *Updater) Parse(input string) (*Result, error) {
+	return u.Parser().Parse(input)
+}
+
+// Update fetches the dataset and swaps in a new Parser if it has changed,
+// returning true if the Parser was replaced. Failures leave the current
+// Parser in place. OnUpdate is called unless the dataset is unchanged.
+func (u *Updater) Update() (bool, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	data, err := u.src()
+	if err != nil {
+		err = fmt.Errorf("%w: %v", ErrDatasetOpen, err)
+		u.notify(nil, err)
+		return false, err
+	}
+	sum := sha256.Sum256(data)
+	if bytes.Equal(sum[:], u.sum[:]) {
+		return false, nil
+	}
+	p, err := NewFromDataset(data, u.opts.Options...)
+	if err != nil {
+		u.notify(nil, err)
+		return false, err
+	}
+	u.parser.Store(p)
+	u.sum = sum
+	u.notify(p, nil)
+	return true, nil
+}
+
+// notify calls any OnUpdate callback
+func (u *Updater) notify(p *Parser, err error) {
+	if u.opts.OnUpdate != nil {
+		u.opts.OnUpdate(p, err)
+	}
+}
+
+// Start begins updating in the background every Interval, until Stop
+func (u *Updater) Start() {
+	u.stop = make(chan struct{})
+	u.stopped.Add(1)
+	go func() {
+		defer u.stopped.Done()
+		ticker := time.NewTicker(u.opts.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				u.Update()
+			case <-u.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops background updates started by Start, waiting for any
+// update in progress to finish
+func (u *Updater) Stop() {
+	close(u.stop)
+	u.stopped.Wait()
+}
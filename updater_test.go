@@ -0,0 +1,122 @@
+package gocd
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const updaterDataset1 = `
+Gesellschaft mit beschränkter Haftung:
+  abbr:
+    - GmbH
+  lang: de
+`
+
+const updaterDataset2 = `
+Widgetschaft:
+  abbr:
+    - WSch
+  lang: de
+`
+
+func TestUpdater(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dataset.yml")
+	err := ioutil.WriteFile(path, []byte(updaterDataset1), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var updates []error
+	u, err := NewUpdater(FileSource(path), UpdaterOptions{
+		OnUpdate: func(p *Parser, err error) { updates = append(updates, err) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := u.Parse("Acme GmbH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, res.Matched, "initial dataset used")
+
+	updated, err := u.Update()
+	assert.NoError(t, err, "unchanged Update ok")
+	assert.False(t, updated, "unchanged dataset not reloaded")
+	assert.Empty(t, updates, "OnUpdate not called for unchanged dataset")
+
+	err = ioutil.WriteFile(path, []byte("X: ["), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated, err = u.Update()
+	assert.ErrorIs(t, err, ErrDatasetParse, "invalid dataset errors")
+	assert.False(t, updated, "invalid dataset not swapped in")
+	res, err = u.Parse("Acme GmbH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, res.Matched, "previous Parser kept after failure")
+
+	err = ioutil.WriteFile(path, []byte(updaterDataset2), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated, err = u.Update()
+	assert.NoError(t, err, "Update ok")
+	assert.True(t, updated, "changed dataset swapped in")
+	res, err = u.Parse("Acme WSch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, res.Matched, "new dataset used")
+	if assert.Len(t, updates, 2, "OnUpdate called") {
+		assert.Error(t, updates[0], "failure reported")
+		assert.NoError(t, updates[1], "success reported")
+	}
+}
+
+func TestUpdaterBackground(t *testing.T) {
+	var mu sync.Mutex
+	data := updaterDataset1
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Write([]byte(data))
+	}))
+	defer ts.Close()
+
+	done := make(chan struct{})
+	var once sync.Once
+	u, err := NewUpdater(URLSource(ts.URL, time.Second), UpdaterOptions{
+		Interval: 10 * time.Millisecond,
+		OnUpdate: func(p *Parser, err error) { once.Do(func() { close(done) }) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	u.Start()
+	defer u.Stop()
+
+	mu.Lock()
+	data = updaterDataset2
+	mu.Unlock()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for update")
+	}
+	res, err := u.Parse("Acme WSch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, res.Matched, "background update applied")
+}
+
+var _ NameParser = (*Updater)(nil)
@@ -0,0 +1,52 @@
+package gocd
+
+import (
+	"bytes"
+	"errors"
+	"unicode/utf8"
+)
+
+// ErrInvalidUTF8 is returned for invalid UTF-8 input with the
+// RejectInvalidUTF8 policy
+var ErrInvalidUTF8 = errors.New("invalid UTF-8 input")
+
+// InvalidUTF8Policy determines how a Parser handles input that is not
+// valid UTF-8
+type InvalidUTF8Policy int
+
+const (
+	ReplaceInvalidUTF8 InvalidUTF8Policy = iota // Replace invalid sequences with U+FFFD (the default)
+	RejectInvalidUTF8                           // Return an ErrInvalidUTF8 error
+	Latin1InvalidUTF8                           // Reinterpret the whole input as Latin-1 (ISO 8859-1)
+)
+
+// WithInvalidUTF8 sets the policy for handling invalid UTF-8 input
+func WithInvalidUTF8(policy InvalidUTF8Policy) Option {
+	return func(o *options) {
+		o.invalidUTF8 = policy
+	}
+}
+
+// latin1ToUTF8 returns the Latin-1 input converted to UTF-8
+func latin1ToUTF8(input []byte) []byte {
+	buf := make([]byte, 0, len(input)*2)
+	for _, b := range input {
+		buf = utf8.AppendRune(buf, rune(b))
+	}
+	return buf
+}
+
+// checkUTF8 applies the Parser's InvalidUTF8Policy to input, returning
+// valid UTF-8
+func (p *Parser) checkUTF8(input []byte) ([]byte, error) {
+	if utf8.Valid(input) {
+		return input, nil
+	}
+	switch p.opts.invalidUTF8 {
+	case RejectInvalidUTF8:
+		return nil, ErrInvalidUTF8
+	case Latin1InvalidUTF8:
+		return latin1ToUTF8(input), nil
+	}
+	return bytes.ToValidUTF8(input, []byte("\uFFFD")), nil
+}
@@ -0,0 +1,40 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvalidUTF8(t *testing.T) {
+	tests := []struct {
+		policy InvalidUTF8Policy
+		input  string
+		err    error
+		short  string
+		des    string
+	}{
+		{ReplaceInvalidUTF8, "Acme\xff Widgets GmbH", nil, "Acme� Widgets", "GmbH"},
+		{ReplaceInvalidUTF8, "Acme GmbH", nil, "Acme", "GmbH"},
+		{RejectInvalidUTF8, "Acme\xff Widgets GmbH", ErrInvalidUTF8, "", ""},
+		{RejectInvalidUTF8, "Acme GmbH", nil, "Acme", "GmbH"},
+		{Latin1InvalidUTF8, "Soci\xe9t\xe9 G\xe9n\xe9rale Lt\xe9e", nil, "Société Générale", "Ltée"},
+	}
+
+	for _, tc := range tests {
+		p, err := New(WithInvalidUTF8(tc.policy))
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := p.Parse(tc.input)
+		if tc.err != nil {
+			assert.ErrorIs(t, err, tc.err, "Parse errors for %q", tc.input)
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.short, res.ShortName, "ShortName matches for %q", tc.input)
+		assert.Equal(t, tc.des, res.Designator, "Designator matches for %q", tc.input)
+	}
+}
@@ -0,0 +1,52 @@
+package gocd
+
+import "strings"
+
+// MaxVariants is the maximum number of variants returned by Variants
+const MaxVariants = 20
+
+// Variants returns name combined with each equivalent form of its
+// designator (see Synonyms) e.g. "Acme Ltd", "Acme Limited", "Acme Ltd."
+// for "Acme Ltd", for query expansion and alias tables. name itself
+// (normalised to single spaces) comes first, and variants are
+// de-duplicated and capped at MaxVariants. If name has no designator,
+// only name is returned; if name is only a designator, its synonyms are
+// returned. Returns nil if name cannot be parsed.
+func (p *Parser) Variants(name string) []string {
+	res, err := p.Parse(name)
+	if err != nil {
+		return nil
+	}
+	if !res.Matched {
+		return []string{name}
+	}
+
+	short := res.ShortName
+	if res.Qualifier != "" {
+		short += " (" + res.Qualifier + ")"
+	}
+	combine := func(des string) string {
+		switch res.Position {
+		case Whole:
+			return des
+		case Begin:
+			return des + " " + short
+		}
+		return short + " " + des
+	}
+
+	var variants []string
+	seen := make(map[string]bool)
+	add := func(s string) {
+		s = strings.Join(strings.Fields(s), " ")
+		if len(variants) < MaxVariants && s != "" && !seen[s] {
+			seen[s] = true
+			variants = append(variants, s)
+		}
+	}
+	add(combine(res.Designator))
+	for _, des := range p.Synonyms(res.Designator) {
+		add(combine(des))
+	}
+	return variants
+}
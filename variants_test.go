@@ -0,0 +1,34 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVariants(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		expected []string
+	}{
+		{"Acme Ltd", []string{"Acme Ltd", "Acme Limited", "Acme Ltd."}},
+		{"Acme (UK) Limited", []string{"Acme (UK) Limited", "Acme (UK) Ltd."}},
+		{"ООО Ромашка", []string{"ООО Ромашка",
+			"Общество с ограниченной ответственностью Ромашка",
+			"Общество с ограниченнои ответственностью Ромашка", "OOO Ромашка"}},
+		{"Acme Widgets", []string{"Acme Widgets"}},
+		{"Ltd", []string{"Ltd", "Limited", "Ltd."}},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, p.Variants(tc.name), "Variants matches for %q", tc.name)
+	}
+
+	variants := p.Variants("Acme LLC")
+	assert.LessOrEqual(t, len(variants), MaxVariants, "Variants capped")
+	assert.Contains(t, variants, "Acme L.L.C.", "Variants include L.L.C.")
+}
@@ -0,0 +1,62 @@
+package gocd
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrDatasetVerify is returned for external datasets failing verification
+var ErrDatasetVerify = errors.New("dataset verification failed")
+
+// DatasetVerifier checks external dataset data before it is used,
+// returning an error wrapping ErrDatasetVerify if it fails
+type DatasetVerifier func(data []byte) error
+
+// WithDatasetVerifier verifies external datasets (from GOCD_DATASET,
+// NewFromDataset, or an Updater) with v before use. The embedded default
+// dataset is not verified.
+func WithDatasetVerifier(v DatasetVerifier) Option {
+	return func(o *options) {
+		o.verifier = v
+	}
+}
+
+// SHA256Verifier returns a DatasetVerifier requiring the dataset to have
+// the hex-encoded SHA-256 checksum sum
+func SHA256Verifier(sum string) DatasetVerifier {
+	return func(data []byte) error {
+		got := sha256.Sum256(data)
+		if !strings.EqualFold(hex.EncodeToString(got[:]), strings.TrimSpace(sum)) {
+			return fmt.Errorf("%w: sha256 checksum mismatch", ErrDatasetVerify)
+		}
+		return nil
+	}
+}
+
+// Ed25519Verifier returns a DatasetVerifier requiring a valid Ed25519
+// detached signature of the dataset by the key pub. The signature, raw
+// or base64-encoded, is fetched from sig on each verification, so it
+// can track dataset updates (e.g. FileSource(path + ".sig")).
+func Ed25519Verifier(pub ed25519.PublicKey, sig DatasetSource) DatasetVerifier {
+	return func(data []byte) error {
+		s, err := sig()
+		if err != nil {
+			return fmt.Errorf("%w: reading signature: %v", ErrDatasetVerify, err)
+		}
+		if len(s) != ed25519.SignatureSize {
+			s, err = base64.StdEncoding.DecodeString(strings.TrimSpace(string(s)))
+			if err != nil {
+				return fmt.Errorf("%w: decoding signature: %v", ErrDatasetVerify, err)
+			}
+		}
+		if !ed25519.Verify(pub, data, s) {
+			return fmt.Errorf("%w: invalid signature", ErrDatasetVerify)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,58 @@
+package gocd
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatasetVerifier(t *testing.T) {
+	data := []byte(updaterDataset2)
+	sum := sha256.Sum256(data)
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := ed25519.Sign(priv, data)
+	badSig := ed25519.Sign(priv, []byte("other"))
+	source := func(b []byte) DatasetSource {
+		return func() ([]byte, error) { return b, nil }
+	}
+
+	tests := []struct {
+		name string
+		v    DatasetVerifier
+		ok   bool
+	}{
+		{"sha256", SHA256Verifier(hex.EncodeToString(sum[:])), true},
+		{"sha256 mismatch", SHA256Verifier(hex.EncodeToString(make([]byte, 32))), false},
+		{"ed25519", Ed25519Verifier(pub, source(sig)), true},
+		{"ed25519 base64", Ed25519Verifier(pub, source([]byte(base64.StdEncoding.EncodeToString(sig)+"\n"))), true},
+		{"ed25519 bad signature", Ed25519Verifier(pub, source(badSig)), false},
+		{"ed25519 garbage signature", Ed25519Verifier(pub, source([]byte("garbage"))), false},
+	}
+
+	for _, tc := range tests {
+		p, err := NewFromDataset(data, WithDatasetVerifier(tc.v))
+		if !tc.ok {
+			assert.ErrorIs(t, err, ErrDatasetVerify, "%s fails verification", tc.name)
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := p.Parse("Acme WSch")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, res.Matched, "%s verified dataset used", tc.name)
+	}
+
+	// The embedded dataset is not verified
+	_, err = New(WithoutDatasetEnv(), WithDatasetVerifier(SHA256Verifier("")))
+	assert.NoError(t, err, "embedded dataset not verified")
+}
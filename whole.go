@@ -0,0 +1,36 @@
+package gocd
+
+import (
+	"bytes"
+	"unicode"
+)
+
+// compileWholeKeys returns the set of loose index keys of the
+// designators in ds, for recognising inputs that are entirely a
+// designator. Regex (abbr_re) designators are not included.
+func compileWholeKeys(ds *dataset) map[string]bool {
+	keys := make(map[string]bool)
+	for long, e := range *ds {
+		keys[looseKey(long)] = true
+		for _, a := range e.Abbr {
+			keys[looseKey(a)] = true
+		}
+	}
+	delete(keys, "")
+	return keys
+}
+
+// matchWhole checks whether the NFD input in (from src) consists
+// entirely of a designator, ignoring surrounding whitespace, and if so
+// fills in res with an empty ShortName and the Whole Position
+func (p *Parser) matchWhole(src source, in []byte, res *Result) bool {
+	trimmed := bytes.TrimFunc(in, unicode.IsSpace)
+	if len(trimmed) == 0 || !p.wholeKeys[looseKey(string(trimmed))] {
+		return false
+	}
+	res.Matched = true
+	res.ShortName = ""
+	res.Designator = src.str(trimmed)
+	res.Position = Whole
+	return true
+}
@@ -0,0 +1,58 @@
+package gocd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWhole(t *testing.T) {
+	p, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input      string
+		designator string
+		std        string
+	}{
+		{"GmbH", "GmbH", "GmbH"},
+		{"Limited", "Limited", "Limited"},
+		{" Ltd. ", "Ltd.", "Ltd."},
+		{"(LLC)", "(LLC)", "LLC"},
+		{"ООО", "ООО", "ООО"},
+		{"有限公司", "有限公司", "有限公司"},
+	}
+	for _, tc := range tests {
+		res, err := p.Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, res.Matched, "Matched matches for %q", tc.input)
+		assert.Equal(t, Whole, res.Position, "Position matches for %q", tc.input)
+		assert.Equal(t, "", res.ShortName, "ShortName matches for %q", tc.input)
+		assert.Equal(t, tc.designator, res.Designator, "Designator matches for %q", tc.input)
+		assert.Equal(t, tc.std, res.DesignatorStd, "DesignatorStd matches for %q", tc.input)
+	}
+
+	// Not designator-only
+	for _, input := range []string{"Acme Ltd", "Acme", "", "   "} {
+		res, err := p.Parse(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.NotEqual(t, Whole, res.Position, "Position matches for %q", input)
+	}
+
+	// Minimum ShortName lengths don't apply
+	p, err = New(WithMinShortNameRunes(2, SuppressMatch))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := p.Parse("GmbH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, Whole, res.Position, "Position matches with minimum")
+}